@@ -14,6 +14,13 @@ import (
 type ServerApi struct {
 	Url string `json:"url"`
 	Key string `json:"key"`
+	// CertFingerprint pins the SHA-256 fingerprint of the server certificate, e.g. for self-signed certificates
+	CertFingerprint string `json:"certFingerprint,omitempty"`
+	CaCertFile      string `json:"caCertFile,omitempty"`
+	ClientCertFile  string `json:"clientCertFile,omitempty"`
+	ClientKeyFile   string `json:"clientKeyFile,omitempty"`
+	// Socket is the path of the local server's Unix socket. If set, requests are sent over it instead of the network
+	Socket string `json:"socket,omitempty"`
 }
 
 type Profile struct {
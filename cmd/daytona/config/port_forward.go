@@ -0,0 +1,139 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// GetPortForwardDir returns the directory that holds the port forwarding state of a workspace project
+func GetPortForwardDir(workspaceId, projectName string) (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "port-forward", workspaceId, projectName), nil
+}
+
+// GetPortForwardLogPath returns the path of the log file of the background port forwarding process of a workspace project
+func GetPortForwardLogPath(workspaceId, projectName string) (string, error) {
+	dir, err := GetPortForwardDir(workspaceId, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "forward.log"), nil
+}
+
+// GetPortForwardPid returns the PID of the background port forwarding process of a workspace project or 0 if none was started
+func GetPortForwardPid(workspaceId, projectName string) (int, error) {
+	pidPath, err := getPortForwardPidPath(workspaceId, projectName)
+	if err != nil {
+		return 0, err
+	}
+
+	content, err := os.ReadFile(pidPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(string(content)))
+}
+
+func SetPortForwardPid(workspaceId, projectName string, pid int) error {
+	pidPath, err := getPortForwardPidPath(workspaceId, projectName)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(pidPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(pidPath, []byte(strconv.Itoa(pid)), 0644)
+}
+
+func RemovePortForwardPid(workspaceId, projectName string) error {
+	pidPath, err := getPortForwardPidPath(workspaceId, projectName)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(pidPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// IsPortBrowserOpened returns true if the browser was already opened for the forwarded port of a workspace project
+func IsPortBrowserOpened(workspaceId, projectName string, port uint16) (bool, error) {
+	markerPath, err := getPortBrowserOpenedPath(workspaceId, projectName, port)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(markerPath)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func SetPortBrowserOpened(workspaceId, projectName string, port uint16) error {
+	markerPath, err := getPortBrowserOpenedPath(workspaceId, projectName, port)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(markerPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(markerPath, []byte{}, 0644)
+}
+
+// RemoveWorkspacePortForwardData removes the port forwarding state of all projects of a workspace
+func RemoveWorkspacePortForwardData(workspaceId string) error {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(filepath.Join(configDir, "port-forward", workspaceId))
+}
+
+func getPortForwardPidPath(workspaceId, projectName string) (string, error) {
+	dir, err := GetPortForwardDir(workspaceId, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "forward.pid"), nil
+}
+
+func getPortBrowserOpenedPath(workspaceId, projectName string, port uint16) (string, error) {
+	dir, err := GetPortForwardDir(workspaceId, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "browser-opened", fmt.Sprint(port)), nil
+}
@@ -0,0 +1,18 @@
+#!/bin/bash
+# Copyright 2024 Daytona Platforms Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+# Build a release binary of daytona.
+# VERSION is the release version and PROVIDERS_PUBLIC_KEY is the base64 encoded Ed25519 key
+# that provider releases are signed with. Servers can override the key with providersPublicKey in their config.
+set -e
+
+if [ -z "$VERSION" ] || [ -z "$PROVIDERS_PUBLIC_KEY" ]; then
+  echo "VERSION and PROVIDERS_PUBLIC_KEY must be set" >&2
+  exit 1
+fi
+
+CGO_ENABLED=0 go build \
+  -ldflags "-X github.com/daytonaio/daytona/internal.Version=$VERSION -X github.com/daytonaio/daytona/internal.ProvidersPublicKey=$PROVIDERS_PUBLIC_KEY" \
+  -o "${OUTPUT:-daytona}" \
+  cmd/daytona/main.go
@@ -5,4 +5,7 @@ package internal
 
 var (
 	Version = "v0.0.0-dev"
+	// ProvidersPublicKey is the base64 encoded Ed25519 key that provider releases are signed with.
+	// It is set with -ldflags at build time for release builds (see hack/build.sh).
+	ProvidersPublicKey = ""
 )
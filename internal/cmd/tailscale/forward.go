@@ -17,7 +17,7 @@ import (
 
 func ForwardPort(workspaceId, projectName string, targetPort uint16) (*uint16, chan error) {
 	hostPort := targetPort
-	errChan := make(chan error)
+	errChan := make(chan error, 1)
 	var err error
 	if !ports.IsPortAvailable(targetPort) {
 		hostPort, err = ports.GetAvailableEphemeralPort()
@@ -52,6 +52,11 @@ func (m *MockApiClient) ImagePull(ctx context.Context, ref string, options image
 	return args.Get(0).(io.ReadCloser), args.Error(1)
 }
 
+func (m *MockApiClient) ImageBuild(ctx context.Context, buildContext io.Reader, options types.ImageBuildOptions) (types.ImageBuildResponse, error) {
+	args := m.Called(ctx, buildContext, options)
+	return args.Get(0).(types.ImageBuildResponse), args.Error(1)
+}
+
 func (m *MockApiClient) ContainerLogs(ctx context.Context, container string, options container.LogsOptions) (io.ReadCloser, error) {
 	args := m.Called(ctx, container, options)
 	return args.Get(0).(io.ReadCloser), args.Error(1)
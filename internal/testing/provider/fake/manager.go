@@ -0,0 +1,105 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
+)
+
+var errNotSupported = errors.New("not supported by the fake provider manager")
+
+// FakeProviderManager is a provider manager with a fixed set of in-process providers.
+// Installing, downloading and uninstalling providers is not supported.
+type FakeProviderManager struct {
+	providers map[string]provider.ProviderV2
+}
+
+func NewFakeProviderManager(providers map[string]provider.ProviderV2) manager.IProviderManager {
+	return &FakeProviderManager{providers: providers}
+}
+
+func (m *FakeProviderManager) GetProvider(name string) (*provider.Provider, error) {
+	p, err := m.GetProviderV2(name)
+	if err != nil {
+		return nil, err
+	}
+
+	v1 := provider.ToV1(p)
+	return &v1, nil
+}
+
+func (m *FakeProviderManager) GetProviderV2(name string) (provider.ProviderV2, error) {
+	p, ok := m.providers[name]
+	if !ok {
+		return nil, errors.New("provider not found")
+	}
+	return p, nil
+}
+
+func (m *FakeProviderManager) GetProviderCapabilities(name string) (*provider.ProviderCapabilities, error) {
+	p, err := m.GetProviderV2(name)
+	if err != nil {
+		return nil, err
+	}
+	return p.GetCapabilities(context.Background())
+}
+
+func (m *FakeProviderManager) GetProviders() map[string]provider.Provider {
+	providers := map[string]provider.Provider{}
+	for name, p := range m.providers {
+		providers[name] = provider.ToV1(p)
+	}
+	return providers
+}
+
+func (m *FakeProviderManager) GetProvidersHealth() []manager.ProviderHealth {
+	health := []manager.ProviderHealth{}
+	for name := range m.providers {
+		health = append(health, manager.ProviderHealth{Name: name, State: manager.ProviderStateUp})
+	}
+	return health
+}
+
+func (m *FakeProviderManager) DownloadProvider(version manager.Version, providerName string, throwIfPresent bool, allowUnsigned bool) (string, error) {
+	return "", errNotSupported
+}
+
+func (m *FakeProviderManager) GetInstalledVersions(providerName string) ([]string, error) {
+	return nil, errNotSupported
+}
+
+func (m *FakeProviderManager) GetProvidersManifest() (*manager.ProvidersManifest, error) {
+	return nil, errNotSupported
+}
+
+func (m *FakeProviderManager) InstallProvider(providerName string, versionName string, version manager.Version, allowUnsigned bool) error {
+	return errNotSupported
+}
+
+func (m *FakeProviderManager) InstallProviderBinary(binary io.Reader, providerName string, checksum string, signature string, allowUnsigned bool) error {
+	return errNotSupported
+}
+
+func (m *FakeProviderManager) RegisterProvider(pluginPath string) error {
+	return errNotSupported
+}
+
+func (m *FakeProviderManager) RollbackProvider(providerName string) (string, error) {
+	return "", errNotSupported
+}
+
+func (m *FakeProviderManager) TerminateProviderProcesses(providersBasePath string) error {
+	return nil
+}
+
+func (m *FakeProviderManager) UninstallProvider(name string) error {
+	return errNotSupported
+}
@@ -0,0 +1,320 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+const ProviderName = "fake-provider"
+
+var ErrWorkspaceNotFound = errors.New("workspace not found")
+var ErrProjectNotFound = errors.New("project not found")
+
+type fakeProject struct {
+	created time.Time
+	running bool
+}
+
+// FakeProvider is an in-memory ProviderV2 that keeps track of workspaces and projects
+// without running anything
+type FakeProvider struct {
+	mutex        sync.Mutex
+	capabilities provider.ProviderCapabilities
+	workspaces   map[string]bool
+	projects     map[string]*fakeProject
+	errors       map[string]error
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		capabilities: provider.DefaultProviderCapabilities,
+		workspaces:   map[string]bool{},
+		projects:     map[string]*fakeProject{},
+		errors:       map[string]error{},
+	}
+}
+
+// SetCapabilities changes the capabilities the provider reports
+func (p *FakeProvider) SetCapabilities(capabilities provider.ProviderCapabilities) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.capabilities = capabilities
+}
+
+// SetError makes the method with the given name fail with err until it is reset with a nil error
+func (p *FakeProvider) SetError(method string, err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if err == nil {
+		delete(p.errors, method)
+		return
+	}
+	p.errors[method] = err
+}
+
+// WorkspaceExists reports whether the workspace was created and not destroyed
+func (p *FakeProvider) WorkspaceExists(workspaceId string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.workspaces[workspaceId]
+}
+
+// ProjectRunning reports whether the project exists and is running
+func (p *FakeProvider) ProjectRunning(workspaceId, projectName string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	project, ok := p.projects[projectKey(workspaceId, projectName)]
+	return ok && project.running
+}
+
+func (p *FakeProvider) Initialize(ctx context.Context, req provider.InitializeProviderRequest) (*util.Empty, error) {
+	return p.empty(ctx, "Initialize")
+}
+
+func (p *FakeProvider) GetInfo(ctx context.Context) (provider.ProviderInfo, error) {
+	_, err := p.empty(ctx, "GetInfo")
+	if err != nil {
+		return provider.ProviderInfo{}, err
+	}
+
+	return provider.ProviderInfo{Name: ProviderName, Version: "v0.0.1"}, nil
+}
+
+func (p *FakeProvider) GetCapabilities(ctx context.Context) (*provider.ProviderCapabilities, error) {
+	_, err := p.empty(ctx, "GetCapabilities")
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	capabilities := p.capabilities
+	return &capabilities, nil
+}
+
+func (p *FakeProvider) GetTargetManifest(ctx context.Context) (*provider.ProviderTargetManifest, error) {
+	_, err := p.empty(ctx, "GetTargetManifest")
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.ProviderTargetManifest{
+		"Region": provider.ProviderTargetProperty{
+			Type:         provider.ProviderTargetPropertyTypeOption,
+			Options:      []string{"eu", "us"},
+			DefaultValue: "eu",
+			Description:  "Region the fake workspaces are created in",
+		},
+		"Token": provider.ProviderTargetProperty{
+			Type:        provider.ProviderTargetPropertyTypeString,
+			InputMasked: true,
+			Description: "Token used to authenticate with the fake provider",
+		},
+	}, nil
+}
+
+func (p *FakeProvider) GetDefaultTargets(ctx context.Context) (*[]provider.ProviderTarget, error) {
+	_, err := p.empty(ctx, "GetDefaultTargets")
+	if err != nil {
+		return nil, err
+	}
+
+	return &[]provider.ProviderTarget{
+		{
+			Name:         "fake",
+			ProviderInfo: provider.ProviderInfo{Name: ProviderName, Version: "v0.0.1"},
+			Options:      `{"Region": "eu"}`,
+		},
+	}, nil
+}
+
+func (p *FakeProvider) CreateWorkspace(ctx context.Context, req *provider.WorkspaceRequest) (*util.Empty, error) {
+	return p.update(ctx, "CreateWorkspace", func() error {
+		p.workspaces[req.Workspace.Id] = true
+		return nil
+	})
+}
+
+func (p *FakeProvider) StartWorkspace(ctx context.Context, req *provider.WorkspaceRequest) (*util.Empty, error) {
+	return p.update(ctx, "StartWorkspace", func() error {
+		return p.requireWorkspace(req.Workspace.Id)
+	})
+}
+
+func (p *FakeProvider) StopWorkspace(ctx context.Context, req *provider.WorkspaceRequest) (*util.Empty, error) {
+	return p.update(ctx, "StopWorkspace", func() error {
+		return p.requireWorkspace(req.Workspace.Id)
+	})
+}
+
+func (p *FakeProvider) DestroyWorkspace(ctx context.Context, req *provider.WorkspaceRequest) (*util.Empty, error) {
+	return p.update(ctx, "DestroyWorkspace", func() error {
+		delete(p.workspaces, req.Workspace.Id)
+		return nil
+	})
+}
+
+func (p *FakeProvider) GetWorkspaceInfo(ctx context.Context, req *provider.WorkspaceRequest) (*workspace.WorkspaceInfo, error) {
+	_, err := p.empty(ctx, "GetWorkspaceInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	err = p.requireWorkspace(req.Workspace.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceInfo := &workspace.WorkspaceInfo{
+		Name:     req.Workspace.Name,
+		Projects: []*workspace.ProjectInfo{},
+	}
+
+	for _, project := range req.Workspace.Projects {
+		projectInfo, err := p.projectInfo(project)
+		if err != nil {
+			continue
+		}
+		workspaceInfo.Projects = append(workspaceInfo.Projects, projectInfo)
+	}
+
+	return workspaceInfo, nil
+}
+
+func (p *FakeProvider) CreateProject(ctx context.Context, req *provider.ProjectRequest) (*util.Empty, error) {
+	return p.update(ctx, "CreateProject", func() error {
+		err := p.requireWorkspace(req.Project.WorkspaceId)
+		if err != nil {
+			return err
+		}
+
+		key := projectKey(req.Project.WorkspaceId, req.Project.Name)
+		if _, ok := p.projects[key]; !ok {
+			p.projects[key] = &fakeProject{created: time.Now()}
+		}
+		return nil
+	})
+}
+
+func (p *FakeProvider) StartProject(ctx context.Context, req *provider.ProjectRequest) (*util.Empty, error) {
+	return p.update(ctx, "StartProject", func() error {
+		project, ok := p.projects[projectKey(req.Project.WorkspaceId, req.Project.Name)]
+		if !ok {
+			return ErrProjectNotFound
+		}
+
+		project.running = true
+		return nil
+	})
+}
+
+func (p *FakeProvider) StopProject(ctx context.Context, req *provider.ProjectRequest) (*util.Empty, error) {
+	return p.update(ctx, "StopProject", func() error {
+		project, ok := p.projects[projectKey(req.Project.WorkspaceId, req.Project.Name)]
+		if !ok {
+			return ErrProjectNotFound
+		}
+
+		project.running = false
+		return nil
+	})
+}
+
+func (p *FakeProvider) DestroyProject(ctx context.Context, req *provider.ProjectRequest) (*util.Empty, error) {
+	return p.update(ctx, "DestroyProject", func() error {
+		delete(p.projects, projectKey(req.Project.WorkspaceId, req.Project.Name))
+		return nil
+	})
+}
+
+func (p *FakeProvider) GetProjectInfo(ctx context.Context, req *provider.ProjectRequest) (*workspace.ProjectInfo, error) {
+	_, err := p.empty(ctx, "GetProjectInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.projectInfo(req.Project)
+}
+
+func (p *FakeProvider) projectInfo(project *workspace.Project) (*workspace.ProjectInfo, error) {
+	fakeProject, ok := p.projects[projectKey(project.WorkspaceId, project.Name)]
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+
+	return &workspace.ProjectInfo{
+		Name:        project.Name,
+		Created:     fakeProject.created.Format(time.RFC3339),
+		IsRunning:   fakeProject.running,
+		WorkspaceId: project.WorkspaceId,
+	}, nil
+}
+
+func (p *FakeProvider) requireWorkspace(workspaceId string) error {
+	if !p.workspaces[workspaceId] {
+		return ErrWorkspaceNotFound
+	}
+	return nil
+}
+
+// empty returns the context error or the error set for the method
+func (p *FakeProvider) empty(ctx context.Context, method string) (*util.Empty, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if err, ok := p.errors[method]; ok {
+		return nil, err
+	}
+
+	return new(util.Empty), nil
+}
+
+func (p *FakeProvider) update(ctx context.Context, method string, fn func() error) (*util.Empty, error) {
+	_, err := p.empty(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(provider.LogWriter(ctx), "%s\n", method)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	err = fn()
+	if err != nil {
+		return nil, err
+	}
+
+	return new(util.Empty), nil
+}
+
+func projectKey(workspaceId, projectName string) string {
+	return workspaceId + "/" + projectName
+}
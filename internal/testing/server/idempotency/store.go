@@ -0,0 +1,44 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/idempotency"
+)
+
+type InMemoryIdempotencyKeyStore struct {
+	mutex           sync.Mutex
+	idempotencyKeys map[string]*idempotency.IdempotencyKey
+}
+
+func NewInMemoryIdempotencyKeyStore() idempotency.Store {
+	return &InMemoryIdempotencyKeyStore{
+		idempotencyKeys: make(map[string]*idempotency.IdempotencyKey),
+	}
+}
+
+func (s *InMemoryIdempotencyKeyStore) Find(key string) (*idempotency.IdempotencyKey, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	idempotencyKey, ok := s.idempotencyKeys[key]
+	if !ok || !time.Now().Before(idempotencyKey.ExpiresAt) {
+		return nil, idempotency.ErrIdempotencyKeyNotFound
+	}
+
+	return idempotencyKey, nil
+}
+
+func (s *InMemoryIdempotencyKeyStore) Save(idempotencyKey *idempotency.IdempotencyKey) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.idempotencyKeys[idempotencyKey.Key] = idempotencyKey
+	return nil
+}
@@ -26,7 +26,7 @@ type MockBuilderFactory struct {
 	mock.Mock
 }
 
-func (f *MockBuilderFactory) Create(p workspace.Project, gpc *gitprovider.GitProviderConfig) (builder.IBuilder, error) {
+func (f *MockBuilderFactory) Create(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig) (builder.IBuilder, error) {
 	return &mockBuilder{}, nil
 }
 
@@ -34,6 +34,21 @@ func (f *MockBuilderFactory) CheckExistingBuild(p workspace.Project) (*builder.B
 	return MockBuildResults, nil
 }
 
+func (f *MockBuilderFactory) GetContentHash(p workspace.Project, gpc *gitprovider.GitProviderConfig) (string, error) {
+	args := f.Called(p, gpc)
+	return args.String(0), args.Error(1)
+}
+
+func (f *MockBuilderFactory) ListBuilds() ([]*builder.Build, error) {
+	args := f.Called()
+	return args.Get(0).([]*builder.Build), args.Error(1)
+}
+
+func (f *MockBuilderFactory) DeleteBuild(hash string) error {
+	args := f.Called(hash)
+	return args.Error(0)
+}
+
 type mockBuilder struct {
 	mock.Mock
 }
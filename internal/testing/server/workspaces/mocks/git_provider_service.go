@@ -58,8 +58,8 @@ func (m *mockGitProviderService) GetRepoPRs(gitProviderId string, namespaceId st
 	return args.Get(0).([]*gitprovider.GitPullRequest), args.Error(1)
 }
 
-func (m *mockGitProviderService) GetRepositories(gitProviderId string, namespaceId string) ([]*gitprovider.GitRepository, error) {
-	args := m.Called(gitProviderId, namespaceId)
+func (m *mockGitProviderService) GetRepositories(gitProviderId string, namespaceId string, options gitprovider.ListOptions) ([]*gitprovider.GitRepository, error) {
+	args := m.Called(gitProviderId, namespaceId, options)
 	return args.Get(0).([]*gitprovider.GitRepository), args.Error(1)
 }
 
@@ -6,6 +6,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
@@ -20,47 +22,52 @@ func NewMockProvisioner() *mockProvisioner {
 	return &mockProvisioner{}
 }
 
-func (p *mockProvisioner) CreateProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+func (p *mockProvisioner) CreateProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
 	args := p.Called(project, target, cr)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+func (p *mockProvisioner) CreateWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
 	args := p.Called(workspace, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) DestroyProject(project *workspace.Project, target *provider.ProviderTarget) error {
+func (p *mockProvisioner) DestroyProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget) error {
 	args := p.Called(project, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+func (p *mockProvisioner) DestroyWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
 	args := p.Called(workspace, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) GetWorkspaceInfo(w *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error) {
+func (p *mockProvisioner) GetWorkspaceInfo(ctx context.Context, w *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error) {
 	args := p.Called(w, target)
 	return args.Get(0).(*workspace.WorkspaceInfo), args.Error(1)
 }
 
-func (p *mockProvisioner) StartProject(project *workspace.Project, target *provider.ProviderTarget) error {
+func (p *mockProvisioner) StartProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget) error {
 	args := p.Called(project, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+func (p *mockProvisioner) StartWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
 	args := p.Called(workspace, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) StopProject(project *workspace.Project, target *provider.ProviderTarget) error {
+func (p *mockProvisioner) StopProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget) error {
 	args := p.Called(project, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+func (p *mockProvisioner) StopWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
 	args := p.Called(workspace, target)
 	return args.Error(0)
 }
+
+func (p *mockProvisioner) TestTarget(ctx context.Context, target *provider.ProviderTarget) (*provider.TargetTestResult, error) {
+	args := p.Called(target)
+	return args.Get(0).(*provider.TargetTestResult), args.Error(1)
+}
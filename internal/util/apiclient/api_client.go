@@ -50,7 +50,12 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 		return nil, err
 	}
 
-	_, err = http.Head(healthUrl)
+	transport, err := getTransport(activeProfile.Api)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = (&http.Client{Transport: transport}).Head(healthUrl)
 	if err != nil {
 		return nil, ErrHealthCheckFailed(healthUrl)
 	}
@@ -62,13 +67,15 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 		},
 	}
 
-	clientConfig.AddDefaultHeader("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	if apiKey != "" {
+		clientConfig.AddDefaultHeader("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	}
 	clientConfig.AddDefaultHeader(CLIENT_VERSION_HEADER, internal.Version)
 
 	apiClient = apiclient.NewAPIClient(clientConfig)
 
 	apiClient.GetConfig().HTTPClient = &http.Client{
-		Transport: http.DefaultTransport,
+		Transport: transport,
 	}
 
 	return apiClient, nil
@@ -95,7 +102,7 @@ func GetAgentApiClient(apiUrl, apiKey string) (*apiclient.APIClient, error) {
 }
 
 func ToServerConfig(config apiclient.ServerConfig) server.Config {
-	return server.Config{
+	serverConfig := server.Config{
 		ProvidersDir:      *config.ProvidersDir,
 		RegistryUrl:       *config.RegistryUrl,
 		Id:                *config.Id,
@@ -105,9 +112,22 @@ func ToServerConfig(config apiclient.ServerConfig) server.Config {
 			Port:     uint32(*config.Frps.Port),
 			Protocol: *config.Frps.Protocol,
 		},
-		ApiPort:       uint32(*config.ApiPort),
-		HeadscalePort: uint32(*config.HeadscalePort),
+		ApiPort:                uint32(*config.ApiPort),
+		HeadscalePort:          uint32(*config.HeadscalePort),
+		ProvidersPublicKey:     config.GetProvidersPublicKey(),
+		PinnedProviderVersions: config.GetPinnedProviderVersions(),
+	}
+
+	if config.Tls != nil {
+		serverConfig.Tls = &server.TLSConfig{
+			CertFile:     config.Tls.GetCertFile(),
+			KeyFile:      config.Tls.GetKeyFile(),
+			ClientCaFile: config.Tls.GetClientCaFile(),
+			SelfSigned:   config.Tls.GetSelfSigned(),
+		}
 	}
+
+	return serverConfig
 }
 
 func GetProviderList() ([]apiclient.Provider, error) {
@@ -132,7 +152,9 @@ func GetTargetList() ([]apiclient.ProviderTarget, error) {
 		return nil, err
 	}
 
-	targets, resp, err := apiClient.TargetAPI.ListTargets(context.Background()).Execute()
+	targets, resp, err := ListAll(func(cursor string, limit int32) ([]apiclient.ProviderTarget, *http.Response, error) {
+		return apiClient.TargetAPI.ListTargets(context.Background()).Cursor(cursor).Limit(limit).Execute()
+	})
 	if err != nil {
 		return nil, HandleErrorResponse(resp, err)
 	}
@@ -33,6 +33,14 @@ func ToProject(projectDTO *apiclient.Project) *workspace.Project {
 			Uptime:    uint64(uptime),
 			GitStatus: ToGitStatus(projectDTO.State.GitStatus),
 		}
+
+		for _, hookDTO := range projectDTO.State.LifecycleHooks {
+			projectState.LifecycleHooks = append(projectState.LifecycleHooks, workspace.LifecycleHookStatus{
+				Hook:  workspace.LifecycleHook(hookDTO.GetHook()),
+				State: workspace.LifecycleHookState(hookDTO.GetState()),
+				Error: hookDTO.GetError(),
+			})
+		}
 	}
 
 	project := &workspace.Project{
@@ -44,6 +52,7 @@ func ToProject(projectDTO *apiclient.Project) *workspace.Project {
 		PostCreateCommands: projectDTO.PostCreateCommands,
 		PostStartCommands:  projectDTO.PostStartCommands,
 		Repository:         repository,
+		Lifecycle:          ToProjectLifecycle(projectDTO.Lifecycle),
 		State:              projectState,
 	}
 
@@ -55,6 +64,65 @@ func ToProject(projectDTO *apiclient.Project) *workspace.Project {
 	return project
 }
 
+func ToProjectLifecycle(lifecycleDTO *apiclient.ProjectLifecycle) *workspace.ProjectLifecycle {
+	if lifecycleDTO == nil {
+		return nil
+	}
+
+	return &workspace.ProjectLifecycle{
+		OnCreate:      toLifecycleCommandGroups(lifecycleDTO.OnCreate),
+		UpdateContent: toLifecycleCommandGroups(lifecycleDTO.UpdateContent),
+		PostCreate:    toLifecycleCommandGroups(lifecycleDTO.PostCreate),
+		PostStart:     toLifecycleCommandGroups(lifecycleDTO.PostStart),
+		PostAttach:    toLifecycleCommandGroups(lifecycleDTO.PostAttach),
+		WaitFor:       workspace.LifecycleHook(lifecycleDTO.GetWaitFor()),
+		Prebuilt:      toLifecycleHooks(lifecycleDTO.Prebuilt),
+	}
+}
+
+func toLifecycleHooks(hookDTOs []apiclient.LifecycleHook) []workspace.LifecycleHook {
+	hooks := []workspace.LifecycleHook{}
+	for _, hookDTO := range hookDTOs {
+		hooks = append(hooks, workspace.LifecycleHook(hookDTO))
+	}
+	return hooks
+}
+
+func toLifecycleCommandGroups(groupDTOs []apiclient.LifecycleCommandGroup) []workspace.LifecycleCommandGroup {
+	groups := []workspace.LifecycleCommandGroup{}
+	for _, groupDTO := range groupDTOs {
+		group := workspace.LifecycleCommandGroup{}
+		for _, commandDTO := range groupDTO.Commands {
+			group.Commands = append(group.Commands, workspace.LifecycleCommand{
+				Name:    commandDTO.GetName(),
+				Command: commandDTO.GetCommand(),
+				Args:    commandDTO.Args,
+			})
+		}
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+func ToLifecycleHookStatusesDTO(statuses []workspace.LifecycleHookStatus) []apiclient.LifecycleHookStatus {
+	statusDTOs := []apiclient.LifecycleHookStatus{}
+	for _, status := range statuses {
+		hook := apiclient.LifecycleHook(status.Hook)
+		state := apiclient.LifecycleHookState(status.State)
+		statusDTO := apiclient.LifecycleHookStatus{
+			Hook:  &hook,
+			State: &state,
+		}
+		if status.Error != "" {
+			statusDTO.Error = &status.Error
+		}
+		statusDTOs = append(statusDTOs, statusDTO)
+	}
+
+	return statusDTOs
+}
+
 func ToGitStatus(gitStatusDTO *apiclient.GitStatus) *workspace.GitStatus {
 	if gitStatusDTO == nil {
 		return nil
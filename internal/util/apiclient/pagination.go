@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/pagination"
+)
+
+const LIST_PAGE_SIZE = 100
+
+// GetNextCursor returns the cursor of the next page of a list response or an empty string on the last page
+func GetNextCursor(res *http.Response) string {
+	if res == nil {
+		return ""
+	}
+
+	return res.Header.Get(pagination.NEXT_CURSOR_HEADER)
+}
+
+// ListAll follows the cursors of a paginated list endpoint and returns the items of all pages
+func ListAll[T any](fetchPage func(cursor string, limit int32) ([]T, *http.Response, error)) ([]T, *http.Response, error) {
+	items := []T{}
+	cursor := ""
+
+	for {
+		page, res, err := fetchPage(cursor, LIST_PAGE_SIZE)
+		if err != nil {
+			return nil, res, err
+		}
+
+		items = append(items, page...)
+
+		cursor = GetNextCursor(res)
+		if cursor == "" {
+			return items, res, nil
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/server"
+)
+
+// GetTlsConfig returns the TLS client config of a profile or nil if the profile uses the default config
+func GetTlsConfig(serverApi config.ServerApi) (*tls.Config, error) {
+	if serverApi.CertFingerprint == "" && serverApi.CaCertFile == "" && serverApi.ClientCertFile == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if serverApi.CaCertFile != "" {
+		caCert, err := os.ReadFile(serverApi.CaCertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("no certificates found in " + serverApi.CaCertFile)
+		}
+	}
+
+	if serverApi.CertFingerprint != "" {
+		fingerprint := strings.ToLower(strings.ReplaceAll(serverApi.CertFingerprint, ":", ""))
+
+		// The pinned fingerprint replaces the chain and hostname verification
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("server did not present a certificate")
+			}
+
+			serverFingerprint := server.CertificateFingerprint(rawCerts[0])
+			if serverFingerprint != fingerprint {
+				return fmt.Errorf("server certificate fingerprint %s does not match the pinned fingerprint %s", serverFingerprint, fingerprint)
+			}
+
+			return nil
+		}
+	}
+
+	if serverApi.ClientCertFile != "" {
+		clientCert, err := tls.LoadX509KeyPair(serverApi.ClientCertFile, serverApi.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+)
+
+func getTransport(serverApi config.ServerApi) (http.RoundTripper, error) {
+	if serverApi.Socket != "" {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = nil
+		transport.DialContext = getSocketDialer(serverApi.Socket)
+
+		return transport, nil
+	}
+
+	tlsConfig, err := GetTlsConfig(serverApi)
+	if err != nil {
+		return nil, err
+	}
+
+	if tlsConfig == nil {
+		return http.DefaultTransport, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// getSocketDialer returns a dialer that connects to the socket regardless of the requested address
+func getSocketDialer(socketPath string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, "unix", socketPath)
+	}
+}
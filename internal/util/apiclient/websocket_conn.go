@@ -23,6 +23,7 @@ func GetWebsocketConn(path string, profile *config.Profile, query *string) (*web
 
 	var serverUrl string
 	var apiKey string
+	dialer := *websocket.DefaultDialer
 
 	if envApiUrl, ok := os.LookupEnv("DAYTONA_SERVER_API_URL"); ok {
 		serverUrl = envApiUrl
@@ -41,6 +42,15 @@ func GetWebsocketConn(path string, profile *config.Profile, query *string) (*web
 
 		serverUrl = activeProfile.Api.Url
 		apiKey = activeProfile.Api.Key
+
+		if activeProfile.Api.Socket != "" {
+			dialer.NetDialContext = getSocketDialer(activeProfile.Api.Socket)
+		} else {
+			dialer.TLSClientConfig, err = GetTlsConfig(activeProfile.Api)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
 	}
 
 	url, err := url.JoinPath(serverUrl, path)
@@ -57,7 +67,7 @@ func GetWebsocketConn(path string, profile *config.Profile, query *string) (*web
 		wsUrl = fmt.Sprintf("%s?%s", wsUrl, *query)
 	}
 
-	return websocket.DefaultDialer.Dial(wsUrl, http.Header{
+	return dialer.Dial(wsUrl, http.Header{
 		"Authorization": []string{fmt.Sprintf("Bearer %s", apiKey)},
 	})
 }
@@ -132,13 +132,11 @@ func (a *Agent) startProjectMode() error {
 		}
 	}()
 
-	err = a.runPostCreateCommands(project)
-	if err != nil {
-		log.Error(fmt.Sprintf("failed to run post create commands: %s", err))
-		log.Error("skipping post start commands...")
-	} else {
-		a.runPostStartCommands(project)
-	}
+	a.lifecycleMutex.Lock()
+	a.project = project
+	a.lifecycleMutex.Unlock()
+
+	a.runLifecycle(project)
 
 	return nil
 }
@@ -232,53 +230,11 @@ func (a *Agent) setDefaultConfig() error {
 	return config.Save()
 }
 
-func (a *Agent) runPostCreateCommands(project *workspace.Project) error {
-	if _, err := os.Stat(a.PostCreateLockFilePath); err == nil {
-		log.Info("Post create commands already ran. Skipping...")
-		return nil
-	}
-
-	if len(project.PostCreateCommands) == 0 {
-		log.Info("No post create commands to run")
-		return nil
-	}
-
-	log.Info("Running post create commands...")
-
-	var errCh = make(chan error)
-
-	for _, command := range project.PostCreateCommands {
-		go func() {
-			log.Info("Running command: " + command)
-			cmd := exec.Command("sh", "-c", command)
-			cmd.Dir = a.Config.ProjectDir
-			cmd.Stdout = io.MultiWriter(a.LogWriter, os.Stdout)
-			cmd.Stderr = io.MultiWriter(a.LogWriter, os.Stderr)
-
-			err := cmd.Run()
-			if err != nil {
-				log.Error(fmt.Sprintf("command '%s' failed: %v", command, err))
-			}
-			errCh <- err
-		}()
-	}
-
-	resultErr := errors.New("errors: ")
-	for range project.PostCreateCommands {
-		err := <-errCh
-		if err != nil {
-			resultErr = fmt.Errorf("%w %v", resultErr, err)
-		}
-	}
-
-	if resultErr.Error() != "errors: " {
-		return resultErr
+func (a *Agent) runPostStartCommands(project *workspace.Project) {
+	if len(project.PostStartCommands) == 0 {
+		return
 	}
 
-	return os.WriteFile(a.PostCreateLockFilePath, []byte{}, 0644)
-}
-
-func (a *Agent) runPostStartCommands(project *workspace.Project) {
 	log.Info("Running post start commands...")
 
 	for _, command := range project.PostStartCommands {
@@ -315,8 +271,9 @@ func (a *Agent) updateProjectState() error {
 
 	uptime := a.uptime()
 	res, err := apiClient.WorkspaceAPI.SetProjectState(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).SetState(apiclient.SetProjectState{
-		Uptime:    &uptime,
-		GitStatus: conversion.ToGitStatusDTO(gitStatus),
+		Uptime:         &uptime,
+		GitStatus:      conversion.ToGitStatusDTO(gitStatus),
+		LifecycleHooks: conversion.ToLifecycleHookStatusesDTO(a.getLifecycleHookStatuses()),
 	}).Execute()
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
@@ -164,3 +165,117 @@ func TestAgentHostMode(t *testing.T) {
 		mockTailscaleServer.AssertExpectations(t)
 	})
 }
+
+func TestAgentLifecycle(t *testing.T) {
+	buf := bytes.Buffer{}
+	log.SetOutput(&buf)
+
+	lifecycleProject := *project1
+	lifecycleProject.PostCreateCommands = nil
+	lifecycleProject.PostStartCommands = nil
+
+	startAgent := func(t *testing.T, lifecycle *workspace.ProjectLifecycle) *agent.Agent {
+		project := lifecycleProject
+		project.Lifecycle = lifecycle
+
+		apiServer := mocks.NewMockRestServer(t, &workspace.Workspace{
+			Id:       workspace1.Id,
+			Name:     workspace1.Name,
+			Target:   workspace1.Target,
+			Projects: []*workspace.Project{&project},
+		})
+		t.Cleanup(apiServer.Close)
+
+		mockGitService := mock_git.NewMockGitService()
+		mockGitService.On("RepositoryExists", mock.Anything).Return(true, nil)
+		mockGitService.On("SetGitConfig", mock.Anything).Return(nil)
+		mockGitService.On("GetGitStatus").Return(gitStatus1, nil)
+
+		mockConfig := *mockConfig
+		mockConfig.Server.ApiUrl = apiServer.URL
+		mockConfig.ProjectDir = t.TempDir()
+
+		a := &agent.Agent{
+			Config:                 &mockConfig,
+			Git:                    mockGitService,
+			Ssh:                    mocks.NewMockSshServer(),
+			Tailscale:              mocks.NewMockTailscaleServer(),
+			PostCreateLockFilePath: filepath.Join(t.TempDir(), ".daytona_post_create.lock"),
+			LifecycleLogsDir:       t.TempDir(),
+		}
+
+		err := a.Start()
+		require.Nil(t, err)
+
+		return a
+	}
+
+	t.Run("Runs hooks in order", func(t *testing.T) {
+		a := startAgent(t, &workspace.ProjectLifecycle{
+			OnCreate: []workspace.LifecycleCommandGroup{
+				{Commands: []workspace.LifecycleCommand{{Command: "echo 'onCreate' > onCreate.txt"}}},
+			},
+			PostCreate: []workspace.LifecycleCommandGroup{
+				{Commands: []workspace.LifecycleCommand{
+					{Name: "first", Command: "cat onCreate.txt > first.txt"},
+					{Name: "second", Args: []string{"touch", "second.txt"}},
+				}},
+			},
+			PostStart: []workspace.LifecycleCommandGroup{
+				{Commands: []workspace.LifecycleCommand{{Command: "touch postStart.txt"}}},
+			},
+			WaitFor: workspace.LifecycleHookPostCreate,
+		})
+
+		require.FileExists(t, filepath.Join(a.Config.ProjectDir, "first.txt"))
+		require.FileExists(t, filepath.Join(a.Config.ProjectDir, "second.txt"))
+		require.FileExists(t, a.PostCreateLockFilePath)
+		require.Eventually(t, func() bool {
+			_, err := os.Stat(filepath.Join(a.Config.ProjectDir, "postStart.txt"))
+			return err == nil
+		}, 5*time.Second, 10*time.Millisecond)
+
+		onCreateLog, err := os.ReadFile(filepath.Join(a.LifecycleLogsDir, "onCreate.log"))
+		require.Nil(t, err)
+		require.Empty(t, onCreateLog)
+		require.FileExists(t, filepath.Join(a.LifecycleLogsDir, "postCreate.log"))
+	})
+
+	t.Run("Skips hooks that ran while the image was built", func(t *testing.T) {
+		a := startAgent(t, &workspace.ProjectLifecycle{
+			OnCreate: []workspace.LifecycleCommandGroup{
+				{Commands: []workspace.LifecycleCommand{{Command: "touch onCreate.txt"}}},
+			},
+			UpdateContent: []workspace.LifecycleCommandGroup{
+				{Commands: []workspace.LifecycleCommand{{Command: "touch updateContent.txt"}}},
+			},
+			PostCreate: []workspace.LifecycleCommandGroup{
+				{Commands: []workspace.LifecycleCommand{{Command: "touch postCreate.txt"}}},
+			},
+			WaitFor:  workspace.LifecycleHookPostCreate,
+			Prebuilt: []workspace.LifecycleHook{workspace.LifecycleHookOnCreate, workspace.LifecycleHookUpdateContent},
+		})
+
+		require.FileExists(t, filepath.Join(a.Config.ProjectDir, "postCreate.txt"))
+		require.NoFileExists(t, filepath.Join(a.Config.ProjectDir, "onCreate.txt"))
+		require.NoFileExists(t, filepath.Join(a.Config.ProjectDir, "updateContent.txt"))
+	})
+
+	t.Run("Failing hook stops remaining hooks", func(t *testing.T) {
+		a := startAgent(t, &workspace.ProjectLifecycle{
+			UpdateContent: []workspace.LifecycleCommandGroup{
+				{Commands: []workspace.LifecycleCommand{{Command: "echo 'failed' && exit 1"}}},
+			},
+			PostCreate: []workspace.LifecycleCommandGroup{
+				{Commands: []workspace.LifecycleCommand{{Command: "touch postCreate.txt"}}},
+			},
+		})
+
+		require.NoFileExists(t, filepath.Join(a.Config.ProjectDir, "postCreate.txt"))
+		require.NoFileExists(t, a.PostCreateLockFilePath)
+
+		updateContentLog, err := os.ReadFile(filepath.Join(a.LifecycleLogsDir, "updateContent.log"))
+		require.Nil(t, err)
+		require.Contains(t, string(updateContentLog), "failed")
+	})
+}
@@ -6,6 +6,7 @@ package config
 import (
 	"errors"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
@@ -83,3 +84,7 @@ func GetLogFilePath() *string {
 
 	return &logFilePath
 }
+
+func GetLifecycleLogsDir() string {
+	return filepath.Join(os.Getenv("HOME"), ".daytona", "lifecycle")
+}
@@ -0,0 +1,257 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	log "github.com/sirupsen/logrus"
+)
+
+// runLifecycle runs the lifecycle hooks of the project in order and returns once the hook the project waits for finished.
+// The remaining hooks keep running in the background. A failing hook stops the hooks after it from running.
+// Hooks that already ran while the image was built are skipped.
+func (a *Agent) runLifecycle(project *workspace.Project) {
+	hooks := []workspace.LifecycleHook{}
+	if _, err := os.Stat(a.PostCreateLockFilePath); err == nil {
+		log.Info("Post create commands already ran. Skipping...")
+	} else {
+		for _, hook := range workspace.CreateLifecycleHooks {
+			if project.Lifecycle.IsPrebuilt(hook) {
+				log.Info(fmt.Sprintf("%s commands already ran while the image was built. Skipping...", hook))
+				continue
+			}
+			hooks = append(hooks, hook)
+		}
+	}
+	hooks = append(hooks, workspace.LifecycleHookPostStart)
+
+	// Projects without lifecycle hooks are ready once their post start commands started
+	waitFor := workspace.LifecycleHookPostStart
+	if project.Lifecycle != nil && project.Lifecycle.GetWaitFor() != workspace.LifecycleHookPostAttach {
+		waitFor = project.Lifecycle.GetWaitFor()
+	}
+
+	ready := make(chan struct{})
+	readyAfter := slices.Index(hooks, waitFor)
+	if readyAfter == -1 {
+		close(ready)
+	}
+
+	go func() {
+		for i, hook := range hooks {
+			err := a.runLifecycleHook(project, hook)
+			if err != nil {
+				log.Error(fmt.Sprintf("%s commands failed: %s", hook, err))
+				if i < len(hooks)-1 {
+					log.Error("skipping remaining lifecycle commands...")
+				}
+				break
+			}
+
+			if hook == workspace.LifecycleHookPostCreate {
+				err = os.WriteFile(a.PostCreateLockFilePath, []byte{}, 0644)
+				if err != nil {
+					log.Error(fmt.Sprintf("failed to write post create lock file: %s", err))
+				}
+			}
+
+			if i == readyAfter {
+				close(ready)
+			}
+		}
+
+		if readyAfter != -1 {
+			select {
+			case <-ready:
+			default:
+				close(ready)
+			}
+		}
+	}()
+
+	<-ready
+}
+
+// RunPostAttachHook runs the postAttach commands of the project in the background.
+// It is called when a client attaches to the project and does nothing while a previous run is in progress.
+func (a *Agent) RunPostAttachHook() {
+	a.lifecycleMutex.Lock()
+	project := a.project
+	if project == nil || len(project.Lifecycle.GetCommandGroups(workspace.LifecycleHookPostAttach)) == 0 || a.postAttachRunning {
+		a.lifecycleMutex.Unlock()
+		return
+	}
+	a.postAttachRunning = true
+	a.lifecycleMutex.Unlock()
+
+	go func() {
+		err := a.runLifecycleHook(project, workspace.LifecycleHookPostAttach)
+		if err != nil {
+			log.Error(fmt.Sprintf("%s commands failed: %s", workspace.LifecycleHookPostAttach, err))
+		}
+
+		a.lifecycleMutex.Lock()
+		a.postAttachRunning = false
+		a.lifecycleMutex.Unlock()
+	}()
+}
+
+// runLifecycleHook runs the command groups of the hook one after another and the commands of a group in parallel.
+// The output of the hook is written to its own log file in the lifecycle logs directory.
+func (a *Agent) runLifecycleHook(project *workspace.Project, hook workspace.LifecycleHook) error {
+	groups := project.Lifecycle.GetCommandGroups(hook)
+
+	switch hook {
+	case workspace.LifecycleHookPostCreate:
+		if len(project.PostCreateCommands) > 0 {
+			group := workspace.LifecycleCommandGroup{}
+			for _, command := range project.PostCreateCommands {
+				group.Commands = append(group.Commands, workspace.LifecycleCommand{Command: command})
+			}
+			groups = append(groups, group)
+		}
+	case workspace.LifecycleHookPostStart:
+		a.runPostStartCommands(project)
+	}
+
+	if len(groups) == 0 {
+		return nil
+	}
+
+	log.Info(fmt.Sprintf("Running %s commands...", hook))
+	a.setLifecycleHookStatus(hook, workspace.LifecycleHookStateRunning, nil)
+
+	logWriter, closeLog := a.getLifecycleLogWriter(hook)
+	defer closeLog()
+
+	var err error
+	for _, group := range groups {
+		err = a.runLifecycleCommandGroup(group, logWriter)
+		if err != nil {
+			break
+		}
+	}
+
+	if err != nil {
+		a.setLifecycleHookStatus(hook, workspace.LifecycleHookStateError, err)
+		return err
+	}
+
+	a.setLifecycleHookStatus(hook, workspace.LifecycleHookStateSuccess, nil)
+	return nil
+}
+
+func (a *Agent) runLifecycleCommandGroup(group workspace.LifecycleCommandGroup, logWriter io.Writer) error {
+	errCh := make(chan error)
+
+	for _, command := range group.Commands {
+		go func(command workspace.LifecycleCommand) {
+			log.Info("Running command: " + getLifecycleCommandString(command))
+
+			var cmd *exec.Cmd
+			if len(command.Args) > 0 {
+				cmd = exec.Command(command.Args[0], command.Args[1:]...)
+			} else {
+				cmd = exec.Command("sh", "-c", command.Command)
+			}
+			cmd.Dir = a.Config.ProjectDir
+			cmd.Stdout = logWriter
+			cmd.Stderr = logWriter
+
+			err := cmd.Run()
+			if err != nil {
+				err = fmt.Errorf("command '%s' failed: %w", getLifecycleCommandString(command), err)
+				log.Error(err)
+			}
+			errCh <- err
+		}(command)
+	}
+
+	var errs []error
+	for range group.Commands {
+		errs = append(errs, <-errCh)
+	}
+
+	return errors.Join(errs...)
+}
+
+// getLifecycleLogWriter returns a writer to the agent log, stdout and the log file of the hook
+func (a *Agent) getLifecycleLogWriter(hook workspace.LifecycleHook) (io.Writer, func()) {
+	writers := []io.Writer{os.Stdout}
+	if a.LogWriter != nil {
+		writers = append(writers, a.LogWriter)
+	}
+
+	if a.LifecycleLogsDir == "" {
+		return io.MultiWriter(writers...), func() {}
+	}
+
+	err := os.MkdirAll(a.LifecycleLogsDir, 0755)
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to create lifecycle logs directory: %s", err))
+		return io.MultiWriter(writers...), func() {}
+	}
+
+	logFile, err := os.Create(filepath.Join(a.LifecycleLogsDir, fmt.Sprintf("%s.log", hook)))
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to create %s log file: %s", hook, err))
+		return io.MultiWriter(writers...), func() {}
+	}
+
+	writers = append(writers, logFile)
+	return io.MultiWriter(writers...), func() {
+		logFile.Close()
+	}
+}
+
+func (a *Agent) setLifecycleHookStatus(hook workspace.LifecycleHook, state workspace.LifecycleHookState, err error) {
+	a.lifecycleMutex.Lock()
+	defer a.lifecycleMutex.Unlock()
+
+	status := workspace.LifecycleHookStatus{
+		Hook:  hook,
+		State: state,
+	}
+	if err != nil {
+		status.Error = err.Error()
+	}
+
+	for i, existing := range a.lifecycleHooks {
+		if existing.Hook == hook {
+			a.lifecycleHooks[i] = status
+			return
+		}
+	}
+
+	a.lifecycleHooks = append(a.lifecycleHooks, status)
+}
+
+func (a *Agent) getLifecycleHookStatuses() []workspace.LifecycleHookStatus {
+	a.lifecycleMutex.Lock()
+	defer a.lifecycleMutex.Unlock()
+
+	return slices.Clone(a.lifecycleHooks)
+}
+
+func getLifecycleCommandString(command workspace.LifecycleCommand) string {
+	commandString := command.Command
+	if len(command.Args) > 0 {
+		commandString = strings.Join(command.Args, " ")
+	}
+
+	if command.Name != "" {
+		return fmt.Sprintf("%s: %s", command.Name, commandString)
+	}
+
+	return commandString
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"sync"
 	"syscall"
 	"unsafe"
 
@@ -24,6 +25,11 @@ import (
 type Server struct {
 	ProjectDir        string
 	DefaultProjectDir string
+	// OnAttach is called when a client attaches to the project while no other session is open
+	OnAttach func()
+
+	sessionsMutex sync.Mutex
+	sessions      int
 }
 
 func (s *Server) Start() error {
@@ -33,6 +39,9 @@ func (s *Server) Start() error {
 	sshServer := ssh.Server{
 		Addr: fmt.Sprintf(":%d", config.SSH_PORT),
 		Handler: func(session ssh.Session) {
+			s.trackSession()
+			defer s.untrackSession()
+
 			switch ss := session.Subsystem(); ss {
 			case "":
 			case "sftp":
@@ -80,6 +89,23 @@ func (s *Server) Start() error {
 	return sshServer.ListenAndServe()
 }
 
+func (s *Server) trackSession() {
+	s.sessionsMutex.Lock()
+	defer s.sessionsMutex.Unlock()
+
+	s.sessions++
+	if s.sessions == 1 && s.OnAttach != nil {
+		go s.OnAttach()
+	}
+}
+
+func (s *Server) untrackSession() {
+	s.sessionsMutex.Lock()
+	defer s.sessionsMutex.Unlock()
+
+	s.sessions--
+}
+
 func (s *Server) handlePty(session ssh.Session, ptyReq ssh.Pty, winCh <-chan ssh.Window) {
 	shell := s.getShell()
 	cmd := exec.Command(shell)
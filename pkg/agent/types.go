@@ -5,10 +5,12 @@ package agent
 
 import (
 	"io"
+	"sync"
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/agent/config"
 	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
 type SshServer interface {
@@ -26,5 +28,12 @@ type Agent struct {
 	Tailscale              TailscaleServer
 	LogWriter              io.Writer
 	PostCreateLockFilePath string
-	startTime              time.Time
+	// LifecycleLogsDir holds a log file for each lifecycle hook
+	LifecycleLogsDir string
+	startTime        time.Time
+
+	lifecycleMutex    sync.Mutex
+	project           *workspace.Project
+	lifecycleHooks    []workspace.LifecycleHookStatus
+	postAttachRunning bool
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/pagination"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -17,19 +18,48 @@ import (
 //	@Summary		List API keys
 //	@Description	List API keys
 //	@Produce		json
-//	@Success		200	{array}	ApiKey
+//	@Success		200		{array}		ApiKey
+//	@Param			limit	query		int				false	"Maximum number of items to return"
+//	@Param			cursor	query		string			false	"Cursor from the X-Next-Cursor header of the previous page"
+//	@Param			sort	query		string			false	"Field to sort by, prefixed with - for descending order"
+//	@Param			fields	query		string			false	"Comma separated list of fields to return"
+//	@Header			200		{string}	X-Next-Cursor	"Cursor of the next page"
+//	@Header			200		{integer}	X-Total-Count	"Total number of items"
 //	@Router			/apikey [get]
 //
 //	@id				ListClientApiKeys
 func ListClientApiKeys(ctx *gin.Context) {
+	params, err := pagination.ParseListParams(ctx.Request.URL.Query())
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
-	response, err := server.ApiKeyService.ListClientKeys()
+	apiKeys, err := server.ApiKeyService.ListClientKeys()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get client API keys: %s", err.Error()))
+		return
+	}
+
+	result, err := pagination.Paginate(apiKeys, params, "name")
+	if err != nil {
+		if pagination.IsInvalidParams(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get client API keys: %s", err.Error()))
+		return
+	}
+
+	response, err := pagination.Project(result.Items, params.Fields)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get client API keys: %s", err.Error()))
 		return
 	}
 
+	pagination.SetHeaders(ctx.Writer.Header(), result)
 	ctx.JSON(200, response)
 }
 
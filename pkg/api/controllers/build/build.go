@@ -0,0 +1,128 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/gin-gonic/gin"
+)
+
+// ListBuilds godoc
+//
+//	@Tags			build
+//	@Summary		List builds
+//	@Description	List the cached builds
+//	@Produce		json
+//	@Success		200	{array}	BuildInfo
+//	@Router			/build [get]
+//
+//	@id				ListBuilds
+func ListBuilds(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	buildList, err := server.BuildService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list builds: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, buildList)
+}
+
+// ListBuildJobs godoc
+//
+//	@Tags			build
+//	@Summary		List build jobs
+//	@Description	List the queued, running and recently finished build jobs
+//	@Produce		json
+//	@Success		200	{array}	BuildJob
+//	@Router			/build/jobs [get]
+//
+//	@id				ListBuildJobs
+func ListBuildJobs(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	ctx.JSON(200, server.BuildService.ListJobs())
+}
+
+// GetBuildJob godoc
+//
+//	@Tags			build
+//	@Summary		Get build job
+//	@Description	Get the state and queue position of a build job
+//	@Produce		json
+//	@Param			buildId	path		string	true	"Build ID"
+//	@Success		200		{object}	BuildJob
+//	@Router			/build/jobs/{buildId} [get]
+//
+//	@id				GetBuildJob
+func GetBuildJob(ctx *gin.Context) {
+	buildId := ctx.Param("buildId")
+
+	server := server.GetInstance(nil)
+
+	job, err := server.BuildService.GetJob(buildId)
+	if err != nil {
+		if errors.Is(err, builds.ErrBuildJobNotFound) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get build job: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, job)
+}
+
+// PruneBuilds godoc
+//
+//	@Tags			build
+//	@Summary		Prune builds
+//	@Description	Delete cached builds with their cloned sources and registry images.
+//	@Description	Images that a workspace references are kept in the registry.
+//	@Description	Builds of a configuration that is queued or running are skipped.
+//	@Produce		json
+//	@Param			olderThan	query	string	false	"Only prune builds last used before this duration, e.g. 7d or 12h"
+//	@Param			unused		query	bool	false	"Only prune builds that no workspace references"
+//	@Success		200			{array}	BuildInfo
+//	@Router			/build/prune [post]
+//
+//	@id				PruneBuilds
+func PruneBuilds(ctx *gin.Context) {
+	var options builds.PruneOptions
+	var err error
+
+	if olderThan := ctx.Query("olderThan"); olderThan != "" {
+		options.OlderThan, err = builds.ParseAge(olderThan)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid olderThan: %s", err.Error()))
+			return
+		}
+	}
+
+	if unused := ctx.Query("unused"); unused != "" {
+		options.Unused, err = strconv.ParseBool(unused)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid unused: %s", err.Error()))
+			return
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	pruned, err := server.BuildService.Prune(options)
+	if err != nil {
+		// The builds that were pruned before the failures are returned in the error details
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to prune builds, %d build(s) pruned: %s", len(pruned), err.Error())).SetMeta(pruned)
+		return
+	}
+
+	ctx.JSON(200, pruned)
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package event
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// StreamEvents streams server events to the client.
+// Websocket upgrade requests receive each event as a JSON message, all other requests get a text/event-stream response.
+// The workspaceId query parameter and the comma separated types query parameter filter the events.
+func StreamEvents(ginCtx *gin.Context) {
+	filter := events.EventFilter{
+		WorkspaceId: ginCtx.Query("workspaceId"),
+	}
+
+	if types := ginCtx.Query("types"); types != "" {
+		for _, eventType := range strings.Split(types, ",") {
+			filter.Types = append(filter.Types, events.EventType(strings.TrimSpace(eventType)))
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	if websocket.IsWebSocketUpgrade(ginCtx.Request) {
+		streamWebsocketEvents(ginCtx, server.EventService, filter)
+		return
+	}
+
+	streamSSEEvents(ginCtx, server.EventService, filter)
+}
+
+func streamSSEEvents(ginCtx *gin.Context, eventService events.IEventService, filter events.EventFilter) {
+	eventCh, unsubscribe := eventService.Subscribe(filter)
+	defer unsubscribe()
+
+	ginCtx.Header("Content-Type", "text/event-stream")
+	ginCtx.Header("Cache-Control", "no-cache")
+	ginCtx.Header("Connection", "keep-alive")
+
+	ginCtx.Stream(func(w io.Writer) bool {
+		select {
+		case <-ginCtx.Request.Context().Done():
+			return false
+		case event, ok := <-eventCh:
+			if !ok {
+				return false
+			}
+			ginCtx.SSEvent(string(event.Type), event)
+			return true
+		}
+	})
+}
+
+func streamWebsocketEvents(ginCtx *gin.Context, eventService events.IEventService, filter events.EventFilter) {
+	ws, err := upgrader.Upgrade(ginCtx.Writer, ginCtx.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer ws.Close()
+
+	eventCh, unsubscribe := eventService.Subscribe(filter)
+	defer unsubscribe()
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			_, _, err := ws.ReadMessage()
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case event, ok := <-eventCh:
+			if !ok {
+				return
+			}
+			err := ws.WriteJSON(event)
+			if err != nil {
+				return
+			}
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"net/url"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/pagination"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -20,25 +21,54 @@ import (
 //	@Summary		List Git providers
 //	@Description	List Git providers
 //	@Produce		json
-//	@Success		200	{array}	gitprovider.GitProviderConfig
+//	@Success		200		{array}		gitprovider.GitProviderConfig
+//	@Param			limit	query		int				false	"Maximum number of items to return"
+//	@Param			cursor	query		string			false	"Cursor from the X-Next-Cursor header of the previous page"
+//	@Param			sort	query		string			false	"Field to sort by, prefixed with - for descending order"
+//	@Param			fields	query		string			false	"Comma separated list of fields to return"
+//	@Header			200		{string}	X-Next-Cursor	"Cursor of the next page"
+//	@Header			200		{integer}	X-Total-Count	"Total number of items"
 //	@Router			/gitprovider [get]
 //
 //	@id				ListGitProviders
 func ListGitProviders(ctx *gin.Context) {
-	var response []*gitprovider.GitProviderConfig
+	var gitProviders []*gitprovider.GitProviderConfig
+
+	params, err := pagination.ParseListParams(ctx.Request.URL.Query())
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
 
 	server := server.GetInstance(nil)
 
-	response, err := server.GitProviderService.ListConfigs()
+	gitProviders, err = server.GitProviderService.ListConfigs()
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list git providers: %s", err.Error()))
 		return
 	}
 
-	for _, provider := range response {
+	for _, provider := range gitProviders {
 		provider.Token = ""
 	}
 
+	result, err := pagination.Paginate(gitProviders, params, "id")
+	if err != nil {
+		if pagination.IsInvalidParams(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list git providers: %s", err.Error()))
+		return
+	}
+
+	response, err := pagination.Project(result.Items, params.Fields)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list git providers: %s", err.Error()))
+		return
+	}
+
+	pagination.SetHeaders(ctx.Writer.Header(), result)
 	ctx.JSON(200, response)
 }
 
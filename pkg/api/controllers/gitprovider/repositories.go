@@ -7,19 +7,28 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/pagination"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
 
+const defaultRepositoriesPageSize = 100
+
 // GetRepositories 			godoc
 //
 //	@Tags			gitProvider
 //	@Summary		Get Git repositories
-//	@Description	Get Git repositories
+//	@Description	Get Git repositories. Pages are fetched from the Git provider, so sorting only applies within a page.
 //	@Param			gitProviderId	path	string	true	"Git provider"
 //	@Param			namespaceId		path	string	true	"Namespace"
+//	@Param			limit			query	int		false	"Maximum number of items to return"
+//	@Param			cursor			query	string	false	"Cursor from the X-Next-Cursor header of the previous page"
+//	@Param			sort			query	string	false	"Field to sort by, prefixed with - for descending order"
+//	@Param			fields			query	string	false	"Comma separated list of fields to return"
 //	@Produce		json
-//	@Success		200	{array}	GitRepository
+//	@Success		200	{array}		GitRepository
+//	@Header			200	{string}	X-Next-Cursor	"Cursor of the next page"
 //	@Router			/gitprovider/{gitProviderId}/{namespaceId}/repositories [get]
 //
 //	@id				GetRepositories
@@ -27,13 +36,59 @@ func GetRepositories(ctx *gin.Context) {
 	gitProviderId := ctx.Param("gitProviderId")
 	namespaceId := ctx.Param("namespaceId")
 
+	params, err := pagination.ParseListParams(ctx.Request.URL.Query())
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	// The repositories cursor holds the index of the last page fetched from the provider
+	lastPage, err := pagination.DecodePageCursor(params.Cursor)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	listOptions := gitprovider.ListOptions{
+		Page:    lastPage + 1,
+		PerPage: defaultRepositoriesPageSize,
+	}
+	if params.Limit > 0 && params.Limit < defaultRepositoriesPageSize {
+		listOptions.PerPage = params.Limit
+	}
+
 	server := server.GetInstance(nil)
 
-	response, err := server.GitProviderService.GetRepositories(gitProviderId, namespaceId)
+	repositories, err := server.GitProviderService.GetRepositories(gitProviderId, namespaceId, listOptions)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get repositories for url: %s", err.Error()))
+		return
+	}
+
+	err = pagination.Sort(repositories, params.Sort)
+	if err != nil {
+		if pagination.IsInvalidParams(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get repositories for url: %s", err.Error()))
+		return
+	}
+
+	result := &pagination.Result[*gitprovider.GitRepository]{
+		Items: repositories,
+		Total: -1,
+	}
+	if len(repositories) >= listOptions.PerPage {
+		result.NextCursor = pagination.EncodePageCursor(listOptions.Page)
+	}
+
+	response, err := pagination.Project(result.Items, params.Fields)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get repositories for url: %s", err.Error()))
 		return
 	}
 
+	pagination.SetHeaders(ctx.Writer.Header(), result)
 	ctx.JSON(200, response)
 }
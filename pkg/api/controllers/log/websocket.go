@@ -7,6 +7,7 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"os"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/server"
@@ -186,3 +187,21 @@ func ReadProjectLog(ginCtx *gin.Context) {
 
 	readJSONLog(ginCtx, projectLogReader)
 }
+
+func ReadBuildLog(ginCtx *gin.Context) {
+	buildId := ginCtx.Param("buildId")
+
+	server := server.GetInstance(nil)
+
+	buildLogReader, err := server.BuildService.GetJobLogReader(buildId)
+	if err != nil {
+		if os.IsNotExist(err) {
+			ginCtx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ginCtx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	readJSONLog(ginCtx, buildLogReader)
+}
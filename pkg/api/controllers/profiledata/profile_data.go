@@ -16,7 +16,7 @@ import (
 //
 //	@Tags			profile
 //	@Summary		Get profile data
-//	@Description	Get profile data
+//	@Description	Get profile data. Values of build secrets are replaced with a placeholder.
 //	@Accept			json
 //	@Success		200 {object} profiledata.ProfileData
 //	@Router			/profile [get]
@@ -34,14 +34,15 @@ func GetProfileData(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(200, profileData)
+	// Build secrets are only passed to builds on the server, so their values are never returned
+	ctx.JSON(200, profileData.MaskBuildSecrets())
 }
 
 // SetProfileData godoc
 //
 //	@Tags			profile
 //	@Summary		Set profile data
-//	@Description	Set profile data
+//	@Description	Set profile data. Masked build secret values sent back unchanged keep their existing value.
 //	@Accept			json
 //	@Param			profileData	body	profiledata.ProfileData	true	"Profile data"
 //	@Success		201
@@ -57,6 +58,14 @@ func SetProfileData(ctx *gin.Context) {
 	}
 
 	server := server.GetInstance(nil)
+
+	existingProfileData, err := server.ProfileDataService.Get()
+	if err != nil && !profiledata.IsProfileDataNotFound(err) {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get profile data: %s", err.Error()))
+		return
+	}
+	req.UnmaskBuildSecrets(existingProfileData)
+
 	err = server.ProfileDataService.Save(&req)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to save profile data: %s", err.Error()))
@@ -5,14 +5,27 @@ package dto
 
 import (
 	"github.com/daytonaio/daytona/pkg/os"
+	"github.com/daytonaio/daytona/pkg/provider"
 )
 
 type Provider struct {
 	Name    string `json:"name"`
 	Version string `json:"version"`
+	// State is one of up, restarting or failed
+	State        string                         `json:"state"`
+	LastError    string                         `json:"lastError,omitempty"`
+	Capabilities *provider.ProviderCapabilities `json:"capabilities,omitempty"`
 } //	@name	Provider
 
 type InstallProviderRequest struct {
-	Name         string                        `json:"name"`
+	Name string `json:"name"`
+	// Version is reinstalled from the versions kept on the server if available
+	Version      string                        `json:"version,omitempty"`
 	DownloadUrls map[os.OperatingSystem]string `json:"downloadUrls"`
+	// Checksums holds the hex encoded SHA-256 digest of each binary
+	Checksums map[os.OperatingSystem]string `json:"checksums,omitempty"`
+	// Signatures holds the base64 encoded Ed25519 signature of each binary's SHA-256 digest
+	Signatures map[os.OperatingSystem]string `json:"signatures,omitempty"`
+	// AllowUnsigned installs the provider even if it has no checksum or signature
+	AllowUnsigned bool `json:"allowUnsigned,omitempty"`
 } //	@name	InstallProviderRequest
@@ -6,8 +6,11 @@ package provider
 import (
 	"fmt"
 	"net/http"
+	"path/filepath"
+	"strings"
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider/dto"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -31,26 +34,75 @@ func InstallProvider(ctx *gin.Context) {
 		return
 	}
 
+	if !isValidProviderName(req.Name) {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid provider name: %s", req.Name))
+		return
+	}
+
+	version := manager.Version{
+		DownloadUrls: req.DownloadUrls,
+		Checksums:    req.Checksums,
+		Signatures:   req.Signatures,
+	}
+
 	server := server.GetInstance(nil)
-	if _, err := server.ProviderManager.GetProvider(req.Name); err == nil {
-		err := server.ProviderManager.UninstallProvider(req.Name)
-		if err != nil {
-			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to uninstall current provider: %s", err.Error()))
-			return
-		}
+	err = server.ProviderManager.InstallProvider(req.Name, req.Version, version, req.AllowUnsigned)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
 	}
 
-	downloadPath, err := server.ProviderManager.DownloadProvider(req.DownloadUrls, req.Name, true)
+	ctx.Status(200)
+}
+
+// InstallProviderFromFile godoc
+//
+//	@Tags			provider
+//	@Summary		Install a provider from an uploaded binary
+//	@Description	Install a provider from an uploaded binary
+//	@Accept			multipart/form-data
+//	@Param			name			formData	string	true	"Provider name"
+//	@Param			file			formData	file	true	"Provider binary"
+//	@Param			checksum		formData	string	false	"Hex encoded SHA-256 digest of the binary"
+//	@Param			signature		formData	string	false	"Base64 encoded Ed25519 signature of the digest"
+//	@Param			allowUnsigned	formData	bool	false	"Install the provider even if it has no checksum or signature"
+//	@Success		200
+//	@Router			/provider/install/file [post]
+//
+//	@id				InstallProviderFromFile
+func InstallProviderFromFile(ctx *gin.Context) {
+	name := ctx.PostForm("name")
+	if !isValidProviderName(name) {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid provider name: %s", name))
+		return
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid provider file: %s", err.Error()))
+		return
+	}
+
+	file, err := fileHeader.Open()
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to download provider: %s", err.Error()))
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to read provider file: %s", err.Error()))
 		return
 	}
+	defer file.Close()
+
+	allowUnsigned := ctx.PostForm("allowUnsigned") == "true"
 
-	err = server.ProviderManager.RegisterProvider(downloadPath)
+	server := server.GetInstance(nil)
+	err = server.ProviderManager.InstallProviderBinary(file, name, ctx.PostForm("checksum"), ctx.PostForm("signature"), allowUnsigned)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to register provider: %s", err.Error()))
+		ctx.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
 
 	ctx.Status(200)
 }
+
+// isValidProviderName makes sure a provider name can be used as a directory name in the providers directory
+func isValidProviderName(name string) bool {
+	return name != "" && filepath.Base(name) == name && !strings.HasPrefix(name, ".")
+}
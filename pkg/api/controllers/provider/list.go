@@ -4,9 +4,6 @@
 package provider
 
 import (
-	"fmt"
-	"net/http"
-
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider/dto"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
@@ -24,19 +21,17 @@ import (
 //	@id				ListProviders
 func ListProviders(ctx *gin.Context) {
 	server := server.GetInstance(nil)
-	providers := server.ProviderManager.GetProviders()
 
 	result := []dto.Provider{}
-	for _, provider := range providers {
-		info, err := provider.GetInfo()
-		if err != nil {
-			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get provider: %s", err.Error()))
-			return
-		}
+	for _, health := range server.ProviderManager.GetProvidersHealth() {
+		capabilities, _ := server.ProviderManager.GetProviderCapabilities(health.Name)
 
 		result = append(result, dto.Provider{
-			Name:    info.Name,
-			Version: info.Version,
+			Name:         health.Name,
+			Version:      health.Version,
+			State:        string(health.State),
+			LastError:    health.LastError,
+			Capabilities: capabilities,
 		})
 	}
 
@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/provider/dto"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// RollbackProvider godoc
+//
+//	@Tags			provider
+//	@Summary		Roll back a provider
+//	@Description	Reinstall the previous installed version of a provider and pin the provider to it
+//	@Produce		json
+//	@Param			provider	path		string	true	"Provider to roll back"
+//	@Success		200			{object}	Provider
+//	@Router			/provider/{provider}/rollback [post]
+//
+//	@id				RollbackProvider
+func RollbackProvider(ctx *gin.Context) {
+	provider := ctx.Param("provider")
+	if !isValidProviderName(provider) {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid provider name: %s", provider))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	version, err := server.ProviderManager.RollbackProvider(provider)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to roll back provider: %s", err.Error()))
+		return
+	}
+
+	err = pinProviderVersion(provider, version)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to pin provider version: %s", err.Error()))
+		return
+	}
+
+	capabilities, _ := server.ProviderManager.GetProviderCapabilities(provider)
+
+	ctx.JSON(200, dto.Provider{
+		Name:         provider,
+		Version:      version,
+		State:        string(manager.ProviderStateUp),
+		Capabilities: capabilities,
+	})
+}
+
+// pinProviderVersion pins a provider to a version in the server config so that updates don't move past it
+func pinProviderVersion(providerName string, version string) error {
+	c, err := server.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	if c.PinnedProviderVersions == nil {
+		c.PinnedProviderVersions = map[string]string{}
+	}
+	c.PinnedProviderVersions[providerName] = version
+
+	return server.Save(*c)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/pagination"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -15,13 +16,25 @@ import (
 //
 //	@Tags			target
 //	@Summary		List targets
-//	@Description	List targets
+//	@Description	List targets. Values of masked options are replaced with a placeholder.
 //	@Produce		json
-//	@Success		200	{array}	ProviderTarget
+//	@Success		200		{array}		ProviderTarget
+//	@Param			limit	query		int				false	"Maximum number of items to return"
+//	@Param			cursor	query		string			false	"Cursor from the X-Next-Cursor header of the previous page"
+//	@Param			sort	query		string			false	"Field to sort by, prefixed with - for descending order"
+//	@Param			fields	query		string			false	"Comma separated list of fields to return"
+//	@Header			200		{string}	X-Next-Cursor	"Cursor of the next page"
+//	@Header			200		{integer}	X-Total-Count	"Total number of items"
 //	@Router			/target [get]
 //
 //	@id				ListTargets
 func ListTargets(ctx *gin.Context) {
+	params, err := pagination.ParseListParams(ctx.Request.URL.Query())
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
 	targets, err := server.ProviderTargetService.List()
@@ -30,5 +43,28 @@ func ListTargets(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(200, targets)
+	result, err := pagination.Paginate(targets, params, "name")
+	if err != nil {
+		if pagination.IsInvalidParams(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list targets: %s", err.Error()))
+		return
+	}
+
+	items, err := maskTargets(result.Items)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list targets: %s", err.Error()))
+		return
+	}
+
+	response, err := pagination.Project(items, params.Fields)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list targets: %s", err.Error()))
+		return
+	}
+
+	pagination.SetHeaders(ctx.Writer.Header(), result)
+	ctx.JSON(200, response)
 }
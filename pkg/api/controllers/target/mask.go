@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"encoding/json"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+)
+
+// maskTargets returns copies of the targets with masked options hidden.
+// If the target manifest of a provider can't be fetched, all options of its targets are masked.
+func maskTargets(targets []*provider.ProviderTarget) ([]*provider.ProviderTarget, error) {
+	server := server.GetInstance(nil)
+
+	manifests := map[string]*provider.ProviderTargetManifest{}
+	getTargetManifest := func(providerName string) *provider.ProviderTargetManifest {
+		if manifest, ok := manifests[providerName]; ok {
+			return manifest
+		}
+
+		var manifest *provider.ProviderTargetManifest
+		p, err := server.ProviderManager.GetProvider(providerName)
+		if err == nil {
+			manifest, err = (*p).GetTargetManifest()
+			if err != nil {
+				manifest = nil
+			}
+		}
+
+		manifests[providerName] = manifest
+		return manifest
+	}
+
+	result := []*provider.ProviderTarget{}
+	for _, target := range targets {
+		masked := *target
+
+		manifest := getTargetManifest(target.ProviderInfo.Name)
+		if manifest == nil {
+			manifest = maskAllManifest(target.Options)
+		}
+
+		options, err := manifest.MaskOptions(target.Options)
+		if err != nil {
+			return nil, err
+		}
+		masked.Options = options
+
+		result = append(result, &masked)
+	}
+
+	return result, nil
+}
+
+// maskAllManifest returns a manifest that masks every one of the options
+func maskAllManifest(options string) *provider.ProviderTargetManifest {
+	manifest := provider.ProviderTargetManifest{}
+
+	values := map[string]interface{}{}
+	if json.Unmarshal([]byte(options), &values) == nil {
+		for name := range values {
+			manifest[name] = provider.ProviderTargetProperty{InputMasked: true}
+		}
+	}
+
+	return &manifest
+}
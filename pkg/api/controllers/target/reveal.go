@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// RevealTarget godoc
+//
+//	@Tags			target
+//	@Summary		Reveal a target
+//	@Description	Get a target including the values of masked options. Not available to workspace and project API keys.
+//	@Produce		json
+//	@Param			target	path		string	true	"Target name"
+//	@Success		200		{object}	ProviderTarget
+//	@Router			/target/{target}/reveal [get]
+//
+//	@id				RevealTarget
+func RevealTarget(ctx *gin.Context) {
+	targetName := ctx.Param("target")
+
+	server := server.GetInstance(nil)
+
+	target, err := server.ProviderTargetService.Find(targetName)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to find target: %s", err.Error()))
+		return
+	}
+
+	log.Infof("Masked options of target %s revealed", targetName)
+
+	ctx.JSON(200, target)
+}
@@ -4,6 +4,7 @@
 package target
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -16,7 +17,8 @@ import (
 //
 //	@Tags			target
 //	@Summary		Set a target
-//	@Description	Set a target
+//	@Description	Set a target. Options are validated against the target manifest of the provider.
+//	@Description	Masked options sent back unchanged keep their existing value.
 //	@Param			target	body	ProviderTarget	true	"Target to set"
 //	@Success		201
 //	@Router			/target [put]
@@ -32,8 +34,42 @@ func SetTarget(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
+	p, err := server.ProviderManager.GetProvider(req.ProviderInfo.Name)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to get provider %s: %s", req.ProviderInfo.Name, err.Error()))
+		return
+	}
+
+	targetManifest, err := (*p).GetTargetManifest()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get target manifest: %s", err.Error()))
+		return
+	}
+
+	existingOptions := ""
 	target, err := server.ProviderTargetService.Find(req.Name)
 	if err == nil {
+		existingOptions = target.Options
+	}
+
+	req.Options, err = targetManifest.UnmaskOptions(req.Options, existingOptions)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid target options: %s", err.Error()))
+		return
+	}
+
+	err = targetManifest.ValidateOptions(req.Name, req.Options)
+	if err != nil {
+		var optionErrors provider.TargetOptionsError
+		if errors.As(err, &optionErrors) {
+			ctx.AbortWithError(http.StatusBadRequest, err).SetMeta(optionErrors)
+			return
+		}
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	if target != nil {
 		target.Options = req.Options
 		target.ProviderInfo = req.ProviderInfo
 	} else {
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// testTargetTimeout bounds a target test, which may create and destroy a workspace
+const testTargetTimeout = 5 * time.Minute
+
+// TestTarget godoc
+//
+//	@Tags			target
+//	@Summary		Test a target
+//	@Description	Check that the provider can reach the target with its options.
+//	@Description	Providers that can't check targets directly create and destroy an empty workspace.
+//	@Produce		json
+//	@Param			target	path		string	true	"Target name"
+//	@Success		200		{object}	TargetTestResult
+//	@Router			/target/{target}/test [post]
+//
+//	@id				TestTarget
+func TestTarget(ctx *gin.Context) {
+	targetName := ctx.Param("target")
+
+	server := server.GetInstance(nil)
+
+	target, err := server.ProviderTargetService.Find(targetName)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to find target: %s", err.Error()))
+		return
+	}
+
+	testCtx, cancel := context.WithTimeout(ctx.Request.Context(), testTargetTimeout)
+	defer cancel()
+
+	result, err := server.Provisioner.TestTarget(testCtx, target)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to test target: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, result)
+}
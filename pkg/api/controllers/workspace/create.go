@@ -17,7 +17,8 @@ import (
 //	@Tags			workspace
 //	@Summary		Create a workspace
 //	@Description	Create a workspace
-//	@Param			workspace	body	CreateWorkspaceRequest	true	"Create workspace"
+//	@Param			workspace		body	CreateWorkspaceRequest	true	"Create workspace"
+//	@Param			Idempotency-Key	header	string					false	"Key to safely retry the request. Repeated requests with the same key return the workspace created by the original request"
 //	@Produce		json
 //	@Success		200	{object}	Workspace
 //	@Router			/workspace [post]
@@ -41,3 +42,16 @@ func CreateWorkspace(ctx *gin.Context) {
 
 	ctx.JSON(200, w)
 }
+
+// ReplayCreateWorkspace responds to a repeated create request with the workspace created by the original request
+func ReplayCreateWorkspace(ctx *gin.Context, workspaceId string) {
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.FindWorkspace(workspaceId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to find created workspace: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
@@ -6,6 +6,7 @@ package dto
 import "github.com/daytonaio/daytona/pkg/workspace"
 
 type SetProjectState struct {
-	Uptime    uint64              `json:"uptime"`
-	GitStatus workspace.GitStatus `json:"gitStatus"`
+	Uptime         uint64                          `json:"uptime"`
+	GitStatus      workspace.GitStatus             `json:"gitStatus"`
+	LifecycleHooks []workspace.LifecycleHookStatus `json:"lifecycleHooks,omitempty"`
 } // @name SetProjectState
@@ -40,9 +40,10 @@ func SetProjectState(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
 	_, err = server.WorkspaceService.SetProjectState(workspaceId, projectId, &workspace.ProjectState{
-		Uptime:    setProjectStateDTO.Uptime,
-		UpdatedAt: time.Now().Format(time.RFC1123),
-		GitStatus: &setProjectStateDTO.GitStatus,
+		Uptime:         setProjectStateDTO.Uptime,
+		UpdatedAt:      time.Now().Format(time.RFC1123),
+		GitStatus:      &setProjectStateDTO.GitStatus,
+		LifecycleHooks: setProjectStateDTO.LifecycleHooks,
 	})
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %s", workspaceId, err.Error()))
@@ -51,3 +52,30 @@ func SetProjectState(ctx *gin.Context) {
 
 	ctx.Status(200)
 }
+
+// GetProjectImageStatus 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get project image status
+//	@Description	Check if the project image is stale relative to the HEAD of the project repository
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	ProjectImageStatus
+//	@Router			/workspace/{workspaceId}/{projectId}/image-status [get]
+//
+//	@id				GetProjectImageStatus
+func GetProjectImageStatus(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	status, err := server.WorkspaceService.GetProjectImageStatus(workspaceId, projectId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get image status of project %s: %s", projectId, err.Error()))
+		return
+	}
+
+	ctx.JSON(200, status)
+}
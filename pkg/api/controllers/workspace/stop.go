@@ -4,9 +4,11 @@
 package workspace
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -27,6 +29,10 @@ func StopWorkspace(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
 	err := server.WorkspaceService.StopWorkspace(workspaceId)
+	if errors.Is(err, provisioner.ErrStopNotSupported) {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to stop workspace %s: %s", workspaceId, err.Error()))
+		return
+	}
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %s", workspaceId, err.Error()))
 		return
@@ -53,6 +59,10 @@ func StopProject(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
 	err := server.WorkspaceService.StopProject(workspaceId, projectId)
+	if errors.Is(err, provisioner.ErrStopNotSupported) {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to stop project %s: %s", projectId, err.Error()))
+		return
+	}
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop project %s: %s", projectId, err.Error()))
 		return
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/daytonaio/daytona/pkg/pagination"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -46,7 +47,13 @@ func GetWorkspace(ctx *gin.Context) {
 //	@Produce		json
 //	@Success		200	{array}	WorkspaceDTO
 //	@Router			/workspace [get]
-//	@Param			verbose	query	bool	false	"Verbose"
+//	@Param			verbose	query		bool			false	"Verbose"
+//	@Param			limit	query		int				false	"Maximum number of items to return"
+//	@Param			cursor	query		string			false	"Cursor from the X-Next-Cursor header of the previous page"
+//	@Param			sort	query		string			false	"Field to sort by, prefixed with - for descending order"
+//	@Param			fields	query		string			false	"Comma separated list of fields to return"
+//	@Header			200		{string}	X-Next-Cursor	"Cursor of the next page"
+//	@Header			200		{integer}	X-Total-Count	"Total number of items"
 //
 //	@id				ListWorkspaces
 func ListWorkspaces(ctx *gin.Context) {
@@ -62,15 +69,32 @@ func ListWorkspaces(ctx *gin.Context) {
 		}
 	}
 
+	params, err := pagination.ParseListParams(ctx.Request.URL.Query())
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
-	workspaceList, err := server.WorkspaceService.ListWorkspaces(verbose)
+	result, err := server.WorkspaceService.ListWorkspaces(verbose, params)
+	if err != nil {
+		if pagination.IsInvalidParams(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list workspaces: %s", err.Error()))
+		return
+	}
+
+	response, err := pagination.Project(result.Items, params.Fields)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list workspaces: %s", err.Error()))
 		return
 	}
 
-	ctx.JSON(200, workspaceList)
+	pagination.SetHeaders(ctx.Writer.Header(), result)
+	ctx.JSON(200, response)
 }
 
 // RemoveWorkspace 			godoc
@@ -26,6 +26,32 @@ const docTemplate = `{
                 ],
                 "summary": "List API keys",
                 "operationId": "ListClientApiKeys",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of items to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the X-Next-Cursor header of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field to sort by, prefixed with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated list of fields to return",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -34,6 +60,16 @@ const docTemplate = `{
                             "items": {
                                 "$ref": "#/definitions/ApiKey"
                             }
+                        },
+                        "headers": {
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor of the next page"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of items"
+                            }
                         }
                     }
                 }
@@ -91,6 +127,122 @@ const docTemplate = `{
                 }
             }
         },
+        "/build": {
+            "get": {
+                "description": "List the cached builds",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "build"
+                ],
+                "summary": "List builds",
+                "operationId": "ListBuilds",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/BuildInfo"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/build/jobs": {
+            "get": {
+                "description": "List the queued, running and recently finished build jobs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "build"
+                ],
+                "summary": "List build jobs",
+                "operationId": "ListBuildJobs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/BuildJob"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/build/jobs/{buildId}": {
+            "get": {
+                "description": "Get the state and queue position of a build job",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "build"
+                ],
+                "summary": "Get build job",
+                "operationId": "GetBuildJob",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildJob"
+                        }
+                    }
+                }
+            }
+        },
+        "/build/prune": {
+            "post": {
+                "description": "Delete cached builds with their cloned sources and registry images.\nImages that a workspace references are kept in the registry.\nBuilds of a configuration that is queued or running are skipped.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "build"
+                ],
+                "summary": "Prune builds",
+                "operationId": "PruneBuilds",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only prune builds last used before this duration, e.g. 7d or 12h",
+                        "name": "olderThan",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only prune builds that no workspace references",
+                        "name": "unused",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/BuildInfo"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/container-registry": {
             "get": {
                 "description": "List container registries",
@@ -209,6 +361,32 @@ const docTemplate = `{
                 ],
                 "summary": "List Git providers",
                 "operationId": "ListGitProviders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of items to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the X-Next-Cursor header of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field to sort by, prefixed with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated list of fields to return",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -217,6 +395,16 @@ const docTemplate = `{
                             "items": {
                                 "$ref": "#/definitions/GitProvider"
                             }
+                        },
+                        "headers": {
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor of the next page"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of items"
+                            }
                         }
                     }
                 }
@@ -401,7 +589,7 @@ const docTemplate = `{
         },
         "/gitprovider/{gitProviderId}/{namespaceId}/repositories": {
             "get": {
-                "description": "Get Git repositories",
+                "description": "Get Git repositories. Pages are fetched from the Git provider, so sorting only applies within a page.",
                 "produces": [
                     "application/json"
                 ],
@@ -424,6 +612,30 @@ const docTemplate = `{
                         "name": "namespaceId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of items to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the X-Next-Cursor header of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field to sort by, prefixed with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated list of fields to return",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -434,6 +646,12 @@ const docTemplate = `{
                             "items": {
                                 "$ref": "#/definitions/GitRepository"
                             }
+                        },
+                        "headers": {
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor of the next page"
+                            }
                         }
                     }
                 }
@@ -535,7 +753,7 @@ const docTemplate = `{
         },
         "/profile": {
             "get": {
-                "description": "Get profile data",
+                "description": "Get profile data. Values of build secrets are replaced with a placeholder.",
                 "consumes": [
                     "application/json"
                 ],
@@ -554,7 +772,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Set profile data",
+                "description": "Set profile data. Masked build secret values sent back unchanged keep their existing value.",
                 "consumes": [
                     "application/json"
                 ],
@@ -647,6 +865,86 @@ const docTemplate = `{
                 }
             }
         },
+        "/provider/install/file": {
+            "post": {
+                "description": "Install a provider from an uploaded binary",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "provider"
+                ],
+                "summary": "Install a provider from an uploaded binary",
+                "operationId": "InstallProviderFromFile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider name",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Provider binary",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hex encoded SHA-256 digest of the binary",
+                        "name": "checksum",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Base64 encoded Ed25519 signature of the digest",
+                        "name": "signature",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Install the provider even if it has no checksum or signature",
+                        "name": "allowUnsigned",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/provider/{provider}/rollback": {
+            "post": {
+                "description": "Reinstall the previous installed version of a provider and pin the provider to it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "provider"
+                ],
+                "summary": "Roll back a provider",
+                "operationId": "RollbackProvider",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider to roll back",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {}
+                    }
+                }
+            }
+        },
         "/provider/{provider}/target-manifest": {
             "get": {
                 "description": "Get provider target manifest",
@@ -778,7 +1076,7 @@ const docTemplate = `{
         },
         "/target": {
             "get": {
-                "description": "List targets",
+                "description": "List targets. Values of masked options are replaced with a placeholder.",
                 "produces": [
                     "application/json"
                 ],
@@ -787,6 +1085,32 @@ const docTemplate = `{
                 ],
                 "summary": "List targets",
                 "operationId": "ListTargets",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of items to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the X-Next-Cursor header of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field to sort by, prefixed with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated list of fields to return",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -795,12 +1119,22 @@ const docTemplate = `{
                             "items": {
                                 "$ref": "#/definitions/ProviderTarget"
                             }
+                        },
+                        "headers": {
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor of the next page"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of items"
+                            }
                         }
                     }
                 }
             },
             "put": {
-                "description": "Set a target",
+                "description": "Set a target. Options are validated against the target manifest of the provider.\nMasked options sent back unchanged keep their existing value.",
                 "tags": [
                     "target"
                 ],
@@ -848,78 +1182,178 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace": {
+        "/target/{target}/reveal": {
             "get": {
-                "description": "List workspaces",
+                "description": "Get a target including the values of masked options. Not available to workspace and project API keys.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "workspace"
+                    "target"
                 ],
-                "summary": "List workspaces",
-                "operationId": "ListWorkspaces",
+                "summary": "Reveal a target",
+                "operationId": "RevealTarget",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Verbose",
-                        "name": "verbose",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Target name",
+                        "name": "target",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/WorkspaceDTO"
-                            }
+                            "$ref": "#/definitions/ProviderTarget"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/target/{target}/test": {
             "post": {
-                "description": "Create a workspace",
+                "description": "Check that the provider can reach the target with its options.\nProviders that can't check targets directly create and destroy an empty workspace.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "workspace"
+                    "target"
                 ],
-                "summary": "Create a workspace",
-                "operationId": "CreateWorkspace",
+                "summary": "Test a target",
+                "operationId": "TestTarget",
                 "parameters": [
                     {
-                        "description": "Create workspace",
-                        "name": "workspace",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateWorkspaceRequest"
-                        }
+                        "type": "string",
+                        "description": "Target name",
+                        "name": "target",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Workspace"
+                            "$ref": "#/definitions/TargetTestResult"
                         }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}": {
+        "/workspace": {
             "get": {
-                "description": "Get workspace info",
+                "description": "List workspaces",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "workspace"
                 ],
-                "summary": "Get workspace info",
+                "summary": "List workspaces",
+                "operationId": "ListWorkspaces",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Verbose",
+                        "name": "verbose",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of items to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from the X-Next-Cursor header of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field to sort by, prefixed with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated list of fields to return",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WorkspaceDTO"
+                            }
+                        },
+                        "headers": {
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor of the next page"
+                            },
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Total number of items"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Create a workspace",
+                "operationId": "CreateWorkspace",
+                "parameters": [
+                    {
+                        "description": "Create workspace",
+                        "name": "workspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWorkspaceRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key to safely retry the request. Repeated requests with the same key return the workspace created by the original request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}": {
+            "get": {
+                "description": "Get workspace info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get workspace info",
                 "operationId": "GetWorkspace",
                 "parameters": [
                     {
@@ -1016,6 +1450,43 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/{projectId}/image-status": {
+            "get": {
+                "description": "Check if the project image is stale relative to the HEAD of the project repository",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get project image status",
+                "operationId": "GetProjectImageStatus",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectImageStatus"
+                        }
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/{projectId}/start": {
             "post": {
                 "description": "Start project",
@@ -1135,6 +1606,95 @@ const docTemplate = `{
                 }
             }
         },
+        "BuildInfo": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "hash": {
+                    "type": "string"
+                },
+                "imageName": {
+                    "type": "string"
+                },
+                "inUse": {
+                    "description": "InUse is true if a workspace project runs the build image",
+                    "type": "boolean"
+                },
+                "lastUsedAt": {
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "size": {
+                    "description": "Size of the image in the registry in bytes",
+                    "type": "integer",
+                    "format": "int64"
+                }
+            }
+        },
+        "BuildJob": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "hash": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "queuePosition": {
+                    "description": "QueuePosition is the position of a queued build starting at 1 and 0 once the build started",
+                    "type": "integer"
+                },
+                "state": {
+                    "$ref": "#/definitions/BuildJobState"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "BuildJobState": {
+            "type": "string",
+            "enum": [
+                "queued",
+                "running",
+                "success",
+                "error"
+            ],
+            "x-enum-varnames": [
+                "BuildJobStateQueued",
+                "BuildJobStateRunning",
+                "BuildJobStateSuccess",
+                "BuildJobStateError"
+            ]
+        },
+        "BuildSecret": {
+            "type": "object",
+            "properties": {
+                "repositoryPatterns": {
+                    "description": "RepositoryPatterns are glob patterns of repository URLs without the scheme, e.g. github.com/daytonaio/*.\nThe secret is mounted into builds of matching repositories. Other projects have to reference it by name.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
         "ContainerRegistry": {
             "type": "object",
             "properties": {
@@ -1377,6 +1937,17 @@ const docTemplate = `{
         "InstallProviderRequest": {
             "type": "object",
             "properties": {
+                "allowUnsigned": {
+                    "description": "AllowUnsigned installs the provider even if it has no checksum or signature",
+                    "type": "boolean"
+                },
+                "checksums": {
+                    "description": "Checksums holds the hex encoded SHA-256 digest of each binary",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "downloadUrls": {
                     "type": "object",
                     "additionalProperties": {
@@ -1385,6 +1956,90 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "signatures": {
+                    "description": "Signatures holds the base64 encoded Ed25519 signature of each binary's SHA-256 digest",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "version": {
+                    "description": "Version is reinstalled from the versions kept on the server if available",
+                    "type": "string"
+                }
+            }
+        },
+        "LifecycleCommand": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "command": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is set for commands of the object syntax that run in parallel",
+                    "type": "string"
+                }
+            }
+        },
+        "LifecycleCommandGroup": {
+            "type": "object",
+            "properties": {
+                "commands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleCommand"
+                    }
+                }
+            }
+        },
+        "LifecycleHook": {
+            "type": "string",
+            "enum": [
+                "onCreate",
+                "updateContent",
+                "postCreate",
+                "postStart",
+                "postAttach"
+            ],
+            "x-enum-varnames": [
+                "LifecycleHookOnCreate",
+                "LifecycleHookUpdateContent",
+                "LifecycleHookPostCreate",
+                "LifecycleHookPostStart",
+                "LifecycleHookPostAttach"
+            ]
+        },
+        "LifecycleHookState": {
+            "type": "string",
+            "enum": [
+                "running",
+                "success",
+                "error"
+            ],
+            "x-enum-varnames": [
+                "LifecycleHookStateRunning",
+                "LifecycleHookStateSuccess",
+                "LifecycleHookStateError"
+            ]
+        },
+        "LifecycleHookStatus": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "hook": {
+                    "$ref": "#/definitions/LifecycleHook"
+                },
+                "state": {
+                    "$ref": "#/definitions/LifecycleHookState"
                 }
             }
         },
@@ -1396,9 +2051,35 @@ const docTemplate = `{
                 }
             }
         },
+        "PortAutoForwardAction": {
+            "type": "string",
+            "enum": [
+                "notify",
+                "openBrowser",
+                "openBrowserOnce",
+                "openPreview",
+                "silent",
+                "ignore"
+            ],
+            "x-enum-varnames": [
+                "PortAutoForwardActionNotify",
+                "PortAutoForwardActionOpenBrowser",
+                "PortAutoForwardActionOpenBrowserOnce",
+                "PortAutoForwardActionOpenPreview",
+                "PortAutoForwardActionSilent",
+                "PortAutoForwardActionIgnore"
+            ]
+        },
         "ProfileData": {
             "type": "object",
             "properties": {
+                "buildSecrets": {
+                    "description": "BuildSecrets are keyed by the secret name",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/BuildSecret"
+                    }
+                },
                 "envVars": {
                     "type": "object",
                     "additionalProperties": {
@@ -1413,9 +2094,32 @@ const docTemplate = `{
                 "build": {
                     "$ref": "#/definitions/ProjectBuild"
                 },
+                "customizations": {
+                    "description": "Customizations are applied by the IDE when opening the project",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ProjectCustomizations"
+                        }
+                    ]
+                },
+                "forwardPorts": {
+                    "description": "ForwardPorts are forwarded to the local machine when opening the project",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectPort"
+                    }
+                },
                 "image": {
                     "type": "string"
                 },
+                "lifecycle": {
+                    "description": "Lifecycle holds the devcontainer lifecycle hooks that the agent runs",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ProjectLifecycle"
+                        }
+                    ]
+                },
                 "name": {
                     "type": "string"
                 },
@@ -1434,6 +2138,13 @@ const docTemplate = `{
                 "repository": {
                     "$ref": "#/definitions/GitRepository"
                 },
+                "services": {
+                    "description": "Services run next to the project container and start, stop and get removed with it",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectService"
+                    }
+                },
                 "state": {
                     "$ref": "#/definitions/ProjectState"
                 },
@@ -1453,6 +2164,16 @@ const docTemplate = `{
             "properties": {
                 "devcontainer": {
                     "$ref": "#/definitions/ProjectBuildDevcontainer"
+                },
+                "dockerfile": {
+                    "$ref": "#/definitions/ProjectBuildDockerfile"
+                },
+                "secrets": {
+                    "description": "Secrets are names of profile build secrets that are mounted into the build in addition to the secrets matching the repository",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -1464,6 +2185,52 @@ const docTemplate = `{
                 }
             }
         },
+        "ProjectBuildDockerfile": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "context": {
+                    "description": "Context is the path of the build context relative to the repository root. Defaults to the repository root.",
+                    "type": "string"
+                },
+                "dockerfile": {
+                    "description": "Dockerfile is the path of the Dockerfile relative to the repository root",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "Target is the build stage to build. The last stage is built if empty.",
+                    "type": "string"
+                }
+            }
+        },
+        "ProjectCustomizations": {
+            "type": "object",
+            "properties": {
+                "vscode": {
+                    "$ref": "#/definitions/VSCodeCustomizations"
+                }
+            }
+        },
+        "ProjectImageStatus": {
+            "type": "object",
+            "properties": {
+                "buildSha": {
+                    "type": "string"
+                },
+                "headSha": {
+                    "type": "string"
+                },
+                "stale": {
+                    "description": "Stale is true if the build files changed since the project image was built",
+                    "type": "boolean"
+                }
+            }
+        },
         "ProjectInfo": {
             "type": "object",
             "properties": {
@@ -1484,12 +2251,119 @@ const docTemplate = `{
                 }
             }
         },
+        "ProjectLifecycle": {
+            "type": "object",
+            "properties": {
+                "onCreate": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleCommandGroup"
+                    }
+                },
+                "postAttach": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleCommandGroup"
+                    }
+                },
+                "postCreate": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleCommandGroup"
+                    }
+                },
+                "postStart": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleCommandGroup"
+                    }
+                },
+                "prebuilt": {
+                    "description": "Prebuilt are the hooks that already ran while the image was built. The agent does not run them again.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleHook"
+                    }
+                },
+                "updateContent": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleCommandGroup"
+                    }
+                },
+                "waitFor": {
+                    "description": "WaitFor is the hook that has to finish before the project accepts connections. Defaults to updateContent.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/LifecycleHook"
+                        }
+                    ]
+                }
+            }
+        },
+        "ProjectPort": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "type": "string"
+                },
+                "onAutoForward": {
+                    "description": "OnAutoForward defaults to notify",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/PortAutoForwardAction"
+                        }
+                    ]
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "protocol": {
+                    "type": "string"
+                },
+                "requireLocalPort": {
+                    "description": "RequireLocalPort prevents forwarding to a different local port when the port is taken",
+                    "type": "boolean"
+                }
+            }
+        },
+        "ProjectService": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "description": "Command overrides the default command of the image",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "envVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
         "ProjectState": {
             "type": "object",
             "properties": {
                 "gitStatus": {
                     "$ref": "#/definitions/GitStatus"
                 },
+                "lifecycleHooks": {
+                    "description": "LifecycleHooks holds the results of the lifecycle hooks the agent ran",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleHookStatus"
+                    }
+                },
                 "updatedAt": {
                     "type": "string"
                 },
@@ -1501,14 +2375,45 @@ const docTemplate = `{
         "Provider": {
             "type": "object",
             "properties": {
+                "capabilities": {
+                    "$ref": "#/definitions/ProviderCapabilities"
+                },
+                "lastError": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
+                "state": {
+                    "description": "State is one of up, restarting or failed",
+                    "type": "string"
+                },
                 "version": {
                     "type": "string"
                 }
             }
         },
+        "ProviderCapabilities": {
+            "type": "object",
+            "properties": {
+                "directPortExposure": {
+                    "description": "DirectPortExposure is set if project ports can be exposed directly, without the tunnel",
+                    "type": "boolean"
+                },
+                "resourceUsage": {
+                    "description": "ResourceUsage is set if the provider reports the resource usage of workspaces",
+                    "type": "boolean"
+                },
+                "stopWithoutDestroy": {
+                    "description": "StopWithoutDestroy is set if stopped workspaces and projects keep their state and can be started again",
+                    "type": "boolean"
+                },
+                "volumeSnapshots": {
+                    "description": "VolumeSnapshots is set if the provider can snapshot project volumes",
+                    "type": "boolean"
+                }
+            }
+        },
         "ProviderTarget": {
             "type": "object",
             "properties": {
@@ -1542,12 +2447,20 @@ const docTemplate = `{
                 "buildImageNamespace": {
                     "type": "string"
                 },
+                "buildWorkers": {
+                    "description": "BuildWorkers is the number of builds that run at the same time",
+                    "type": "integer"
+                },
                 "builderImage": {
                     "type": "string"
                 },
                 "builderRegistryServer": {
                     "type": "string"
                 },
+                "buildpacksBuilderImage": {
+                    "description": "BuildpacksBuilderImage is the Cloud Native Buildpacks builder image used for repositories without a devcontainer or Dockerfile.\nLanguage base images are used if empty.",
+                    "type": "string"
+                },
                 "defaultProjectImage": {
                     "type": "string"
                 },
@@ -1575,14 +2488,28 @@ const docTemplate = `{
                 "logFilePath": {
                     "type": "string"
                 },
+                "pinnedProviderVersions": {
+                    "description": "PinnedProviderVersions maps provider names to the version they are pinned to",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "providersDir": {
                     "type": "string"
                 },
+                "providersPublicKey": {
+                    "description": "ProvidersPublicKey is the base64 encoded Ed25519 key used to verify downloaded providers.\nDefaults to the key built into the server.",
+                    "type": "string"
+                },
                 "registryUrl": {
                     "type": "string"
                 },
                 "serverDownloadUrl": {
                     "type": "string"
+                },
+                "tls": {
+                    "$ref": "#/definitions/TLSConfig"
                 }
             }
         },
@@ -1592,6 +2519,12 @@ const docTemplate = `{
                 "gitStatus": {
                     "$ref": "#/definitions/GitStatus"
                 },
+                "lifecycleHooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LifecycleHookStatus"
+                    }
+                },
                 "uptime": {
                     "type": "integer"
                 }
@@ -1620,6 +2553,67 @@ const docTemplate = `{
                 "UpdatedButUnmerged"
             ]
         },
+        "TLSConfig": {
+            "type": "object",
+            "properties": {
+                "certFile": {
+                    "type": "string"
+                },
+                "clientCaFile": {
+                    "type": "string"
+                },
+                "keyFile": {
+                    "type": "string"
+                },
+                "selfSigned": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "TargetCheck": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "passed": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "TargetTestResult": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TargetCheck"
+                    }
+                },
+                "passed": {
+                    "description": "Passed is set if all checks passed",
+                    "type": "boolean"
+                }
+            }
+        },
+        "VSCodeCustomizations": {
+            "type": "object",
+            "properties": {
+                "extensions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "settings": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
         "Workspace": {
             "type": "object",
             "properties": {
@@ -1729,6 +2723,10 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "required": {
+                    "description": "Required properties must be set unless they are disabled for the target",
+                    "type": "boolean"
+                },
                 "type": {
                     "$ref": "#/definitions/provider.ProviderTargetPropertyType"
                 }
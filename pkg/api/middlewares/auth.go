@@ -13,6 +13,12 @@ import (
 
 func AuthMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		// Connections on the local socket from the user running the server don't need an API key
+		if isOwnerPeer(ctx.Request.Context()) {
+			ctx.Next()
+			return
+		}
+
 		bearerToken := ctx.GetHeader("Authorization")
 		if bearerToken == "" {
 			ctx.AbortWithError(401, errors.New("unauthorized"))
@@ -35,6 +41,28 @@ func AuthMiddleware() gin.HandlerFunc {
 	}
 }
 
+// ClientAuthMiddleware rejects workspace and project API keys on routes that are only meant for clients.
+// It must run after AuthMiddleware.
+func ClientAuthMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if isOwnerPeer(ctx.Request.Context()) {
+			ctx.Next()
+			return
+		}
+
+		token := ExtractToken(ctx.GetHeader("Authorization"))
+
+		server := server.GetInstance(nil)
+
+		if server.ApiKeyService.IsWorkspaceApiKey(token) || server.ApiKeyService.IsProjectApiKey(token) {
+			ctx.AbortWithError(403, errors.New("forbidden"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
 func ExtractToken(bearerToken string) string {
 	if !strings.HasPrefix(bearerToken, "Bearer ") {
 		return ""
@@ -0,0 +1,182 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/idempotency"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const IDEMPOTENCY_KEY_HEADER = "Idempotency-Key"
+const IDEMPOTENT_REPLAYED_HEADER = "Idempotent-Replayed"
+
+// IdempotencyReplayFunc responds to a repeated request with the resource created by the original request
+type IdempotencyReplayFunc func(ctx *gin.Context, resourceId string)
+
+type idempotentRequest struct {
+	requestHash string
+	done        chan struct{}
+}
+
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware makes requests with an Idempotency-Key header safe to retry.
+// The first request with a key is handled normally and, if it succeeds, the key is stored with the ID
+// of the created resource for the ttl. Repeated requests with the same key are answered by replay with
+// that resource. Repeated requests that arrive while the first one is still being handled wait for its
+// result. Failed requests are not stored, so they can be retried with the same key.
+func IdempotencyMiddleware(ttl time.Duration, replay IdempotencyReplayFunc) gin.HandlerFunc {
+	return idempotencyMiddleware(func() idempotency.Store {
+		return server.GetInstance(nil).IdempotencyKeyStore
+	}, ttl, replay)
+}
+
+func idempotencyMiddleware(getStore func() idempotency.Store, ttl time.Duration, replay IdempotencyReplayFunc) gin.HandlerFunc {
+	var mutex sync.Mutex
+	inFlight := map[string]*idempotentRequest{}
+
+	return func(ctx *gin.Context) {
+		idempotencyKey := ctx.GetHeader(IDEMPOTENCY_KEY_HEADER)
+		if idempotencyKey == "" {
+			ctx.Next()
+			return
+		}
+
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to read request body: %s", err.Error()))
+			return
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		// Keys are scoped to the client so that clients can't read each other's resources
+		key := hash(ctx.GetHeader("Authorization"), idempotencyKey)
+		requestHash := hash(ctx.Request.Method, ctx.Request.URL.Path, string(body))
+
+		store := getStore()
+
+		for {
+			mutex.Lock()
+			request, exists := inFlight[key]
+			if !exists {
+				storedKey, err := store.Find(key)
+				if err != nil && !idempotency.IsIdempotencyKeyNotFound(err) {
+					mutex.Unlock()
+					ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to find idempotency key: %s", err.Error()))
+					return
+				}
+
+				if storedKey != nil {
+					mutex.Unlock()
+					if storedKey.RequestHash != requestHash {
+						ctx.AbortWithError(http.StatusUnprocessableEntity, errors.New("idempotency key was already used for a different request"))
+						return
+					}
+
+					ctx.Header(IDEMPOTENT_REPLAYED_HEADER, "true")
+					replay(ctx, storedKey.ResourceId)
+					ctx.Abort()
+					return
+				}
+
+				request = &idempotentRequest{
+					requestHash: requestHash,
+					done:        make(chan struct{}),
+				}
+				inFlight[key] = request
+			}
+			mutex.Unlock()
+
+			if !exists {
+				handleIdempotentRequest(ctx, store, key, request, ttl)
+
+				mutex.Lock()
+				delete(inFlight, key)
+				mutex.Unlock()
+				close(request.done)
+				return
+			}
+
+			if request.requestHash != requestHash {
+				ctx.AbortWithError(http.StatusUnprocessableEntity, errors.New("idempotency key was already used for a different request"))
+				return
+			}
+
+			// Once the original request is done, its key is either stored or it failed and was forgotten
+			select {
+			case <-request.done:
+			case <-ctx.Request.Context().Done():
+				ctx.Abort()
+				return
+			}
+		}
+	}
+}
+
+func handleIdempotentRequest(ctx *gin.Context, store idempotency.Store, key string, request *idempotentRequest, ttl time.Duration) {
+	recorder := &responseRecorder{ResponseWriter: ctx.Writer}
+	ctx.Writer = recorder
+
+	ctx.Next()
+
+	status := recorder.Status()
+	if len(ctx.Errors) > 0 || status < 200 || status >= 300 {
+		return
+	}
+
+	var resource struct {
+		Id string `json:"id"`
+	}
+	err := json.Unmarshal(recorder.body.Bytes(), &resource)
+	if err != nil || resource.Id == "" {
+		log.Errorf("failed to store idempotency key: response has no resource ID")
+		return
+	}
+
+	err = store.Save(&idempotency.IdempotencyKey{
+		Key:         key,
+		RequestHash: request.requestHash,
+		ResourceId:  resource.Id,
+		ExpiresAt:   time.Now().Add(ttl),
+	})
+	if err != nil {
+		log.Errorf("failed to store idempotency key: %s", err.Error())
+	}
+}
+
+func hash(values ...string) string {
+	h := sha256.New()
+	for _, value := range values {
+		h.Write([]byte(value))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
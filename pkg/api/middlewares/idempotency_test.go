@@ -0,0 +1,171 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	idempotency_store "github.com/daytonaio/daytona/internal/testing/server/idempotency"
+	"github.com/daytonaio/daytona/pkg/idempotency"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newIdempotentRouter(store idempotency.Store, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/", idempotencyMiddleware(func() idempotency.Store { return store }, time.Hour, func(ctx *gin.Context, resourceId string) {
+		ctx.JSON(200, gin.H{"id": resourceId})
+	}), handler)
+	return router
+}
+
+func sendIdempotentRequest(router *gin.Engine, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(IDEMPOTENCY_KEY_HEADER, key)
+	}
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	t.Run("Replays the response of a repeated request", func(t *testing.T) {
+		var calls int32
+		router := newIdempotentRouter(idempotency_store.NewInMemoryIdempotencyKeyStore(), func(ctx *gin.Context) {
+			ctx.JSON(200, gin.H{"id": fmt.Sprint(atomic.AddInt32(&calls, 1))})
+		})
+
+		first := sendIdempotentRequest(router, "key", "body")
+		second := sendIdempotentRequest(router, "key", "body")
+
+		require.Equal(t, int32(1), calls)
+		require.Equal(t, 200, second.Code)
+		require.Equal(t, first.Body.String(), second.Body.String())
+		require.Equal(t, "true", second.Header().Get(IDEMPOTENT_REPLAYED_HEADER))
+	})
+
+	t.Run("Handles requests without a key", func(t *testing.T) {
+		var calls int32
+		router := newIdempotentRouter(idempotency_store.NewInMemoryIdempotencyKeyStore(), func(ctx *gin.Context) {
+			atomic.AddInt32(&calls, 1)
+			ctx.Status(200)
+		})
+
+		sendIdempotentRequest(router, "", "body")
+		sendIdempotentRequest(router, "", "body")
+
+		require.Equal(t, int32(2), calls)
+	})
+
+	t.Run("Rejects a reused key with a different request", func(t *testing.T) {
+		router := newIdempotentRouter(idempotency_store.NewInMemoryIdempotencyKeyStore(), func(ctx *gin.Context) {
+			ctx.JSON(200, gin.H{"id": "created"})
+		})
+
+		sendIdempotentRequest(router, "key", "body")
+		res := sendIdempotentRequest(router, "key", "other body")
+
+		require.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	})
+
+	t.Run("Does not store failed requests", func(t *testing.T) {
+		var calls int32
+		router := newIdempotentRouter(idempotency_store.NewInMemoryIdempotencyKeyStore(), func(ctx *gin.Context) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				ctx.Status(http.StatusInternalServerError)
+				return
+			}
+			ctx.JSON(200, gin.H{"id": "created"})
+		})
+
+		first := sendIdempotentRequest(router, "key", "body")
+		second := sendIdempotentRequest(router, "key", "body")
+
+		require.Equal(t, http.StatusInternalServerError, first.Code)
+		require.Equal(t, 200, second.Code)
+		require.Equal(t, int32(2), calls)
+	})
+
+	t.Run("Waits for a request in progress", func(t *testing.T) {
+		var calls int32
+		started := make(chan struct{})
+		release := make(chan struct{})
+		router := newIdempotentRouter(idempotency_store.NewInMemoryIdempotencyKeyStore(), func(ctx *gin.Context) {
+			atomic.AddInt32(&calls, 1)
+			close(started)
+			<-release
+			ctx.JSON(200, gin.H{"id": "created"})
+		})
+
+		var wg sync.WaitGroup
+		responses := make([]*httptest.ResponseRecorder, 2)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[0] = sendIdempotentRequest(router, "key", "body")
+		}()
+		<-started
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[1] = sendIdempotentRequest(router, "key", "body")
+		}()
+
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		require.Equal(t, int32(1), calls)
+		require.JSONEq(t, `{"id":"created"}`, responses[0].Body.String())
+		require.JSONEq(t, `{"id":"created"}`, responses[1].Body.String())
+	})
+
+	t.Run("Replays requests with keys stored before a restart", func(t *testing.T) {
+		store := idempotency_store.NewInMemoryIdempotencyKeyStore()
+		handler := func(ctx *gin.Context) {
+			ctx.JSON(200, gin.H{"id": "created", "info": "not replayed"})
+		}
+
+		sendIdempotentRequest(newIdempotentRouter(store, handler), "key", "body")
+		res := sendIdempotentRequest(newIdempotentRouter(store, handler), "key", "body")
+
+		require.Equal(t, 200, res.Code)
+		require.JSONEq(t, `{"id":"created"}`, res.Body.String())
+		require.Equal(t, "true", res.Header().Get(IDEMPOTENT_REPLAYED_HEADER))
+	})
+
+	t.Run("Handles requests with expired keys again", func(t *testing.T) {
+		var calls int32
+		store := idempotency_store.NewInMemoryIdempotencyKeyStore()
+		router := newIdempotentRouter(store, func(ctx *gin.Context) {
+			ctx.JSON(200, gin.H{"id": fmt.Sprint(atomic.AddInt32(&calls, 1))})
+		})
+
+		sendIdempotentRequest(router, "key", "body")
+
+		require.Nil(t, store.Save(&idempotency.IdempotencyKey{
+			Key:         hash("", "key"),
+			RequestHash: hash(http.MethodPost, "/", "body"),
+			ResourceId:  "1",
+			ExpiresAt:   time.Now().Add(-time.Second),
+		}))
+
+		res := sendIdempotentRequest(router, "key", "body")
+
+		require.Equal(t, int32(2), calls)
+		require.JSONEq(t, `{"id":"2"}`, res.Body.String())
+	})
+}
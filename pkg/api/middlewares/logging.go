@@ -29,7 +29,12 @@ func LoggingMiddleware() gin.HandlerFunc {
 				"latency": latencyTime,
 				"error":   ctx.Errors.String(),
 			}).Error("API ERROR")
-			ctx.JSON(statusCode, gin.H{"error": ctx.Errors[0].Err.Error()})
+			body := gin.H{"error": ctx.Errors[0].Err.Error()}
+			// Structured details of the error, e.g. per-field validation errors
+			if ctx.Errors[0].Meta != nil {
+				body["details"] = ctx.Errors[0].Meta
+			}
+			ctx.JSON(statusCode, body)
 		} else {
 			log.WithFields(log.Fields{
 				"method":  reqMethod,
@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"context"
+	"net"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type ownerPeerKey struct{}
+
+// PeerCredentialsConnContext marks connections on a Unix socket that come from the user running the server.
+// It is meant to be used as the ConnContext of the API http.Server.
+func PeerCredentialsConnContext(ctx context.Context, conn net.Conn) context.Context {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return ctx
+	}
+
+	uid, err := getPeerUid(unixConn)
+	if err != nil {
+		log.Debugf("Failed to get peer credentials: %s", err)
+		return ctx
+	}
+
+	if uid != os.Getuid() {
+		return ctx
+	}
+
+	return context.WithValue(ctx, ownerPeerKey{}, true)
+}
+
+func isOwnerPeer(ctx context.Context) bool {
+	isOwner, ok := ctx.Value(ownerPeerKey{}).(bool)
+	return ok && isOwner
+}
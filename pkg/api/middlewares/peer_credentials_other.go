@@ -0,0 +1,17 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux && !darwin
+
+package middlewares
+
+import (
+	"errors"
+	"net"
+)
+
+const PeerCredentialsSupported = false
+
+func getPeerUid(conn *net.UnixConn) (int, error) {
+	return -1, errors.New("peer credentials are not supported on this platform")
+}
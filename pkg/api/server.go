@@ -20,10 +20,13 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/api/docs"
@@ -32,7 +35,9 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/apikey"
 	"github.com/daytonaio/daytona/pkg/api/controllers/binary"
+	"github.com/daytonaio/daytona/pkg/api/controllers/build"
 	"github.com/daytonaio/daytona/pkg/api/controllers/containerregistry"
+	"github.com/daytonaio/daytona/pkg/api/controllers/event"
 	"github.com/daytonaio/daytona/pkg/api/controllers/gitprovider"
 	log_controller "github.com/daytonaio/daytona/pkg/api/controllers/log"
 	"github.com/daytonaio/daytona/pkg/api/controllers/profiledata"
@@ -40,6 +45,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/api/controllers/server"
 	"github.com/daytonaio/daytona/pkg/api/controllers/target"
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace"
+	daytona_server "github.com/daytonaio/daytona/pkg/server"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -51,20 +57,42 @@ import (
 
 type ApiServerConfig struct {
 	ApiPort int
+	// TLS is optional. If set, the server only accepts HTTPS connections
+	TLS *daytona_server.TLSConfig
+	// SocketPath is optional. If set, the server also listens on a Unix socket that
+	// only the current user can access and that doesn't require an API key
+	SocketPath string
+	// LocalAddrFile is optional. If set, the address of the listener for local clients is written to it
+	// so other processes can reach the server without a client certificate
+	LocalAddrFile string
 }
 
 const HEALTH_CHECK_ROUTE = "/health"
+const IDEMPOTENCY_KEY_TTL = 24 * time.Hour
 
 func NewApiServer(config ApiServerConfig) *ApiServer {
 	return &ApiServer{
-		apiPort: config.ApiPort,
+		apiPort:       config.ApiPort,
+		tls:           config.TLS,
+		socketPath:    config.SocketPath,
+		localAddrFile: config.LocalAddrFile,
 	}
 }
 
 type ApiServer struct {
-	apiPort    int
-	httpServer *http.Server
-	router     *gin.Engine
+	apiPort       int
+	tls           *daytona_server.TLSConfig
+	socketPath    string
+	localAddrFile string
+	httpServer    *http.Server
+	listener      net.Listener
+	socket        net.Listener
+	// localListener is the loopback-only listener that doesn't require client certificates when mutual TLS is required.
+	// It serves local clients and the server's tunnel, which can not forward client certificates.
+	localListener  net.Listener
+	localAddr      string
+	localAddrMutex sync.RWMutex
+	router         *gin.Engine
 }
 
 func (a *ApiServer) Start() error {
@@ -115,19 +143,30 @@ func (a *ApiServer) Start() error {
 	{
 		workspaceController.GET("/:workspaceId", workspace.GetWorkspace)
 		workspaceController.GET("/", workspace.ListWorkspaces)
-		workspaceController.POST("/", workspace.CreateWorkspace)
+		workspaceController.POST("/", middlewares.IdempotencyMiddleware(IDEMPOTENCY_KEY_TTL, workspace.ReplayCreateWorkspace), workspace.CreateWorkspace)
 		workspaceController.POST("/:workspaceId/start", workspace.StartWorkspace)
 		workspaceController.POST("/:workspaceId/stop", workspace.StopWorkspace)
 		workspaceController.DELETE("/:workspaceId", workspace.RemoveWorkspace)
 		workspaceController.POST("/:workspaceId/:projectId/start", workspace.StartProject)
 		workspaceController.POST("/:workspaceId/:projectId/stop", workspace.StopProject)
+		workspaceController.GET("/:workspaceId/:projectId/image-status", workspace.GetProjectImageStatus)
+	}
+
+	buildController := protected.Group("/build")
+	{
+		buildController.GET("/", build.ListBuilds)
+		buildController.POST("/prune", build.PruneBuilds)
+		buildController.GET("/jobs", build.ListBuildJobs)
+		buildController.GET("/jobs/:buildId", build.GetBuildJob)
 	}
 
 	providerController := protected.Group("/provider")
 	{
 		providerController.POST("/install", provider.InstallProvider)
+		providerController.POST("/install/file", provider.InstallProviderFromFile)
 		providerController.GET("/", provider.ListProviders)
 		providerController.POST("/:provider/uninstall", provider.UninstallProvider)
+		providerController.POST("/:provider/rollback", provider.RollbackProvider)
 		providerController.GET("/:provider/target-manifest", provider.GetTargetManifest)
 	}
 
@@ -144,6 +183,8 @@ func (a *ApiServer) Start() error {
 		targetController.GET("/", target.ListTargets)
 		targetController.PUT("/", target.SetTarget)
 		targetController.DELETE("/:target", target.RemoveTarget)
+		targetController.GET("/:target/reveal", middlewares.ClientAuthMiddleware(), target.RevealTarget)
+		targetController.POST("/:target/test", target.TestTarget)
 	}
 
 	logController := protected.Group("/log")
@@ -151,6 +192,12 @@ func (a *ApiServer) Start() error {
 		logController.GET("/server", log_controller.ReadServerLog)
 		logController.GET("/workspace/:workspaceId", log_controller.ReadWorkspaceLog)
 		logController.GET("/workspace/:workspaceId/:projectName", log_controller.ReadProjectLog)
+		logController.GET("/build/:buildId", log_controller.ReadBuildLog)
+	}
+
+	eventController := protected.Group("/events")
+	{
+		eventController.GET("", event.StreamEvents)
 	}
 
 	gitProviderController := protected.Group("/gitprovider")
@@ -188,21 +235,167 @@ func (a *ApiServer) Start() error {
 	}
 
 	a.httpServer = &http.Server{
-		Addr:    fmt.Sprintf(":%d", a.apiPort),
-		Handler: a.router,
+		Addr:        fmt.Sprintf(":%d", a.apiPort),
+		Handler:     a.router,
+		ConnContext: middlewares.PeerCredentialsConnContext,
+	}
+
+	if a.listener == nil {
+		err := a.Listen()
+		if err != nil {
+			return err
+		}
+	}
+
+	if a.socket != nil {
+		go func() {
+			log.Infof("Starting api server on socket %s", a.socketPath)
+			err := a.httpServer.Serve(a.socket)
+			if err != nil && err != http.ErrServerClosed {
+				log.Error(err)
+			}
+		}()
+	}
+
+	if a.localListener != nil {
+		go func() {
+			log.Infof("Starting api server for local clients on %s", a.localListener.Addr().String())
+			err := a.httpServer.Serve(a.localListener)
+			if err != nil && err != http.ErrServerClosed {
+				log.Error(err)
+			}
+		}()
+	}
+
+	if a.tls != nil {
+		log.Infof("Starting api server with TLS on port %d", a.apiPort)
+	} else {
+		log.Infof("Starting api server on port %d", a.apiPort)
 	}
 
-	listener, err := net.Listen("tcp", a.httpServer.Addr)
+	return a.httpServer.Serve(a.listener)
+}
+
+// Listen opens the listeners of the server without serving requests yet so their addresses are known before the
+// server starts. Start calls it if it wasn't called before.
+func (a *ApiServer) Listen() error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", a.apiPort))
 	if err != nil {
 		return err
 	}
 
-	log.Infof("Starting api server on port %d", a.apiPort)
-	return a.httpServer.Serve(listener)
+	if a.socketPath != "" {
+		a.socket, err = listenOnSocket(a.socketPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	if a.tls != nil {
+		tlsConfig, err := getTlsConfig(a.tls)
+		if err != nil {
+			return err
+		}
+
+		if tlsConfig.ClientAuth == tls.RequireAndVerifyClientCert {
+			err = a.listenLocal(tlsConfig)
+			if err != nil {
+				return err
+			}
+		}
+
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	a.listener = listener
+
+	return nil
+}
+
+// listenLocal opens a loopback-only listener that doesn't require a client certificate. It serves local clients without
+// access to the local socket and the server's tunnel, which terminates HTTP and can not forward client certificates.
+// Its clients still authenticate with an API key.
+func (a *ApiServer) listenLocal(tlsConfig *tls.Config) error {
+	localListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	localTlsConfig := tlsConfig.Clone()
+	localTlsConfig.ClientAuth = tls.NoClientCert
+	localTlsConfig.ClientCAs = nil
+
+	a.localListener = tls.NewListener(localListener, localTlsConfig)
+
+	a.localAddrMutex.Lock()
+	a.localAddr = localListener.Addr().String()
+	a.localAddrMutex.Unlock()
+
+	if a.localAddrFile != "" {
+		err = os.WriteFile(a.localAddrFile, []byte(a.localAddr), 0600)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LocalAddr returns the address of the loopback-only listener for local clients or an empty string if there is none
+func (a *ApiServer) LocalAddr() string {
+	a.localAddrMutex.RLock()
+	defer a.localAddrMutex.RUnlock()
+
+	return a.localAddr
+}
+
+// getLocalAddr returns the address of the listener for local clients. The server may run in another process,
+// like the daemon, in which case the address is read from the local address file.
+func (a *ApiServer) getLocalAddr() string {
+	if localAddr := a.LocalAddr(); localAddr != "" {
+		return localAddr
+	}
+
+	if a.localAddrFile == "" {
+		return ""
+	}
+
+	localAddr, err := os.ReadFile(a.localAddrFile)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(localAddr))
 }
 
 func (a *ApiServer) HealthCheck() error {
-	resp, err := http.Get(fmt.Sprintf("http://localhost:%d%s", a.apiPort, HEALTH_CHECK_ROUTE))
+	scheme := "http"
+	host := fmt.Sprintf("localhost:%d", a.apiPort)
+	client := http.DefaultClient
+
+	if a.socketPath != "" {
+		// Connections on the main port may require a client certificate, so the socket is used if available
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = nil
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", a.socketPath)
+		}
+		client = &http.Client{Transport: transport}
+	} else if a.tls != nil {
+		scheme = "https"
+		if localAddr := a.getLocalAddr(); localAddr != "" {
+			host = localAddr
+		}
+		client = &http.Client{
+			Transport: &http.Transport{
+				// The health check only verifies that the local server is up
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		}
+	}
+
+	resp, err := client.Get(fmt.Sprintf("%s://%s%s", scheme, host, HEALTH_CHECK_ROUTE))
 	if err != nil {
 		return err
 	}
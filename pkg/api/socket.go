@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+)
+
+// LocalSocketSupported is set on platforms where the server can authenticate local socket clients
+const LocalSocketSupported = middlewares.PeerCredentialsSupported
+
+func listenOnSocket(socketPath string) (net.Listener, error) {
+	err := os.MkdirAll(filepath.Dir(socketPath), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove the socket left behind by a previous server process
+	err = os.Remove(socketPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(socketPath, 0600)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/server"
+)
+
+func getTlsConfig(c *server.TLSConfig) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if c.ClientCaFile == "" {
+		return tlsConfig, nil
+	}
+
+	clientCa, err := os.ReadFile(c.ClientCaFile)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCaPool := x509.NewCertPool()
+	if !clientCaPool.AppendCertsFromPEM(clientCa) {
+		return nil, errors.New("no certificates found in " + c.ClientCaFile)
+	}
+
+	tlsConfig.ClientCAs = clientCaPool
+	tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+
+	return tlsConfig, nil
+}
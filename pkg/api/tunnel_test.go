@@ -0,0 +1,225 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/frpc"
+	daytona_server "github.com/daytonaio/daytona/pkg/server"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	frps "github.com/fatedier/frp/server"
+	"github.com/stretchr/testify/require"
+)
+
+const tunnelSubDomainHost = "daytona.test"
+
+func TestMutualTlsThroughTunnel(t *testing.T) {
+	dir := t.TempDir()
+
+	tlsConfig := &daytona_server.TLSConfig{
+		CertFile:     filepath.Join(dir, "server.crt"),
+		KeyFile:      filepath.Join(dir, "server.key"),
+		ClientCaFile: filepath.Join(dir, "client-ca.crt"),
+		SelfSigned:   true,
+	}
+	require.NoError(t, daytona_server.EnsureTLSCertificate(tlsConfig))
+
+	clientCertificate := generateClientCertificate(t, tlsConfig.ClientCaFile)
+
+	apiPort := getFreePort(t)
+	apiServerConfig := ApiServerConfig{
+		ApiPort:       apiPort,
+		TLS:           tlsConfig,
+		LocalAddrFile: filepath.Join(dir, "api-local-addr"),
+	}
+	apiServer := NewApiServer(apiServerConfig)
+	require.NoError(t, apiServer.Listen())
+	require.NotEmpty(t, apiServer.LocalAddr())
+
+	go func() {
+		err := apiServer.Start()
+		if err != nil && err != http.ErrServerClosed {
+			t.Error(err)
+		}
+	}()
+	t.Cleanup(apiServer.Stop)
+
+	require.Eventually(t, func() bool {
+		return apiServer.HealthCheck() == nil
+	}, 10*time.Second, 100*time.Millisecond)
+
+	frpsPort, vhostPort := startFrps(t)
+
+	config := daytona_server.Config{
+		Id:      "test",
+		ApiPort: uint32(apiPort),
+		Frps: &daytona_server.FRPSConfig{
+			Domain: "127.0.0.1",
+			Port:   uint32(frpsPort),
+		},
+		Tls: tlsConfig,
+	}
+
+	t.Run("Direct connections require a client certificate", func(t *testing.T) {
+		healthUrl := fmt.Sprintf("https://127.0.0.1:%d%s", apiPort, HEALTH_CHECK_ROUTE)
+
+		_, err := newTlsClient(nil).Get(healthUrl)
+		require.Error(t, err)
+
+		res, err := newTlsClient(&clientCertificate).Get(healthUrl)
+		require.NoError(t, err)
+		res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("Other processes check the health through the listener for local clients", func(t *testing.T) {
+		require.NoError(t, NewApiServer(apiServerConfig).HealthCheck())
+	})
+
+	t.Run("The tunnel reaches the server", func(t *testing.T) {
+		startFrpc(t, daytona_server.GetApiFrpcConnectParams(config, apiServer.LocalAddr()))
+
+		require.Eventually(t, func() bool {
+			return getThroughTunnel(vhostPort, fmt.Sprintf("api-%s", config.Id)) == http.StatusOK
+		}, 15*time.Second, 200*time.Millisecond)
+	})
+
+	t.Run("The tunnel can't reach the port that requires a client certificate", func(t *testing.T) {
+		params := daytona_server.GetApiFrpcConnectParams(config, "")
+		params.Name = "daytona-server-api-direct"
+		params.SubDomain = "api-direct"
+		startFrpc(t, params)
+
+		require.Never(t, func() bool {
+			return getThroughTunnel(vhostPort, params.SubDomain) == http.StatusOK
+		}, 3*time.Second, 200*time.Millisecond)
+	})
+}
+
+func startFrps(t *testing.T) (int, int) {
+	frpsPort := getFreePort(t)
+	vhostPort := getFreePort(t)
+
+	cfg := &v1.ServerConfig{
+		BindAddr:      "127.0.0.1",
+		BindPort:      frpsPort,
+		VhostHTTPPort: vhostPort,
+		SubDomainHost: tunnelSubDomainHost,
+	}
+	cfg.Complete()
+
+	service, err := frps.NewService(cfg)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go service.Run(ctx)
+	t.Cleanup(func() {
+		cancel()
+		service.Close()
+	})
+
+	return frpsPort, vhostPort
+}
+
+func startFrpc(t *testing.T, params frpc.FrpcConnectParams) {
+	_, service, err := frpc.GetService(params)
+	require.NoError(t, err)
+
+	go func() {
+		_ = service.Run(context.Background())
+	}()
+	t.Cleanup(func() {
+		service.Close()
+	})
+}
+
+func getThroughTunnel(vhostPort int, subDomain string) int {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://127.0.0.1:%d%s", vhostPort, HEALTH_CHECK_ROUTE), nil)
+	if err != nil {
+		return 0
+	}
+	req.Host = fmt.Sprintf("%s.%s", subDomain, tunnelSubDomainHost)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0
+	}
+	defer res.Body.Close()
+
+	return res.StatusCode
+}
+
+func newTlsClient(certificate *tls.Certificate) *http.Client {
+	tlsConfig := &tls.Config{InsecureSkipVerify: true}
+	if certificate != nil {
+		tlsConfig.Certificates = []tls.Certificate{*certificate}
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		Timeout:   5 * time.Second,
+	}
+}
+
+// generateClientCertificate writes a client CA to caFile and returns a client certificate signed by it
+func generateClientCertificate(t *testing.T, caFile string) tls.Certificate {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Daytona Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDer}), 0600))
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "Daytona Test Client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDer, err := x509.CreateCertificate(rand.Reader, clientTemplate, caTemplate, &clientKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	return tls.Certificate{
+		Certificate: [][]byte{clientDer},
+		PrivateKey:  clientKey,
+	}
+}
+
+func getFreePort(t *testing.T) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port
+}
@@ -143,6 +143,34 @@ func (a *ApiKeyAPIService) GenerateApiKeyExecute(r ApiGenerateApiKeyRequest) (st
 type ApiListClientApiKeysRequest struct {
 	ctx        context.Context
 	ApiService *ApiKeyAPIService
+	limit      *int32
+	cursor     *string
+	sort       *string
+	fields     *string
+}
+
+// Maximum number of items to return
+func (r ApiListClientApiKeysRequest) Limit(limit int32) ApiListClientApiKeysRequest {
+	r.limit = &limit
+	return r
+}
+
+// Cursor from the X-Next-Cursor header of the previous page
+func (r ApiListClientApiKeysRequest) Cursor(cursor string) ApiListClientApiKeysRequest {
+	r.cursor = &cursor
+	return r
+}
+
+// Field to sort by, prefixed with - for descending order
+func (r ApiListClientApiKeysRequest) Sort(sort string) ApiListClientApiKeysRequest {
+	r.sort = &sort
+	return r
+}
+
+// Comma separated list of fields to return
+func (r ApiListClientApiKeysRequest) Fields(fields string) ApiListClientApiKeysRequest {
+	r.fields = &fields
+	return r
 }
 
 func (r ApiListClientApiKeysRequest) Execute() ([]ApiKey, *http.Response, error) {
@@ -186,6 +214,18 @@ func (a *ApiKeyAPIService) ListClientApiKeysExecute(r ApiListClientApiKeysReques
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "")
+	}
+	if r.cursor != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "cursor", r.cursor, "")
+	}
+	if r.sort != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "sort", r.sort, "")
+	}
+	if r.fields != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "fields", r.fields, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
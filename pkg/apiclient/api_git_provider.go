@@ -751,6 +751,34 @@ type ApiGetRepositoriesRequest struct {
 	ApiService    *GitProviderAPIService
 	gitProviderId string
 	namespaceId   string
+	limit         *int32
+	cursor        *string
+	sort          *string
+	fields        *string
+}
+
+// Maximum number of items to return
+func (r ApiGetRepositoriesRequest) Limit(limit int32) ApiGetRepositoriesRequest {
+	r.limit = &limit
+	return r
+}
+
+// Cursor from the X-Next-Cursor header of the previous page
+func (r ApiGetRepositoriesRequest) Cursor(cursor string) ApiGetRepositoriesRequest {
+	r.cursor = &cursor
+	return r
+}
+
+// Field to sort by, prefixed with - for descending order
+func (r ApiGetRepositoriesRequest) Sort(sort string) ApiGetRepositoriesRequest {
+	r.sort = &sort
+	return r
+}
+
+// Comma separated list of fields to return
+func (r ApiGetRepositoriesRequest) Fields(fields string) ApiGetRepositoriesRequest {
+	r.fields = &fields
+	return r
 }
 
 func (r ApiGetRepositoriesRequest) Execute() ([]GitRepository, *http.Response, error) {
@@ -800,6 +828,18 @@ func (a *GitProviderAPIService) GetRepositoriesExecute(r ApiGetRepositoriesReque
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "")
+	}
+	if r.cursor != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "cursor", r.cursor, "")
+	}
+	if r.sort != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "sort", r.sort, "")
+	}
+	if r.fields != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "fields", r.fields, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -871,6 +911,34 @@ func (a *GitProviderAPIService) GetRepositoriesExecute(r ApiGetRepositoriesReque
 type ApiListGitProvidersRequest struct {
 	ctx        context.Context
 	ApiService *GitProviderAPIService
+	limit      *int32
+	cursor     *string
+	sort       *string
+	fields     *string
+}
+
+// Maximum number of items to return
+func (r ApiListGitProvidersRequest) Limit(limit int32) ApiListGitProvidersRequest {
+	r.limit = &limit
+	return r
+}
+
+// Cursor from the X-Next-Cursor header of the previous page
+func (r ApiListGitProvidersRequest) Cursor(cursor string) ApiListGitProvidersRequest {
+	r.cursor = &cursor
+	return r
+}
+
+// Field to sort by, prefixed with - for descending order
+func (r ApiListGitProvidersRequest) Sort(sort string) ApiListGitProvidersRequest {
+	r.sort = &sort
+	return r
+}
+
+// Comma separated list of fields to return
+func (r ApiListGitProvidersRequest) Fields(fields string) ApiListGitProvidersRequest {
+	r.fields = &fields
+	return r
 }
 
 func (r ApiListGitProvidersRequest) Execute() ([]GitProvider, *http.Response, error) {
@@ -914,6 +982,18 @@ func (a *GitProviderAPIService) ListGitProvidersExecute(r ApiListGitProvidersReq
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "")
+	}
+	if r.cursor != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "cursor", r.cursor, "")
+	}
+	if r.sort != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "sort", r.sort, "")
+	}
+	if r.fields != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "fields", r.fields, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -135,7 +135,7 @@ func (r ApiGetProfileDataRequest) Execute() (*ProfileData, *http.Response, error
 /*
 GetProfileData Get profile data
 
-Get profile data
+Get profile data. Values of build secrets are replaced with a placeholder.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiGetProfileDataRequest
@@ -256,7 +256,7 @@ func (r ApiSetProfileDataRequest) Execute() (*http.Response, error) {
 /*
 SetProfileData Set profile data
 
-Set profile data
+Set profile data. Masked build secret values sent back unchanged keep their existing value.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiSetProfileDataRequest
@@ -16,6 +16,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 )
 
@@ -254,6 +255,175 @@ func (a *ProviderAPIService) InstallProviderExecute(r ApiInstallProviderRequest)
 	return localVarHTTPResponse, nil
 }
 
+type ApiInstallProviderFromFileRequest struct {
+	ctx           context.Context
+	ApiService    *ProviderAPIService
+	name          *string
+	file          *os.File
+	checksum      *string
+	signature     *string
+	allowUnsigned *bool
+}
+
+// Provider name
+func (r ApiInstallProviderFromFileRequest) Name(name string) ApiInstallProviderFromFileRequest {
+	r.name = &name
+	return r
+}
+
+// Provider binary
+func (r ApiInstallProviderFromFileRequest) File(file *os.File) ApiInstallProviderFromFileRequest {
+	r.file = file
+	return r
+}
+
+// Hex encoded SHA-256 digest of the binary
+func (r ApiInstallProviderFromFileRequest) Checksum(checksum string) ApiInstallProviderFromFileRequest {
+	r.checksum = &checksum
+	return r
+}
+
+// Base64 encoded Ed25519 signature of the digest
+func (r ApiInstallProviderFromFileRequest) Signature(signature string) ApiInstallProviderFromFileRequest {
+	r.signature = &signature
+	return r
+}
+
+// Install the provider even if it has no checksum or signature
+func (r ApiInstallProviderFromFileRequest) AllowUnsigned(allowUnsigned bool) ApiInstallProviderFromFileRequest {
+	r.allowUnsigned = &allowUnsigned
+	return r
+}
+
+func (r ApiInstallProviderFromFileRequest) Execute() (*http.Response, error) {
+	return r.ApiService.InstallProviderFromFileExecute(r)
+}
+
+/*
+InstallProviderFromFile Install a provider from an uploaded binary
+
+Install a provider from an uploaded binary
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiInstallProviderFromFileRequest
+*/
+func (a *ProviderAPIService) InstallProviderFromFile(ctx context.Context) ApiInstallProviderFromFileRequest {
+	return ApiInstallProviderFromFileRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+func (a *ProviderAPIService) InstallProviderFromFileExecute(r ApiInstallProviderFromFileRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProviderAPIService.InstallProviderFromFile")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/provider/install/file"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.name == nil {
+		return nil, reportError("name is required and must be specified")
+	}
+	if r.file == nil {
+		return nil, reportError("file is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"multipart/form-data"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	parameterAddToHeaderOrQuery(localVarFormParams, "name", r.name, "")
+	var fileLocalVarFormFileName string
+	var fileLocalVarFileName string
+	var fileLocalVarFileBytes []byte
+
+	fileLocalVarFormFileName = "file"
+
+	fileLocalVarFile := r.file
+
+	if fileLocalVarFile != nil {
+		fbs, _ := io.ReadAll(fileLocalVarFile)
+
+		fileLocalVarFileBytes = fbs
+		fileLocalVarFileName = fileLocalVarFile.Name()
+		fileLocalVarFile.Close()
+		formFiles = append(formFiles, formFile{fileBytes: fileLocalVarFileBytes, fileName: fileLocalVarFileName, formFileName: fileLocalVarFormFileName})
+	}
+	if r.checksum != nil {
+		parameterAddToHeaderOrQuery(localVarFormParams, "checksum", r.checksum, "")
+	}
+	if r.signature != nil {
+		parameterAddToHeaderOrQuery(localVarFormParams, "signature", r.signature, "")
+	}
+	if r.allowUnsigned != nil {
+		parameterAddToHeaderOrQuery(localVarFormParams, "allowUnsigned", r.allowUnsigned, "")
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
 type ApiListProvidersRequest struct {
 	ctx        context.Context
 	ApiService *ProviderAPIService
@@ -368,6 +538,124 @@ func (a *ProviderAPIService) ListProvidersExecute(r ApiListProvidersRequest) ([]
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiRollbackProviderRequest struct {
+	ctx        context.Context
+	ApiService *ProviderAPIService
+	provider   string
+}
+
+func (r ApiRollbackProviderRequest) Execute() (*Provider, *http.Response, error) {
+	return r.ApiService.RollbackProviderExecute(r)
+}
+
+/*
+RollbackProvider Roll back a provider
+
+Reinstall the previous installed version of a provider and pin the provider to it
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param provider Provider to roll back
+	@return ApiRollbackProviderRequest
+*/
+func (a *ProviderAPIService) RollbackProvider(ctx context.Context, provider string) ApiRollbackProviderRequest {
+	return ApiRollbackProviderRequest{
+		ApiService: a,
+		ctx:        ctx,
+		provider:   provider,
+	}
+}
+
+// Execute executes the request
+//
+//	@return Provider
+func (a *ProviderAPIService) RollbackProviderExecute(r ApiRollbackProviderRequest) (*Provider, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *Provider
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProviderAPIService.RollbackProvider")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/provider/{provider}/rollback"
+	localVarPath = strings.Replace(localVarPath, "{"+"provider"+"}", url.PathEscape(parameterValueToString(r.provider, "provider")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiUninstallProviderRequest struct {
 	ctx        context.Context
 	ApiService *ProviderAPIService
@@ -25,6 +25,34 @@ type TargetAPIService service
 type ApiListTargetsRequest struct {
 	ctx        context.Context
 	ApiService *TargetAPIService
+	limit      *int32
+	cursor     *string
+	sort       *string
+	fields     *string
+}
+
+// Maximum number of items to return
+func (r ApiListTargetsRequest) Limit(limit int32) ApiListTargetsRequest {
+	r.limit = &limit
+	return r
+}
+
+// Cursor from the X-Next-Cursor header of the previous page
+func (r ApiListTargetsRequest) Cursor(cursor string) ApiListTargetsRequest {
+	r.cursor = &cursor
+	return r
+}
+
+// Field to sort by, prefixed with - for descending order
+func (r ApiListTargetsRequest) Sort(sort string) ApiListTargetsRequest {
+	r.sort = &sort
+	return r
+}
+
+// Comma separated list of fields to return
+func (r ApiListTargetsRequest) Fields(fields string) ApiListTargetsRequest {
+	r.fields = &fields
+	return r
 }
 
 func (r ApiListTargetsRequest) Execute() ([]ProviderTarget, *http.Response, error) {
@@ -34,7 +62,7 @@ func (r ApiListTargetsRequest) Execute() ([]ProviderTarget, *http.Response, erro
 /*
 ListTargets List targets
 
-List targets
+List targets. Values of masked options are replaced with a placeholder.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiListTargetsRequest
@@ -68,6 +96,18 @@ func (a *TargetAPIService) ListTargetsExecute(r ApiListTargetsRequest) ([]Provid
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "")
+	}
+	if r.cursor != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "cursor", r.cursor, "")
+	}
+	if r.sort != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "sort", r.sort, "")
+	}
+	if r.fields != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "fields", r.fields, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -242,6 +282,124 @@ func (a *TargetAPIService) RemoveTargetExecute(r ApiRemoveTargetRequest) (*http.
 	return localVarHTTPResponse, nil
 }
 
+type ApiRevealTargetRequest struct {
+	ctx        context.Context
+	ApiService *TargetAPIService
+	target     string
+}
+
+func (r ApiRevealTargetRequest) Execute() (*ProviderTarget, *http.Response, error) {
+	return r.ApiService.RevealTargetExecute(r)
+}
+
+/*
+RevealTarget Reveal a target
+
+Get a target including the values of masked options. Not available to workspace and project API keys.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param target Target name
+	@return ApiRevealTargetRequest
+*/
+func (a *TargetAPIService) RevealTarget(ctx context.Context, target string) ApiRevealTargetRequest {
+	return ApiRevealTargetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		target:     target,
+	}
+}
+
+// Execute executes the request
+//
+//	@return ProviderTarget
+func (a *TargetAPIService) RevealTargetExecute(r ApiRevealTargetRequest) (*ProviderTarget, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *ProviderTarget
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TargetAPIService.RevealTarget")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/target/{target}/reveal"
+	localVarPath = strings.Replace(localVarPath, "{"+"target"+"}", url.PathEscape(parameterValueToString(r.target, "target")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiSetTargetRequest struct {
 	ctx        context.Context
 	ApiService *TargetAPIService
@@ -261,7 +419,8 @@ func (r ApiSetTargetRequest) Execute() (*http.Response, error) {
 /*
 SetTarget Set a target
 
-Set a target
+Set a target. Options are validated against the target manifest of the provider.
+Masked options sent back unchanged keep their existing value.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiSetTargetRequest
@@ -355,3 +514,122 @@ func (a *TargetAPIService) SetTargetExecute(r ApiSetTargetRequest) (*http.Respon
 
 	return localVarHTTPResponse, nil
 }
+
+type ApiTestTargetRequest struct {
+	ctx        context.Context
+	ApiService *TargetAPIService
+	target     string
+}
+
+func (r ApiTestTargetRequest) Execute() (*TargetTestResult, *http.Response, error) {
+	return r.ApiService.TestTargetExecute(r)
+}
+
+/*
+TestTarget Test a target
+
+Check that the provider can reach the target with its options.
+Providers that can't check targets directly create and destroy an empty workspace.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param target Target name
+	@return ApiTestTargetRequest
+*/
+func (a *TargetAPIService) TestTarget(ctx context.Context, target string) ApiTestTargetRequest {
+	return ApiTestTargetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		target:     target,
+	}
+}
+
+// Execute executes the request
+//
+//	@return TargetTestResult
+func (a *TargetAPIService) TestTargetExecute(r ApiTestTargetRequest) (*TargetTestResult, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *TargetTestResult
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TargetAPIService.TestTarget")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/target/{target}/test"
+	localVarPath = strings.Replace(localVarPath, "{"+"target"+"}", url.PathEscape(parameterValueToString(r.target, "target")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
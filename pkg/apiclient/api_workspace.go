@@ -23,9 +23,10 @@ import (
 type WorkspaceAPIService service
 
 type ApiCreateWorkspaceRequest struct {
-	ctx        context.Context
-	ApiService *WorkspaceAPIService
-	workspace  *CreateWorkspaceRequest
+	ctx            context.Context
+	ApiService     *WorkspaceAPIService
+	workspace      *CreateWorkspaceRequest
+	idempotencyKey *string
 }
 
 // Create workspace
@@ -34,6 +35,12 @@ func (r ApiCreateWorkspaceRequest) Workspace(workspace CreateWorkspaceRequest) A
 	return r
 }
 
+// Key to safely retry the request. Repeated requests with the same key return the workspace created by the original request
+func (r ApiCreateWorkspaceRequest) IdempotencyKey(idempotencyKey string) ApiCreateWorkspaceRequest {
+	r.idempotencyKey = &idempotencyKey
+	return r
+}
+
 func (r ApiCreateWorkspaceRequest) Execute() (*Workspace, *http.Response, error) {
 	return r.ApiService.CreateWorkspaceExecute(r)
 }
@@ -95,6 +102,9 @@ func (a *WorkspaceAPIService) CreateWorkspaceExecute(r ApiCreateWorkspaceRequest
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	if r.idempotencyKey != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "Idempotency-Key", r.idempotencyKey, "")
+	}
 	// body params
 	localVarPostBody = r.workspace
 	if r.ctx != nil {
@@ -148,6 +158,128 @@ func (a *WorkspaceAPIService) CreateWorkspaceExecute(r ApiCreateWorkspaceRequest
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiGetProjectImageStatusRequest struct {
+	ctx         context.Context
+	ApiService  *WorkspaceAPIService
+	workspaceId string
+	projectId   string
+}
+
+func (r ApiGetProjectImageStatusRequest) Execute() (*ProjectImageStatus, *http.Response, error) {
+	return r.ApiService.GetProjectImageStatusExecute(r)
+}
+
+/*
+GetProjectImageStatus Get project image status
+
+Check if the project image is stale relative to the HEAD of the project repository
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param workspaceId Workspace ID or Name
+	@param projectId Project ID
+	@return ApiGetProjectImageStatusRequest
+*/
+func (a *WorkspaceAPIService) GetProjectImageStatus(ctx context.Context, workspaceId string, projectId string) ApiGetProjectImageStatusRequest {
+	return ApiGetProjectImageStatusRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		workspaceId: workspaceId,
+		projectId:   projectId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return ProjectImageStatus
+func (a *WorkspaceAPIService) GetProjectImageStatusExecute(r ApiGetProjectImageStatusRequest) (*ProjectImageStatus, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *ProjectImageStatus
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "WorkspaceAPIService.GetProjectImageStatus")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/workspace/{workspaceId}/{projectId}/image-status"
+	localVarPath = strings.Replace(localVarPath, "{"+"workspaceId"+"}", url.PathEscape(parameterValueToString(r.workspaceId, "workspaceId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"projectId"+"}", url.PathEscape(parameterValueToString(r.projectId, "projectId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiGetWorkspaceRequest struct {
 	ctx         context.Context
 	ApiService  *WorkspaceAPIService
@@ -270,6 +402,10 @@ type ApiListWorkspacesRequest struct {
 	ctx        context.Context
 	ApiService *WorkspaceAPIService
 	verbose    *bool
+	limit      *int32
+	cursor     *string
+	sort       *string
+	fields     *string
 }
 
 // Verbose
@@ -278,6 +414,30 @@ func (r ApiListWorkspacesRequest) Verbose(verbose bool) ApiListWorkspacesRequest
 	return r
 }
 
+// Maximum number of items to return
+func (r ApiListWorkspacesRequest) Limit(limit int32) ApiListWorkspacesRequest {
+	r.limit = &limit
+	return r
+}
+
+// Cursor from the X-Next-Cursor header of the previous page
+func (r ApiListWorkspacesRequest) Cursor(cursor string) ApiListWorkspacesRequest {
+	r.cursor = &cursor
+	return r
+}
+
+// Field to sort by, prefixed with - for descending order
+func (r ApiListWorkspacesRequest) Sort(sort string) ApiListWorkspacesRequest {
+	r.sort = &sort
+	return r
+}
+
+// Comma separated list of fields to return
+func (r ApiListWorkspacesRequest) Fields(fields string) ApiListWorkspacesRequest {
+	r.fields = &fields
+	return r
+}
+
 func (r ApiListWorkspacesRequest) Execute() ([]WorkspaceDTO, *http.Response, error) {
 	return r.ApiService.ListWorkspacesExecute(r)
 }
@@ -322,6 +482,18 @@ func (a *WorkspaceAPIService) ListWorkspacesExecute(r ApiListWorkspacesRequest)
 	if r.verbose != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "verbose", r.verbose, "")
 	}
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "")
+	}
+	if r.cursor != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "cursor", r.cursor, "")
+	}
+	if r.sort != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "sort", r.sort, "")
+	}
+	if r.fields != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "fields", r.fields, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -19,8 +19,16 @@ var _ MappedNullable = &InstallProviderRequest{}
 
 // InstallProviderRequest struct for InstallProviderRequest
 type InstallProviderRequest struct {
+	// AllowUnsigned installs the provider even if it has no checksum or signature
+	AllowUnsigned *bool `json:"allowUnsigned,omitempty"`
+	// Checksums holds the hex encoded SHA-256 digest of each binary
+	Checksums    *map[string]string `json:"checksums,omitempty"`
 	DownloadUrls *map[string]string `json:"downloadUrls,omitempty"`
 	Name         *string            `json:"name,omitempty"`
+	// Signatures holds the base64 encoded Ed25519 signature of each binary's SHA-256 digest
+	Signatures *map[string]string `json:"signatures,omitempty"`
+	// Version is reinstalled from the versions kept on the server if available
+	Version *string `json:"version,omitempty"`
 }
 
 // NewInstallProviderRequest instantiates a new InstallProviderRequest object
@@ -40,6 +48,70 @@ func NewInstallProviderRequestWithDefaults() *InstallProviderRequest {
 	return &this
 }
 
+// GetAllowUnsigned returns the AllowUnsigned field value if set, zero value otherwise.
+func (o *InstallProviderRequest) GetAllowUnsigned() bool {
+	if o == nil || IsNil(o.AllowUnsigned) {
+		var ret bool
+		return ret
+	}
+	return *o.AllowUnsigned
+}
+
+// GetAllowUnsignedOk returns a tuple with the AllowUnsigned field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InstallProviderRequest) GetAllowUnsignedOk() (*bool, bool) {
+	if o == nil || IsNil(o.AllowUnsigned) {
+		return nil, false
+	}
+	return o.AllowUnsigned, true
+}
+
+// HasAllowUnsigned returns a boolean if a field has been set.
+func (o *InstallProviderRequest) HasAllowUnsigned() bool {
+	if o != nil && !IsNil(o.AllowUnsigned) {
+		return true
+	}
+
+	return false
+}
+
+// SetAllowUnsigned gets a reference to the given bool and assigns it to the AllowUnsigned field.
+func (o *InstallProviderRequest) SetAllowUnsigned(v bool) {
+	o.AllowUnsigned = &v
+}
+
+// GetChecksums returns the Checksums field value if set, zero value otherwise.
+func (o *InstallProviderRequest) GetChecksums() map[string]string {
+	if o == nil || IsNil(o.Checksums) {
+		var ret map[string]string
+		return ret
+	}
+	return *o.Checksums
+}
+
+// GetChecksumsOk returns a tuple with the Checksums field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InstallProviderRequest) GetChecksumsOk() (*map[string]string, bool) {
+	if o == nil || IsNil(o.Checksums) {
+		return nil, false
+	}
+	return o.Checksums, true
+}
+
+// HasChecksums returns a boolean if a field has been set.
+func (o *InstallProviderRequest) HasChecksums() bool {
+	if o != nil && !IsNil(o.Checksums) {
+		return true
+	}
+
+	return false
+}
+
+// SetChecksums gets a reference to the given map[string]string and assigns it to the Checksums field.
+func (o *InstallProviderRequest) SetChecksums(v map[string]string) {
+	o.Checksums = &v
+}
+
 // GetDownloadUrls returns the DownloadUrls field value if set, zero value otherwise.
 func (o *InstallProviderRequest) GetDownloadUrls() map[string]string {
 	if o == nil || IsNil(o.DownloadUrls) {
@@ -104,6 +176,70 @@ func (o *InstallProviderRequest) SetName(v string) {
 	o.Name = &v
 }
 
+// GetSignatures returns the Signatures field value if set, zero value otherwise.
+func (o *InstallProviderRequest) GetSignatures() map[string]string {
+	if o == nil || IsNil(o.Signatures) {
+		var ret map[string]string
+		return ret
+	}
+	return *o.Signatures
+}
+
+// GetSignaturesOk returns a tuple with the Signatures field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InstallProviderRequest) GetSignaturesOk() (*map[string]string, bool) {
+	if o == nil || IsNil(o.Signatures) {
+		return nil, false
+	}
+	return o.Signatures, true
+}
+
+// HasSignatures returns a boolean if a field has been set.
+func (o *InstallProviderRequest) HasSignatures() bool {
+	if o != nil && !IsNil(o.Signatures) {
+		return true
+	}
+
+	return false
+}
+
+// SetSignatures gets a reference to the given map[string]string and assigns it to the Signatures field.
+func (o *InstallProviderRequest) SetSignatures(v map[string]string) {
+	o.Signatures = &v
+}
+
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *InstallProviderRequest) GetVersion() string {
+	if o == nil || IsNil(o.Version) {
+		var ret string
+		return ret
+	}
+	return *o.Version
+}
+
+// GetVersionOk returns a tuple with the Version field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InstallProviderRequest) GetVersionOk() (*string, bool) {
+	if o == nil || IsNil(o.Version) {
+		return nil, false
+	}
+	return o.Version, true
+}
+
+// HasVersion returns a boolean if a field has been set.
+func (o *InstallProviderRequest) HasVersion() bool {
+	if o != nil && !IsNil(o.Version) {
+		return true
+	}
+
+	return false
+}
+
+// SetVersion gets a reference to the given string and assigns it to the Version field.
+func (o *InstallProviderRequest) SetVersion(v string) {
+	o.Version = &v
+}
+
 func (o InstallProviderRequest) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -114,12 +250,24 @@ func (o InstallProviderRequest) MarshalJSON() ([]byte, error) {
 
 func (o InstallProviderRequest) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AllowUnsigned) {
+		toSerialize["allowUnsigned"] = o.AllowUnsigned
+	}
+	if !IsNil(o.Checksums) {
+		toSerialize["checksums"] = o.Checksums
+	}
 	if !IsNil(o.DownloadUrls) {
 		toSerialize["downloadUrls"] = o.DownloadUrls
 	}
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
+	if !IsNil(o.Signatures) {
+		toSerialize["signatures"] = o.Signatures
+	}
+	if !IsNil(o.Version) {
+		toSerialize["version"] = o.Version
+	}
 	return toSerialize, nil
 }
 
@@ -19,7 +19,9 @@ var _ MappedNullable = &ProfileData{}
 
 // ProfileData struct for ProfileData
 type ProfileData struct {
-	EnvVars *map[string]string `json:"envVars,omitempty"`
+	// BuildSecrets are keyed by the secret name
+	BuildSecrets *map[string]BuildSecret `json:"buildSecrets,omitempty"`
+	EnvVars      *map[string]string      `json:"envVars,omitempty"`
 }
 
 // NewProfileData instantiates a new ProfileData object
@@ -39,6 +41,38 @@ func NewProfileDataWithDefaults() *ProfileData {
 	return &this
 }
 
+// GetBuildSecrets returns the BuildSecrets field value if set, zero value otherwise.
+func (o *ProfileData) GetBuildSecrets() map[string]BuildSecret {
+	if o == nil || IsNil(o.BuildSecrets) {
+		var ret map[string]BuildSecret
+		return ret
+	}
+	return *o.BuildSecrets
+}
+
+// GetBuildSecretsOk returns a tuple with the BuildSecrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfileData) GetBuildSecretsOk() (*map[string]BuildSecret, bool) {
+	if o == nil || IsNil(o.BuildSecrets) {
+		return nil, false
+	}
+	return o.BuildSecrets, true
+}
+
+// HasBuildSecrets returns a boolean if a field has been set.
+func (o *ProfileData) HasBuildSecrets() bool {
+	if o != nil && !IsNil(o.BuildSecrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildSecrets gets a reference to the given map[string]BuildSecret and assigns it to the BuildSecrets field.
+func (o *ProfileData) SetBuildSecrets(v map[string]BuildSecret) {
+	o.BuildSecrets = &v
+}
+
 // GetEnvVars returns the EnvVars field value if set, zero value otherwise.
 func (o *ProfileData) GetEnvVars() map[string]string {
 	if o == nil || IsNil(o.EnvVars) {
@@ -81,6 +115,9 @@ func (o ProfileData) MarshalJSON() ([]byte, error) {
 
 func (o ProfileData) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.BuildSecrets) {
+		toSerialize["buildSecrets"] = o.BuildSecrets
+	}
 	if !IsNil(o.EnvVars) {
 		toSerialize["envVars"] = o.EnvVars
 	}
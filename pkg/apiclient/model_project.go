@@ -19,16 +19,24 @@ var _ MappedNullable = &Project{}
 
 // Project struct for Project
 type Project struct {
-	Build              *ProjectBuild  `json:"build,omitempty"`
-	Image              *string        `json:"image,omitempty"`
-	Name               *string        `json:"name,omitempty"`
-	PostCreateCommands []string       `json:"postCreateCommands,omitempty"`
-	PostStartCommands  []string       `json:"postStartCommands,omitempty"`
-	Repository         *GitRepository `json:"repository,omitempty"`
-	State              *ProjectState  `json:"state,omitempty"`
-	Target             *string        `json:"target,omitempty"`
-	User               *string        `json:"user,omitempty"`
-	WorkspaceId        *string        `json:"workspaceId,omitempty"`
+	Build *ProjectBuild `json:"build,omitempty"`
+	// Customizations are applied by the IDE when opening the project
+	Customizations *ProjectCustomizations `json:"customizations,omitempty"`
+	// ForwardPorts are forwarded to the local machine when opening the project
+	ForwardPorts []ProjectPort `json:"forwardPorts,omitempty"`
+	Image        *string       `json:"image,omitempty"`
+	// Lifecycle holds the devcontainer lifecycle hooks that the agent runs
+	Lifecycle          *ProjectLifecycle `json:"lifecycle,omitempty"`
+	Name               *string           `json:"name,omitempty"`
+	PostCreateCommands []string          `json:"postCreateCommands,omitempty"`
+	PostStartCommands  []string          `json:"postStartCommands,omitempty"`
+	Repository         *GitRepository    `json:"repository,omitempty"`
+	// Services run next to the project container and start, stop and get removed with it
+	Services    []ProjectService `json:"services,omitempty"`
+	State       *ProjectState    `json:"state,omitempty"`
+	Target      *string          `json:"target,omitempty"`
+	User        *string          `json:"user,omitempty"`
+	WorkspaceId *string          `json:"workspaceId,omitempty"`
 }
 
 // NewProject instantiates a new Project object
@@ -80,6 +88,70 @@ func (o *Project) SetBuild(v ProjectBuild) {
 	o.Build = &v
 }
 
+// GetCustomizations returns the Customizations field value if set, zero value otherwise.
+func (o *Project) GetCustomizations() ProjectCustomizations {
+	if o == nil || IsNil(o.Customizations) {
+		var ret ProjectCustomizations
+		return ret
+	}
+	return *o.Customizations
+}
+
+// GetCustomizationsOk returns a tuple with the Customizations field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetCustomizationsOk() (*ProjectCustomizations, bool) {
+	if o == nil || IsNil(o.Customizations) {
+		return nil, false
+	}
+	return o.Customizations, true
+}
+
+// HasCustomizations returns a boolean if a field has been set.
+func (o *Project) HasCustomizations() bool {
+	if o != nil && !IsNil(o.Customizations) {
+		return true
+	}
+
+	return false
+}
+
+// SetCustomizations gets a reference to the given ProjectCustomizations and assigns it to the Customizations field.
+func (o *Project) SetCustomizations(v ProjectCustomizations) {
+	o.Customizations = &v
+}
+
+// GetForwardPorts returns the ForwardPorts field value if set, zero value otherwise.
+func (o *Project) GetForwardPorts() []ProjectPort {
+	if o == nil || IsNil(o.ForwardPorts) {
+		var ret []ProjectPort
+		return ret
+	}
+	return o.ForwardPorts
+}
+
+// GetForwardPortsOk returns a tuple with the ForwardPorts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetForwardPortsOk() ([]ProjectPort, bool) {
+	if o == nil || IsNil(o.ForwardPorts) {
+		return nil, false
+	}
+	return o.ForwardPorts, true
+}
+
+// HasForwardPorts returns a boolean if a field has been set.
+func (o *Project) HasForwardPorts() bool {
+	if o != nil && !IsNil(o.ForwardPorts) {
+		return true
+	}
+
+	return false
+}
+
+// SetForwardPorts gets a reference to the given []ProjectPort and assigns it to the ForwardPorts field.
+func (o *Project) SetForwardPorts(v []ProjectPort) {
+	o.ForwardPorts = v
+}
+
 // GetImage returns the Image field value if set, zero value otherwise.
 func (o *Project) GetImage() string {
 	if o == nil || IsNil(o.Image) {
@@ -112,6 +184,38 @@ func (o *Project) SetImage(v string) {
 	o.Image = &v
 }
 
+// GetLifecycle returns the Lifecycle field value if set, zero value otherwise.
+func (o *Project) GetLifecycle() ProjectLifecycle {
+	if o == nil || IsNil(o.Lifecycle) {
+		var ret ProjectLifecycle
+		return ret
+	}
+	return *o.Lifecycle
+}
+
+// GetLifecycleOk returns a tuple with the Lifecycle field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetLifecycleOk() (*ProjectLifecycle, bool) {
+	if o == nil || IsNil(o.Lifecycle) {
+		return nil, false
+	}
+	return o.Lifecycle, true
+}
+
+// HasLifecycle returns a boolean if a field has been set.
+func (o *Project) HasLifecycle() bool {
+	if o != nil && !IsNil(o.Lifecycle) {
+		return true
+	}
+
+	return false
+}
+
+// SetLifecycle gets a reference to the given ProjectLifecycle and assigns it to the Lifecycle field.
+func (o *Project) SetLifecycle(v ProjectLifecycle) {
+	o.Lifecycle = &v
+}
+
 // GetName returns the Name field value if set, zero value otherwise.
 func (o *Project) GetName() string {
 	if o == nil || IsNil(o.Name) {
@@ -240,6 +344,38 @@ func (o *Project) SetRepository(v GitRepository) {
 	o.Repository = &v
 }
 
+// GetServices returns the Services field value if set, zero value otherwise.
+func (o *Project) GetServices() []ProjectService {
+	if o == nil || IsNil(o.Services) {
+		var ret []ProjectService
+		return ret
+	}
+	return o.Services
+}
+
+// GetServicesOk returns a tuple with the Services field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetServicesOk() ([]ProjectService, bool) {
+	if o == nil || IsNil(o.Services) {
+		return nil, false
+	}
+	return o.Services, true
+}
+
+// HasServices returns a boolean if a field has been set.
+func (o *Project) HasServices() bool {
+	if o != nil && !IsNil(o.Services) {
+		return true
+	}
+
+	return false
+}
+
+// SetServices gets a reference to the given []ProjectService and assigns it to the Services field.
+func (o *Project) SetServices(v []ProjectService) {
+	o.Services = v
+}
+
 // GetState returns the State field value if set, zero value otherwise.
 func (o *Project) GetState() ProjectState {
 	if o == nil || IsNil(o.State) {
@@ -381,9 +517,18 @@ func (o Project) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Build) {
 		toSerialize["build"] = o.Build
 	}
+	if !IsNil(o.Customizations) {
+		toSerialize["customizations"] = o.Customizations
+	}
+	if !IsNil(o.ForwardPorts) {
+		toSerialize["forwardPorts"] = o.ForwardPorts
+	}
 	if !IsNil(o.Image) {
 		toSerialize["image"] = o.Image
 	}
+	if !IsNil(o.Lifecycle) {
+		toSerialize["lifecycle"] = o.Lifecycle
+	}
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
@@ -396,6 +541,9 @@ func (o Project) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Repository) {
 		toSerialize["repository"] = o.Repository
 	}
+	if !IsNil(o.Services) {
+		toSerialize["services"] = o.Services
+	}
 	if !IsNil(o.State) {
 		toSerialize["state"] = o.State
 	}
@@ -20,6 +20,9 @@ var _ MappedNullable = &ProjectBuild{}
 // ProjectBuild struct for ProjectBuild
 type ProjectBuild struct {
 	Devcontainer *ProjectBuildDevcontainer `json:"devcontainer,omitempty"`
+	Dockerfile   *ProjectBuildDockerfile   `json:"dockerfile,omitempty"`
+	// Secrets are names of profile build secrets that are mounted into the build in addition to the secrets matching the repository
+	Secrets []string `json:"secrets,omitempty"`
 }
 
 // NewProjectBuild instantiates a new ProjectBuild object
@@ -71,6 +74,70 @@ func (o *ProjectBuild) SetDevcontainer(v ProjectBuildDevcontainer) {
 	o.Devcontainer = &v
 }
 
+// GetDockerfile returns the Dockerfile field value if set, zero value otherwise.
+func (o *ProjectBuild) GetDockerfile() ProjectBuildDockerfile {
+	if o == nil || IsNil(o.Dockerfile) {
+		var ret ProjectBuildDockerfile
+		return ret
+	}
+	return *o.Dockerfile
+}
+
+// GetDockerfileOk returns a tuple with the Dockerfile field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectBuild) GetDockerfileOk() (*ProjectBuildDockerfile, bool) {
+	if o == nil || IsNil(o.Dockerfile) {
+		return nil, false
+	}
+	return o.Dockerfile, true
+}
+
+// HasDockerfile returns a boolean if a field has been set.
+func (o *ProjectBuild) HasDockerfile() bool {
+	if o != nil && !IsNil(o.Dockerfile) {
+		return true
+	}
+
+	return false
+}
+
+// SetDockerfile gets a reference to the given ProjectBuildDockerfile and assigns it to the Dockerfile field.
+func (o *ProjectBuild) SetDockerfile(v ProjectBuildDockerfile) {
+	o.Dockerfile = &v
+}
+
+// GetSecrets returns the Secrets field value if set, zero value otherwise.
+func (o *ProjectBuild) GetSecrets() []string {
+	if o == nil || IsNil(o.Secrets) {
+		var ret []string
+		return ret
+	}
+	return o.Secrets
+}
+
+// GetSecretsOk returns a tuple with the Secrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectBuild) GetSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.Secrets) {
+		return nil, false
+	}
+	return o.Secrets, true
+}
+
+// HasSecrets returns a boolean if a field has been set.
+func (o *ProjectBuild) HasSecrets() bool {
+	if o != nil && !IsNil(o.Secrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecrets gets a reference to the given []string and assigns it to the Secrets field.
+func (o *ProjectBuild) SetSecrets(v []string) {
+	o.Secrets = v
+}
+
 func (o ProjectBuild) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -84,6 +151,12 @@ func (o ProjectBuild) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Devcontainer) {
 		toSerialize["devcontainer"] = o.Devcontainer
 	}
+	if !IsNil(o.Dockerfile) {
+		toSerialize["dockerfile"] = o.Dockerfile
+	}
+	if !IsNil(o.Secrets) {
+		toSerialize["secrets"] = o.Secrets
+	}
 	return toSerialize, nil
 }
 
@@ -19,9 +19,13 @@ var _ MappedNullable = &ProjectBuildDockerfile{}
 
 // ProjectBuildDockerfile struct for ProjectBuildDockerfile
 type ProjectBuildDockerfile struct {
-	Args       *map[string]string `json:"args,omitempty"`
-	Context    *string            `json:"context,omitempty"`
-	Dockerfile *string            `json:"dockerfile,omitempty"`
+	Args *map[string]string `json:"args,omitempty"`
+	// Context is the path of the build context relative to the repository root. Defaults to the repository root.
+	Context *string `json:"context,omitempty"`
+	// Dockerfile is the path of the Dockerfile relative to the repository root
+	Dockerfile *string `json:"dockerfile,omitempty"`
+	// Target is the build stage to build. The last stage is built if empty.
+	Target *string `json:"target,omitempty"`
 }
 
 // NewProjectBuildDockerfile instantiates a new ProjectBuildDockerfile object
@@ -137,6 +141,38 @@ func (o *ProjectBuildDockerfile) SetDockerfile(v string) {
 	o.Dockerfile = &v
 }
 
+// GetTarget returns the Target field value if set, zero value otherwise.
+func (o *ProjectBuildDockerfile) GetTarget() string {
+	if o == nil || IsNil(o.Target) {
+		var ret string
+		return ret
+	}
+	return *o.Target
+}
+
+// GetTargetOk returns a tuple with the Target field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectBuildDockerfile) GetTargetOk() (*string, bool) {
+	if o == nil || IsNil(o.Target) {
+		return nil, false
+	}
+	return o.Target, true
+}
+
+// HasTarget returns a boolean if a field has been set.
+func (o *ProjectBuildDockerfile) HasTarget() bool {
+	if o != nil && !IsNil(o.Target) {
+		return true
+	}
+
+	return false
+}
+
+// SetTarget gets a reference to the given string and assigns it to the Target field.
+func (o *ProjectBuildDockerfile) SetTarget(v string) {
+	o.Target = &v
+}
+
 func (o ProjectBuildDockerfile) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -156,6 +192,9 @@ func (o ProjectBuildDockerfile) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Dockerfile) {
 		toSerialize["dockerfile"] = o.Dockerfile
 	}
+	if !IsNil(o.Target) {
+		toSerialize["target"] = o.Target
+	}
 	return toSerialize, nil
 }
 
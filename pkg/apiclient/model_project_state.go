@@ -20,8 +20,10 @@ var _ MappedNullable = &ProjectState{}
 // ProjectState struct for ProjectState
 type ProjectState struct {
 	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	UpdatedAt *string    `json:"updatedAt,omitempty"`
-	Uptime    *int32     `json:"uptime,omitempty"`
+	// LifecycleHooks holds the results of the lifecycle hooks the agent ran
+	LifecycleHooks []LifecycleHookStatus `json:"lifecycleHooks,omitempty"`
+	UpdatedAt      *string               `json:"updatedAt,omitempty"`
+	Uptime         *int32                `json:"uptime,omitempty"`
 }
 
 // NewProjectState instantiates a new ProjectState object
@@ -73,6 +75,38 @@ func (o *ProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetLifecycleHooks returns the LifecycleHooks field value if set, zero value otherwise.
+func (o *ProjectState) GetLifecycleHooks() []LifecycleHookStatus {
+	if o == nil || IsNil(o.LifecycleHooks) {
+		var ret []LifecycleHookStatus
+		return ret
+	}
+	return o.LifecycleHooks
+}
+
+// GetLifecycleHooksOk returns a tuple with the LifecycleHooks field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetLifecycleHooksOk() ([]LifecycleHookStatus, bool) {
+	if o == nil || IsNil(o.LifecycleHooks) {
+		return nil, false
+	}
+	return o.LifecycleHooks, true
+}
+
+// HasLifecycleHooks returns a boolean if a field has been set.
+func (o *ProjectState) HasLifecycleHooks() bool {
+	if o != nil && !IsNil(o.LifecycleHooks) {
+		return true
+	}
+
+	return false
+}
+
+// SetLifecycleHooks gets a reference to the given []LifecycleHookStatus and assigns it to the LifecycleHooks field.
+func (o *ProjectState) SetLifecycleHooks(v []LifecycleHookStatus) {
+	o.LifecycleHooks = v
+}
+
 // GetUpdatedAt returns the UpdatedAt field value if set, zero value otherwise.
 func (o *ProjectState) GetUpdatedAt() string {
 	if o == nil || IsNil(o.UpdatedAt) {
@@ -150,6 +184,9 @@ func (o ProjectState) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.LifecycleHooks) {
+		toSerialize["lifecycleHooks"] = o.LifecycleHooks
+	}
 	if !IsNil(o.UpdatedAt) {
 		toSerialize["updatedAt"] = o.UpdatedAt
 	}
@@ -19,7 +19,11 @@ var _ MappedNullable = &Provider{}
 
 // Provider struct for Provider
 type Provider struct {
-	Name    *string `json:"name,omitempty"`
+	Capabilities *ProviderCapabilities `json:"capabilities,omitempty"`
+	LastError    *string               `json:"lastError,omitempty"`
+	Name         *string               `json:"name,omitempty"`
+	// State is one of up, restarting or failed
+	State   *string `json:"state,omitempty"`
 	Version *string `json:"version,omitempty"`
 }
 
@@ -40,6 +44,70 @@ func NewProviderWithDefaults() *Provider {
 	return &this
 }
 
+// GetCapabilities returns the Capabilities field value if set, zero value otherwise.
+func (o *Provider) GetCapabilities() ProviderCapabilities {
+	if o == nil || IsNil(o.Capabilities) {
+		var ret ProviderCapabilities
+		return ret
+	}
+	return *o.Capabilities
+}
+
+// GetCapabilitiesOk returns a tuple with the Capabilities field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Provider) GetCapabilitiesOk() (*ProviderCapabilities, bool) {
+	if o == nil || IsNil(o.Capabilities) {
+		return nil, false
+	}
+	return o.Capabilities, true
+}
+
+// HasCapabilities returns a boolean if a field has been set.
+func (o *Provider) HasCapabilities() bool {
+	if o != nil && !IsNil(o.Capabilities) {
+		return true
+	}
+
+	return false
+}
+
+// SetCapabilities gets a reference to the given ProviderCapabilities and assigns it to the Capabilities field.
+func (o *Provider) SetCapabilities(v ProviderCapabilities) {
+	o.Capabilities = &v
+}
+
+// GetLastError returns the LastError field value if set, zero value otherwise.
+func (o *Provider) GetLastError() string {
+	if o == nil || IsNil(o.LastError) {
+		var ret string
+		return ret
+	}
+	return *o.LastError
+}
+
+// GetLastErrorOk returns a tuple with the LastError field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Provider) GetLastErrorOk() (*string, bool) {
+	if o == nil || IsNil(o.LastError) {
+		return nil, false
+	}
+	return o.LastError, true
+}
+
+// HasLastError returns a boolean if a field has been set.
+func (o *Provider) HasLastError() bool {
+	if o != nil && !IsNil(o.LastError) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastError gets a reference to the given string and assigns it to the LastError field.
+func (o *Provider) SetLastError(v string) {
+	o.LastError = &v
+}
+
 // GetName returns the Name field value if set, zero value otherwise.
 func (o *Provider) GetName() string {
 	if o == nil || IsNil(o.Name) {
@@ -72,6 +140,38 @@ func (o *Provider) SetName(v string) {
 	o.Name = &v
 }
 
+// GetState returns the State field value if set, zero value otherwise.
+func (o *Provider) GetState() string {
+	if o == nil || IsNil(o.State) {
+		var ret string
+		return ret
+	}
+	return *o.State
+}
+
+// GetStateOk returns a tuple with the State field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Provider) GetStateOk() (*string, bool) {
+	if o == nil || IsNil(o.State) {
+		return nil, false
+	}
+	return o.State, true
+}
+
+// HasState returns a boolean if a field has been set.
+func (o *Provider) HasState() bool {
+	if o != nil && !IsNil(o.State) {
+		return true
+	}
+
+	return false
+}
+
+// SetState gets a reference to the given string and assigns it to the State field.
+func (o *Provider) SetState(v string) {
+	o.State = &v
+}
+
 // GetVersion returns the Version field value if set, zero value otherwise.
 func (o *Provider) GetVersion() string {
 	if o == nil || IsNil(o.Version) {
@@ -114,9 +214,18 @@ func (o Provider) MarshalJSON() ([]byte, error) {
 
 func (o Provider) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Capabilities) {
+		toSerialize["capabilities"] = o.Capabilities
+	}
+	if !IsNil(o.LastError) {
+		toSerialize["lastError"] = o.LastError
+	}
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
+	if !IsNil(o.State) {
+		toSerialize["state"] = o.State
+	}
 	if !IsNil(o.Version) {
 		toSerialize["version"] = o.Version
 	}
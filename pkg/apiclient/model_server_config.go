@@ -19,11 +19,16 @@ var _ MappedNullable = &ServerConfig{}
 
 // ServerConfig struct for ServerConfig
 type ServerConfig struct {
-	ApiPort                         *int32      `json:"apiPort,omitempty"`
-	BinariesPath                    *string     `json:"binariesPath,omitempty"`
-	BuildImageNamespace             *string     `json:"buildImageNamespace,omitempty"`
-	BuilderImage                    *string     `json:"builderImage,omitempty"`
-	BuilderRegistryServer           *string     `json:"builderRegistryServer,omitempty"`
+	ApiPort             *int32  `json:"apiPort,omitempty"`
+	BinariesPath        *string `json:"binariesPath,omitempty"`
+	BuildImageNamespace *string `json:"buildImageNamespace,omitempty"`
+	// BuildWorkers is the number of builds that run at the same time
+	BuildWorkers          *int32  `json:"buildWorkers,omitempty"`
+	BuilderImage          *string `json:"builderImage,omitempty"`
+	BuilderRegistryServer *string `json:"builderRegistryServer,omitempty"`
+	// BuildpacksBuilderImage is the Cloud Native Buildpacks builder image used for repositories without a devcontainer or Dockerfile.
+	// Language base images are used if empty.
+	BuildpacksBuilderImage          *string     `json:"buildpacksBuilderImage,omitempty"`
 	DefaultProjectImage             *string     `json:"defaultProjectImage,omitempty"`
 	DefaultProjectPostStartCommands []string    `json:"defaultProjectPostStartCommands,omitempty"`
 	DefaultProjectUser              *string     `json:"defaultProjectUser,omitempty"`
@@ -32,9 +37,15 @@ type ServerConfig struct {
 	Id                              *string     `json:"id,omitempty"`
 	LocalBuilderRegistryPort        *int32      `json:"localBuilderRegistryPort,omitempty"`
 	LogFilePath                     *string     `json:"logFilePath,omitempty"`
-	ProvidersDir                    *string     `json:"providersDir,omitempty"`
-	RegistryUrl                     *string     `json:"registryUrl,omitempty"`
-	ServerDownloadUrl               *string     `json:"serverDownloadUrl,omitempty"`
+	// PinnedProviderVersions maps provider names to the version they are pinned to
+	PinnedProviderVersions *map[string]string `json:"pinnedProviderVersions,omitempty"`
+	ProvidersDir           *string            `json:"providersDir,omitempty"`
+	// ProvidersPublicKey is the base64 encoded Ed25519 key used to verify downloaded providers.
+	// Defaults to the key built into the server.
+	ProvidersPublicKey *string    `json:"providersPublicKey,omitempty"`
+	RegistryUrl        *string    `json:"registryUrl,omitempty"`
+	ServerDownloadUrl  *string    `json:"serverDownloadUrl,omitempty"`
+	Tls                *TLSConfig `json:"tls,omitempty"`
 }
 
 // NewServerConfig instantiates a new ServerConfig object
@@ -150,6 +161,38 @@ func (o *ServerConfig) SetBuildImageNamespace(v string) {
 	o.BuildImageNamespace = &v
 }
 
+// GetBuildWorkers returns the BuildWorkers field value if set, zero value otherwise.
+func (o *ServerConfig) GetBuildWorkers() int32 {
+	if o == nil || IsNil(o.BuildWorkers) {
+		var ret int32
+		return ret
+	}
+	return *o.BuildWorkers
+}
+
+// GetBuildWorkersOk returns a tuple with the BuildWorkers field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetBuildWorkersOk() (*int32, bool) {
+	if o == nil || IsNil(o.BuildWorkers) {
+		return nil, false
+	}
+	return o.BuildWorkers, true
+}
+
+// HasBuildWorkers returns a boolean if a field has been set.
+func (o *ServerConfig) HasBuildWorkers() bool {
+	if o != nil && !IsNil(o.BuildWorkers) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildWorkers gets a reference to the given int32 and assigns it to the BuildWorkers field.
+func (o *ServerConfig) SetBuildWorkers(v int32) {
+	o.BuildWorkers = &v
+}
+
 // GetBuilderImage returns the BuilderImage field value if set, zero value otherwise.
 func (o *ServerConfig) GetBuilderImage() string {
 	if o == nil || IsNil(o.BuilderImage) {
@@ -214,6 +257,38 @@ func (o *ServerConfig) SetBuilderRegistryServer(v string) {
 	o.BuilderRegistryServer = &v
 }
 
+// GetBuildpacksBuilderImage returns the BuildpacksBuilderImage field value if set, zero value otherwise.
+func (o *ServerConfig) GetBuildpacksBuilderImage() string {
+	if o == nil || IsNil(o.BuildpacksBuilderImage) {
+		var ret string
+		return ret
+	}
+	return *o.BuildpacksBuilderImage
+}
+
+// GetBuildpacksBuilderImageOk returns a tuple with the BuildpacksBuilderImage field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetBuildpacksBuilderImageOk() (*string, bool) {
+	if o == nil || IsNil(o.BuildpacksBuilderImage) {
+		return nil, false
+	}
+	return o.BuildpacksBuilderImage, true
+}
+
+// HasBuildpacksBuilderImage returns a boolean if a field has been set.
+func (o *ServerConfig) HasBuildpacksBuilderImage() bool {
+	if o != nil && !IsNil(o.BuildpacksBuilderImage) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildpacksBuilderImage gets a reference to the given string and assigns it to the BuildpacksBuilderImage field.
+func (o *ServerConfig) SetBuildpacksBuilderImage(v string) {
+	o.BuildpacksBuilderImage = &v
+}
+
 // GetDefaultProjectImage returns the DefaultProjectImage field value if set, zero value otherwise.
 func (o *ServerConfig) GetDefaultProjectImage() string {
 	if o == nil || IsNil(o.DefaultProjectImage) {
@@ -470,6 +545,38 @@ func (o *ServerConfig) SetLogFilePath(v string) {
 	o.LogFilePath = &v
 }
 
+// GetPinnedProviderVersions returns the PinnedProviderVersions field value if set, zero value otherwise.
+func (o *ServerConfig) GetPinnedProviderVersions() map[string]string {
+	if o == nil || IsNil(o.PinnedProviderVersions) {
+		var ret map[string]string
+		return ret
+	}
+	return *o.PinnedProviderVersions
+}
+
+// GetPinnedProviderVersionsOk returns a tuple with the PinnedProviderVersions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetPinnedProviderVersionsOk() (*map[string]string, bool) {
+	if o == nil || IsNil(o.PinnedProviderVersions) {
+		return nil, false
+	}
+	return o.PinnedProviderVersions, true
+}
+
+// HasPinnedProviderVersions returns a boolean if a field has been set.
+func (o *ServerConfig) HasPinnedProviderVersions() bool {
+	if o != nil && !IsNil(o.PinnedProviderVersions) {
+		return true
+	}
+
+	return false
+}
+
+// SetPinnedProviderVersions gets a reference to the given map[string]string and assigns it to the PinnedProviderVersions field.
+func (o *ServerConfig) SetPinnedProviderVersions(v map[string]string) {
+	o.PinnedProviderVersions = &v
+}
+
 // GetProvidersDir returns the ProvidersDir field value if set, zero value otherwise.
 func (o *ServerConfig) GetProvidersDir() string {
 	if o == nil || IsNil(o.ProvidersDir) {
@@ -502,6 +609,38 @@ func (o *ServerConfig) SetProvidersDir(v string) {
 	o.ProvidersDir = &v
 }
 
+// GetProvidersPublicKey returns the ProvidersPublicKey field value if set, zero value otherwise.
+func (o *ServerConfig) GetProvidersPublicKey() string {
+	if o == nil || IsNil(o.ProvidersPublicKey) {
+		var ret string
+		return ret
+	}
+	return *o.ProvidersPublicKey
+}
+
+// GetProvidersPublicKeyOk returns a tuple with the ProvidersPublicKey field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetProvidersPublicKeyOk() (*string, bool) {
+	if o == nil || IsNil(o.ProvidersPublicKey) {
+		return nil, false
+	}
+	return o.ProvidersPublicKey, true
+}
+
+// HasProvidersPublicKey returns a boolean if a field has been set.
+func (o *ServerConfig) HasProvidersPublicKey() bool {
+	if o != nil && !IsNil(o.ProvidersPublicKey) {
+		return true
+	}
+
+	return false
+}
+
+// SetProvidersPublicKey gets a reference to the given string and assigns it to the ProvidersPublicKey field.
+func (o *ServerConfig) SetProvidersPublicKey(v string) {
+	o.ProvidersPublicKey = &v
+}
+
 // GetRegistryUrl returns the RegistryUrl field value if set, zero value otherwise.
 func (o *ServerConfig) GetRegistryUrl() string {
 	if o == nil || IsNil(o.RegistryUrl) {
@@ -566,6 +705,38 @@ func (o *ServerConfig) SetServerDownloadUrl(v string) {
 	o.ServerDownloadUrl = &v
 }
 
+// GetTls returns the Tls field value if set, zero value otherwise.
+func (o *ServerConfig) GetTls() TLSConfig {
+	if o == nil || IsNil(o.Tls) {
+		var ret TLSConfig
+		return ret
+	}
+	return *o.Tls
+}
+
+// GetTlsOk returns a tuple with the Tls field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetTlsOk() (*TLSConfig, bool) {
+	if o == nil || IsNil(o.Tls) {
+		return nil, false
+	}
+	return o.Tls, true
+}
+
+// HasTls returns a boolean if a field has been set.
+func (o *ServerConfig) HasTls() bool {
+	if o != nil && !IsNil(o.Tls) {
+		return true
+	}
+
+	return false
+}
+
+// SetTls gets a reference to the given TLSConfig and assigns it to the Tls field.
+func (o *ServerConfig) SetTls(v TLSConfig) {
+	o.Tls = &v
+}
+
 func (o ServerConfig) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -585,12 +756,18 @@ func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.BuildImageNamespace) {
 		toSerialize["buildImageNamespace"] = o.BuildImageNamespace
 	}
+	if !IsNil(o.BuildWorkers) {
+		toSerialize["buildWorkers"] = o.BuildWorkers
+	}
 	if !IsNil(o.BuilderImage) {
 		toSerialize["builderImage"] = o.BuilderImage
 	}
 	if !IsNil(o.BuilderRegistryServer) {
 		toSerialize["builderRegistryServer"] = o.BuilderRegistryServer
 	}
+	if !IsNil(o.BuildpacksBuilderImage) {
+		toSerialize["buildpacksBuilderImage"] = o.BuildpacksBuilderImage
+	}
 	if !IsNil(o.DefaultProjectImage) {
 		toSerialize["defaultProjectImage"] = o.DefaultProjectImage
 	}
@@ -615,15 +792,24 @@ func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.LogFilePath) {
 		toSerialize["logFilePath"] = o.LogFilePath
 	}
+	if !IsNil(o.PinnedProviderVersions) {
+		toSerialize["pinnedProviderVersions"] = o.PinnedProviderVersions
+	}
 	if !IsNil(o.ProvidersDir) {
 		toSerialize["providersDir"] = o.ProvidersDir
 	}
+	if !IsNil(o.ProvidersPublicKey) {
+		toSerialize["providersPublicKey"] = o.ProvidersPublicKey
+	}
 	if !IsNil(o.RegistryUrl) {
 		toSerialize["registryUrl"] = o.RegistryUrl
 	}
 	if !IsNil(o.ServerDownloadUrl) {
 		toSerialize["serverDownloadUrl"] = o.ServerDownloadUrl
 	}
+	if !IsNil(o.Tls) {
+		toSerialize["tls"] = o.Tls
+	}
 	return toSerialize, nil
 }
 
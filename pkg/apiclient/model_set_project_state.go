@@ -19,8 +19,9 @@ var _ MappedNullable = &SetProjectState{}
 
 // SetProjectState struct for SetProjectState
 type SetProjectState struct {
-	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	Uptime    *int32     `json:"uptime,omitempty"`
+	GitStatus      *GitStatus            `json:"gitStatus,omitempty"`
+	LifecycleHooks []LifecycleHookStatus `json:"lifecycleHooks,omitempty"`
+	Uptime         *int32                `json:"uptime,omitempty"`
 }
 
 // NewSetProjectState instantiates a new SetProjectState object
@@ -72,6 +73,38 @@ func (o *SetProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetLifecycleHooks returns the LifecycleHooks field value if set, zero value otherwise.
+func (o *SetProjectState) GetLifecycleHooks() []LifecycleHookStatus {
+	if o == nil || IsNil(o.LifecycleHooks) {
+		var ret []LifecycleHookStatus
+		return ret
+	}
+	return o.LifecycleHooks
+}
+
+// GetLifecycleHooksOk returns a tuple with the LifecycleHooks field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetLifecycleHooksOk() ([]LifecycleHookStatus, bool) {
+	if o == nil || IsNil(o.LifecycleHooks) {
+		return nil, false
+	}
+	return o.LifecycleHooks, true
+}
+
+// HasLifecycleHooks returns a boolean if a field has been set.
+func (o *SetProjectState) HasLifecycleHooks() bool {
+	if o != nil && !IsNil(o.LifecycleHooks) {
+		return true
+	}
+
+	return false
+}
+
+// SetLifecycleHooks gets a reference to the given []LifecycleHookStatus and assigns it to the LifecycleHooks field.
+func (o *SetProjectState) SetLifecycleHooks(v []LifecycleHookStatus) {
+	o.LifecycleHooks = v
+}
+
 // GetUptime returns the Uptime field value if set, zero value otherwise.
 func (o *SetProjectState) GetUptime() int32 {
 	if o == nil || IsNil(o.Uptime) {
@@ -117,6 +150,9 @@ func (o SetProjectState) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.LifecycleHooks) {
+		toSerialize["lifecycleHooks"] = o.LifecycleHooks
+	}
 	if !IsNil(o.Uptime) {
 		toSerialize["uptime"] = o.Uptime
 	}
@@ -7,10 +7,12 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
@@ -20,6 +22,26 @@ type BuildResult struct {
 	ProjectVolumePath  string
 	PostCreateCommands []string
 	PostStartCommands  []string
+	Services           []workspace.ProjectService
+	Lifecycle          *workspace.ProjectLifecycle
+	ForwardPorts       []workspace.ProjectPort
+	Customizations     *workspace.ProjectCustomizations
+}
+
+// Build is the record of a finished build that is saved with its results.
+// Builds are reused by projects with the same config hash or the same cache key.
+type Build struct {
+	BuildResult
+	Hash        string
+	ContentHash string
+	// CacheKey covers the content hash, the base image digests and the build secrets.
+	// It is empty if the image can't be reused for other commits.
+	CacheKey string
+	// BaseImages are the images the build is based on. Their digests are resolved again to validate the cache key.
+	BaseImages  []string
+	ProjectName string
+	CreatedAt   time.Time
+	LastUsedAt  time.Time
 }
 
 type BuilderConfig struct {
@@ -34,6 +56,11 @@ type BuilderConfig struct {
 	DefaultProjectImage             string
 	DefaultProjectUser              string
 	DefaultProjectPostStartCommands []string
+	// BuildpacksBuilderImage is the Cloud Native Buildpacks builder used for projects without a devcontainer or Dockerfile.
+	// The base image of the detected language is used if empty.
+	BuildpacksBuilderImage string
+	// ProfileDataService provides the build secrets
+	ProfileDataService profiledata.IProfileDataService
 }
 
 type IBuilder interface {
@@ -48,7 +75,12 @@ type Builder struct {
 	project           workspace.Project
 	gitProviderConfig *gitprovider.GitProviderConfig
 	hash              string
+	contentHash       string
+	cacheKey          string
+	baseImages        []string
 	projectVolumePath string
+	// buildSecrets are the values of the build secrets keyed by the secret name
+	buildSecrets map[string]string
 
 	image                           string
 	containerRegistryService        containerregistries.IContainerRegistryService
@@ -63,22 +95,65 @@ type Builder struct {
 }
 
 func (b *Builder) SaveBuildResults(r BuildResult) error {
-	err := os.MkdirAll(filepath.Join(b.serverConfigFolder, "builds", b.hash), 0755)
+	now := time.Now()
+
+	contentHash := b.contentHash
+	cacheKey := b.cacheKey
+	// Lifecycle commands that ran during the build can depend on any file of the repository
+	if r.Lifecycle != nil {
+		for _, hook := range r.Lifecycle.Prebuilt {
+			if len(r.Lifecycle.GetCommandGroups(hook)) > 0 {
+				contentHash = ""
+				cacheKey = ""
+			}
+		}
+	}
+
+	return saveBuild(b.serverConfigFolder, Build{
+		BuildResult: r,
+		Hash:        b.hash,
+		ContentHash: contentHash,
+		CacheKey:    cacheKey,
+		BaseImages:  b.baseImages,
+		ProjectName: b.project.Name,
+		CreatedAt:   now,
+		LastUsedAt:  now,
+	})
+}
+
+func saveBuild(serverConfigFolder string, build Build) error {
+	err := os.MkdirAll(filepath.Join(serverConfigFolder, "builds", build.Hash), 0755)
 	if err != nil {
 		return err
 	}
 
-	file, err := os.Create(filepath.Join(b.serverConfigFolder, "builds", b.hash, "build.json"))
+	file, err := os.Create(getBuildFilePath(serverConfigFolder, build.Hash))
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
 	encoder := json.NewEncoder(file)
-	err = encoder.Encode(r)
+	err = encoder.Encode(build)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func getBuildFilePath(serverConfigFolder, hash string) string {
+	return filepath.Join(serverConfigFolder, "builds", hash, "build.json")
+}
+
+// createLogger returns a logger that writes to both the build log and the project log
+func (b *Builder) createLogger() logs.Logger {
+	return createBuildLogger(b.loggerFactory, b.id, b.project)
+}
+
+func createBuildLogger(loggerFactory logs.LoggerFactory, buildId string, p workspace.Project) logs.Logger {
+	return logs.NewMultiLogger(
+		loggerFactory.CreateBuildLogger(buildId, p.WorkspaceId, p.Name),
+		loggerFactory.CreateProjectLogger(p.WorkspaceId, p.Name, logs.LogSourceBuilder),
+	)
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/nat"
+)
+
+// startBuilderContainer starts a container of the builder image that runs its own Docker daemon on the given port.
+// The project is mounted to /project and the build secrets, if any, to the builder secrets path.
+func (b *Builder) startBuilderContainer(builderDockerPort uint16, extraMounts []mount.Mount) error {
+	ctx := context.Background()
+
+	buildLogger := b.createLogger()
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	cr, err := b.containerRegistryService.FindByImageName(b.image)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return err
+	}
+
+	err = dockerClient.PullImage(b.image, cr, buildLogger)
+	if err != nil {
+		return err
+	}
+
+	serverHost, err := containerregistry.GetServerHostname(b.containerRegistryServer)
+	if err != nil {
+		return err
+	}
+
+	mounts := []mount.Mount{
+		{
+			Type:   mount.TypeBind,
+			Source: b.projectVolumePath,
+			Target: "/project",
+		},
+	}
+
+	if len(b.buildSecrets) > 0 {
+		secretsDir, err := b.writeBuildSecrets()
+		if err != nil {
+			return err
+		}
+
+		mounts = append(mounts, mount.Mount{
+			Type:     mount.TypeBind,
+			Source:   secretsDir,
+			Target:   builderSecretsPath,
+			ReadOnly: true,
+		})
+
+		buildLogger.Write([]byte(fmt.Sprintf("Mounting %d build secret(s) to %s\n", len(b.buildSecrets), BUILD_SECRETS_PATH)))
+	}
+
+	mounts = append(mounts, extraMounts...)
+
+	_, err = cli.ContainerCreate(ctx, &container.Config{
+		Image:      b.image,
+		Entrypoint: []string{"sudo", "dockerd", "-H", fmt.Sprintf("tcp://0.0.0.0:%d", builderDockerPort), "-H", "unix:///var/run/docker.sock", "--insecure-registry", serverHost},
+		ExposedPorts: nat.PortSet{
+			nat.Port(fmt.Sprintf("%d/tcp", builderDockerPort)): {},
+		},
+	}, &container.HostConfig{
+		Privileged: true,
+		Mounts:     mounts,
+		PortBindings: nat.PortMap{
+			nat.Port(fmt.Sprintf("%d/tcp", builderDockerPort)): []nat.PortBinding{
+				{
+					HostIP:   "0.0.0.0",
+					HostPort: fmt.Sprint(builderDockerPort),
+				},
+			},
+		},
+	}, nil, nil, b.id)
+	if err != nil {
+		return err
+	}
+
+	err = cli.ContainerStart(ctx, b.id, container.StartOptions{})
+	if err != nil {
+		return err
+	}
+
+	// Wait for docker to start
+	builderCli, err := getBuilderDockerClient(builderDockerPort)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < 30; i++ {
+		time.Sleep(1 * time.Second)
+		_, err = builderCli.Ping(ctx)
+		if err == nil {
+			break
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("timeout waiting for dockerd to start: %v", err)
+	}
+
+	return nil
+}
+
+// removeBuilderContainer removes the builder container of the build if it was started
+func (b *Builder) removeBuilderContainer() error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	err = cli.ContainerRemove(context.Background(), b.id, container.RemoveOptions{
+		Force: true,
+	})
+	if err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func getBuilderDockerClient(builderDockerPort uint16) (*client.Client, error) {
+	return client.NewClientWithOpts(client.WithHost(fmt.Sprintf("tcp://127.0.0.1:%d", builderDockerPort)), client.WithAPIVersionNegotiation())
+}
@@ -0,0 +1,185 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/builder/buildpacks"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+)
+
+// BuildpacksBuilder builds projects that have neither a devcontainer nor a Dockerfile.
+// If a buildpacks builder image is configured, the image is built with the Cloud Native Buildpacks lifecycle of that builder.
+// Otherwise the base image of the detected language is used.
+type BuildpacksBuilder struct {
+	*Builder
+	language          *buildpacks.Language
+	buildpacksBuilder string
+	buildImageName    string
+}
+
+func (b *BuildpacksBuilder) Build() (*BuildResult, error) {
+	buildLogger := b.createLogger()
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	imageName := fmt.Sprintf("%s%s/p-%s:%s", b.containerRegistryServer, b.buildImageNamespace, b.id, b.project.Repository.Sha)
+
+	if b.buildpacksBuilder != "" {
+		buildLogger.Write([]byte(fmt.Sprintf("Detected %s project. Building with buildpacks builder %s\n", b.language.Name, b.buildpacksBuilder)))
+		err = b.buildWithBuildpacks(cli, imageName, buildLogger)
+	} else {
+		buildLogger.Write([]byte(fmt.Sprintf("Detected %s project. Using base image %s\n", b.language.Name, b.language.Image)))
+		err = b.tagBaseImage(cli, imageName, buildLogger)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b.buildImageName = imageName
+
+	user := b.language.User
+	if b.buildpacksBuilder != "" {
+		// Buildpacks images run as the user of the run image
+		imageInfo, _, err := cli.ImageInspectWithRaw(context.Background(), imageName)
+		if err != nil {
+			return nil, err
+		}
+
+		user = "root"
+		if imageInfo.Config != nil && imageInfo.Config.User != "" {
+			user = imageInfo.Config.User
+		}
+	}
+
+	return &BuildResult{
+		User:              user,
+		ImageName:         b.buildImageName,
+		ProjectVolumePath: b.projectVolumePath,
+	}, nil
+}
+
+func (b *BuildpacksBuilder) CleanUp() error {
+	return os.RemoveAll(b.projectVolumePath)
+}
+
+func (b *BuildpacksBuilder) Publish() error {
+	buildLogger := b.createLogger()
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	cr, err := b.containerRegistryService.Find(b.containerRegistryServer)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return err
+	}
+
+	return dockerClient.PushImage(b.buildImageName, cr, buildLogger)
+}
+
+// tagBaseImage pulls the base image of the language and tags it as the build image
+func (b *BuildpacksBuilder) tagBaseImage(cli *client.Client, imageName string, logWriter io.Writer) error {
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	cr, err := b.containerRegistryService.FindByImageName(b.language.Image)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return err
+	}
+
+	err = dockerClient.PullImage(b.language.Image, cr, logWriter)
+	if err != nil {
+		return err
+	}
+
+	return cli.ImageTag(context.Background(), b.language.Image, imageName)
+}
+
+// buildWithBuildpacks runs the lifecycle creator of the builder image against the Docker daemon of the server.
+// The creator detects the buildpacks, builds the project and exports the image to the daemon.
+func (b *BuildpacksBuilder) buildWithBuildpacks(cli *client.Client, imageName string, logWriter io.Writer) error {
+	ctx := context.Background()
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	cr, err := b.containerRegistryService.FindByImageName(b.buildpacksBuilder)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return err
+	}
+
+	err = dockerClient.PullImage(b.buildpacksBuilder, cr, logWriter)
+	if err != nil {
+		return err
+	}
+
+	_, err = cli.ContainerCreate(ctx, &container.Config{
+		Image: b.buildpacksBuilder,
+		// The creator needs root to access the Docker socket
+		User: "root",
+		Cmd:  []string{"/cnb/lifecycle/creator", "-daemon", "-app", "/workspace", imageName},
+	}, &container.HostConfig{
+		Mounts: []mount.Mount{
+			{
+				Type:   mount.TypeBind,
+				Source: b.projectVolumePath,
+				Target: "/workspace",
+			},
+			{
+				Type:   mount.TypeBind,
+				Source: "/var/run/docker.sock",
+				Target: "/var/run/docker.sock",
+			},
+		},
+	}, nil, nil, b.id)
+	if err != nil {
+		return err
+	}
+	defer cli.ContainerRemove(ctx, b.id, container.RemoveOptions{
+		Force: true,
+	})
+
+	err = cli.ContainerStart(ctx, b.id, container.StartOptions{})
+	if err != nil {
+		return err
+	}
+
+	err = dockerClient.GetContainerLogs(b.id, logWriter)
+	if err != nil {
+		return err
+	}
+
+	statusCh, errCh := cli.ContainerWait(ctx, b.id, container.WaitConditionNotRunning)
+	select {
+	case err := <-errCh:
+		return err
+	case status := <-statusCh:
+		if status.StatusCode != 0 {
+			return fmt.Errorf("buildpacks build failed with exit code %d", status.StatusCode)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildpacks
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Language is a project language with the base image that contains its toolchain
+type Language struct {
+	Name string
+	// Image is used as the project image if no buildpacks builder image is configured
+	Image string
+	User  string
+	// detectPatterns are glob patterns of files in the repository root that identify the language
+	detectPatterns []string
+}
+
+// Languages are checked in order, the first language with a matching file is used.
+// Languages with more specific manifests come first, e.g. a Rust project may contain a package.json for its frontend.
+var Languages = []Language{
+	{
+		Name:           "go",
+		Image:          "mcr.microsoft.com/devcontainers/go:1",
+		User:           "vscode",
+		detectPatterns: []string{"go.mod"},
+	},
+	{
+		Name:           "rust",
+		Image:          "mcr.microsoft.com/devcontainers/rust:1",
+		User:           "vscode",
+		detectPatterns: []string{"Cargo.toml"},
+	},
+	{
+		Name:           "java",
+		Image:          "mcr.microsoft.com/devcontainers/java:1",
+		User:           "vscode",
+		detectPatterns: []string{"pom.xml", "build.gradle", "build.gradle.kts"},
+	},
+	{
+		Name:           "dotnet",
+		Image:          "mcr.microsoft.com/devcontainers/dotnet:1",
+		User:           "vscode",
+		detectPatterns: []string{"*.sln", "*.csproj", "*.fsproj", "global.json"},
+	},
+	{
+		Name:           "python",
+		Image:          "mcr.microsoft.com/devcontainers/python:1",
+		User:           "vscode",
+		detectPatterns: []string{"requirements.txt", "pyproject.toml", "setup.py", "Pipfile"},
+	},
+	{
+		Name:           "ruby",
+		Image:          "mcr.microsoft.com/devcontainers/ruby:1",
+		User:           "vscode",
+		detectPatterns: []string{"Gemfile"},
+	},
+	{
+		Name:           "php",
+		Image:          "mcr.microsoft.com/devcontainers/php:1",
+		User:           "vscode",
+		detectPatterns: []string{"composer.json"},
+	},
+	{
+		Name:           "node",
+		Image:          "mcr.microsoft.com/devcontainers/javascript-node:1",
+		User:           "node",
+		detectPatterns: []string{"package.json"},
+	},
+}
+
+// DetectLanguage returns the language of the project in the directory or nil if no language was detected
+func DetectLanguage(projectDir string) (*Language, error) {
+	for _, language := range Languages {
+		for _, pattern := range language.detectPatterns {
+			matches, err := filepath.Glob(filepath.Join(projectDir, pattern))
+			if err != nil {
+				return nil, err
+			}
+
+			for _, match := range matches {
+				info, err := os.Stat(match)
+				if err != nil {
+					return nil, err
+				}
+				if !info.IsDir() {
+					return &language, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
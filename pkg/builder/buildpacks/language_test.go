@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildpacks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []string
+		language string
+	}{
+		{name: "Go", files: []string{"go.mod", "main.go"}, language: "go"},
+		{name: "Node", files: []string{"package.json"}, language: "node"},
+		{name: "Python", files: []string{"requirements.txt"}, language: "python"},
+		{name: "Rust with a frontend", files: []string{"Cargo.toml", "package.json"}, language: "rust"},
+		{name: ".NET project file", files: []string{"App.csproj"}, language: "dotnet"},
+		{name: "Unknown", files: []string{"README.md"}, language: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectDir := t.TempDir()
+			for _, file := range tt.files {
+				require.Nil(t, os.WriteFile(filepath.Join(projectDir, file), []byte{}, 0644))
+			}
+
+			language, err := DetectLanguage(projectDir)
+			require.Nil(t, err)
+
+			if tt.language == "" {
+				require.Nil(t, language)
+				return
+			}
+
+			require.NotNil(t, language)
+			require.Equal(t, tt.language, language.Name)
+		})
+	}
+
+	t.Run("Ignores directories", func(t *testing.T) {
+		projectDir := t.TempDir()
+		require.Nil(t, os.Mkdir(filepath.Join(projectDir, "go.mod"), 0755))
+
+		language, err := DetectLanguage(projectDir)
+		require.Nil(t, err)
+		require.Nil(t, language)
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ListBuilds returns the saved builds sorted by creation time, newest first
+func (f *BuilderFactory) ListBuilds() ([]*Build, error) {
+	entries, err := os.ReadDir(filepath.Join(f.serverConfigFolder, "builds"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*Build{}, nil
+		}
+		return nil, err
+	}
+
+	builds := []*Build{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		build, err := readBuild(f.serverConfigFolder, entry.Name())
+		if err != nil {
+			// Builds that are in progress or failed have no saved results
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	sort.Slice(builds, func(i, j int) bool {
+		return builds[i].CreatedAt.After(builds[j].CreatedAt)
+	})
+
+	return builds, nil
+}
+
+// DeleteBuild removes the saved build results and the cloned sources of the build
+func (f *BuilderFactory) DeleteBuild(hash string) error {
+	if hash == "" || filepath.Base(hash) != hash {
+		return fmt.Errorf("invalid build hash %s", hash)
+	}
+
+	err := os.RemoveAll(filepath.Join(f.serverConfigFolder, "builds", hash))
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(filepath.Join(f.basePath, hash))
+}
+
+func readBuild(serverConfigFolder, hash string) (*Build, error) {
+	filePath := getBuildFilePath(serverConfigFolder, hash)
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var build Build
+	decoder := json.NewDecoder(file)
+	err = decoder.Decode(&build)
+	if err != nil {
+		return nil, err
+	}
+
+	// Builds saved by older versions only contain the build result
+	if build.Hash == "" {
+		build.Hash = hash
+	}
+	if build.CreatedAt.IsZero() {
+		info, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+		build.CreatedAt = info.ModTime()
+	}
+	if build.LastUsedAt.IsZero() {
+		build.LastUsedAt = build.CreatedAt
+	}
+
+	return &build, nil
+}
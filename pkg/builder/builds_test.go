@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/builder"
+	"github.com/stretchr/testify/require"
+)
+
+func writeBuildFile(t *testing.T, configDir, hash string, content interface{}) {
+	dir := filepath.Join(configDir, "builds", hash)
+	require.Nil(t, os.MkdirAll(filepath.Join(dir, "project"), 0755))
+
+	data, err := json.Marshal(content)
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "build.json"), data, 0644))
+}
+
+func TestListAndDeleteBuilds(t *testing.T) {
+	configDir := t.TempDir()
+
+	factory := builder.NewBuilderFactory(builder.BuilderConfig{
+		ServerConfigFolder: configDir,
+		BasePath:           filepath.Join(configDir, "builds"),
+	})
+
+	// Builds saved by older versions only contain the build result
+	writeBuildFile(t, configDir, "legacy", builder.BuildResult{ImageName: "registry/p-legacy:1"})
+	// Builds that are in progress have no results yet
+	require.Nil(t, os.MkdirAll(filepath.Join(configDir, "builds", "in-progress", "project"), 0755))
+
+	builds, err := factory.ListBuilds()
+	require.Nil(t, err)
+	require.Len(t, builds, 1)
+	require.Equal(t, "legacy", builds[0].Hash)
+	require.Equal(t, "registry/p-legacy:1", builds[0].ImageName)
+	require.False(t, builds[0].CreatedAt.IsZero())
+	require.Equal(t, builds[0].CreatedAt, builds[0].LastUsedAt)
+
+	err = factory.DeleteBuild("legacy")
+	require.Nil(t, err)
+
+	_, err = os.Stat(filepath.Join(configDir, "builds", "legacy"))
+	require.True(t, os.IsNotExist(err))
+
+	builds, err = factory.ListBuilds()
+	require.Nil(t, err)
+	require.Empty(t, builds)
+
+	err = factory.DeleteBuild("../legacy")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"os"
+)
+
+// CachedBuilder reuses the image of a saved build with the same cache key instead of building again
+type CachedBuilder struct {
+	*Builder
+	build *Build
+}
+
+func (b *CachedBuilder) Build() (*BuildResult, error) {
+	result := b.build.BuildResult
+	result.ProjectVolumePath = b.projectVolumePath
+
+	return &result, nil
+}
+
+func (b *CachedBuilder) CleanUp() error {
+	return os.RemoveAll(b.projectVolumePath)
+}
+
+// Publish does nothing since the image was already pushed by the original build
+func (b *CachedBuilder) Publish() error {
+	return nil
+}
@@ -13,19 +13,17 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/daytonaio/daytona/pkg/builder/devcontainer"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/docker"
-	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/client"
-	"github.com/docker/go-connections/nat"
 )
 
 type BuildOutcome struct {
@@ -37,14 +35,22 @@ type BuildOutcome struct {
 
 type DevcontainerBuilder struct {
 	*Builder
-	buildImageName     string
-	user               string
-	builderDockerPort  uint16
-	postCreateCommands []string
-	postStartCommands  []string
+	buildImageName    string
+	user              string
+	builderDockerPort uint16
+	// overrideConfig is set if the devcontainer config is overridden to pass the build secrets to the image build
+	overrideConfig    bool
+	postStartCommands []string
+	services          []workspace.ProjectService
+	lifecycle         *workspace.ProjectLifecycle
+	forwardPorts      []workspace.ProjectPort
+	customizations    *workspace.ProjectCustomizations
 }
 
 func (b *DevcontainerBuilder) Build() (*BuildResult, error) {
+	// Secrets are only needed while the devcontainer is built
+	defer b.removeBuildSecrets()
+
 	err := b.startContainer()
 	if err != nil {
 		return nil, err
@@ -61,11 +67,14 @@ func (b *DevcontainerBuilder) Build() (*BuildResult, error) {
 	}
 
 	return &BuildResult{
-		User:               b.user,
-		ImageName:          b.buildImageName,
-		ProjectVolumePath:  b.projectVolumePath,
-		PostCreateCommands: b.postCreateCommands,
-		PostStartCommands:  b.postStartCommands,
+		User:              b.user,
+		ImageName:         b.buildImageName,
+		ProjectVolumePath: b.projectVolumePath,
+		PostStartCommands: b.postStartCommands,
+		Services:          b.services,
+		Lifecycle:         b.lifecycle,
+		ForwardPorts:      b.forwardPorts,
+		Customizations:    b.customizations,
 	}, nil
 }
 
@@ -84,6 +93,11 @@ func (b *DevcontainerBuilder) CleanUp() error {
 		return err
 	}
 
+	err = b.removeBuildSecrets()
+	if err != nil {
+		return err
+	}
+
 	err = os.RemoveAll(b.projectVolumePath)
 	if err != nil {
 		return err
@@ -93,8 +107,8 @@ func (b *DevcontainerBuilder) CleanUp() error {
 }
 
 func (b *DevcontainerBuilder) Publish() error {
-	projectLogger := b.loggerFactory.CreateProjectLogger(b.project.WorkspaceId, b.project.Name, logs.LogSourceBuilder)
-	defer projectLogger.Close()
+	buildLogger := b.createLogger()
+	defer buildLogger.Close()
 
 	cliBuilder, err := b.getBuilderDockerClient()
 	if err != nil {
@@ -110,12 +124,12 @@ func (b *DevcontainerBuilder) Publish() error {
 		return err
 	}
 
-	return dockerClient.PushImage(b.buildImageName, cr, projectLogger)
+	return dockerClient.PushImage(b.buildImageName, cr, buildLogger)
 }
 
 func (b *DevcontainerBuilder) buildDevcontainer() error {
-	projectLogger := b.loggerFactory.CreateProjectLogger(b.project.WorkspaceId, b.project.Name, logs.LogSourceBuilder)
-	defer projectLogger.Close()
+	buildLogger := b.createLogger()
+	defer buildLogger.Close()
 
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
@@ -130,6 +144,13 @@ func (b *DevcontainerBuilder) buildDevcontainer() error {
 	if b.project.Build.Devcontainer.DevContainerFilePath != "" {
 		cmd = append(cmd, "--config", filepath.Join("/project", b.project.Build.Devcontainer.DevContainerFilePath))
 	}
+	if b.overrideConfig {
+		cmd = append(cmd, "--override-config", builderOverrideConfigPath)
+	}
+	// Bind mounts are not part of the committed image
+	if len(b.buildSecrets) > 0 {
+		cmd = append(cmd, "--mount", fmt.Sprintf("type=bind,source=%s,target=%s", builderSecretsPath, BUILD_SECRETS_PATH))
+	}
 
 	execConfig := types.ExecConfig{
 		AttachStdout: true,
@@ -146,7 +167,7 @@ func (b *DevcontainerBuilder) buildDevcontainer() error {
 		scanner := bufio.NewScanner(r)
 		for scanner.Scan() {
 			lastLine = scanner.Text()
-			projectLogger.Write([]byte(lastLine + "\n"))
+			buildLogger.Write([]byte(lastLine + "\n"))
 
 			if strings.Contains(lastLine, `{"outcome"`) {
 				start := strings.Index(lastLine, "{")
@@ -205,8 +226,8 @@ func (b *DevcontainerBuilder) buildDevcontainer() error {
 }
 
 func (b *DevcontainerBuilder) readConfiguration() error {
-	projectLogger := b.loggerFactory.CreateProjectLogger(b.project.WorkspaceId, b.project.Name, logs.LogSourceBuilder)
-	defer projectLogger.Close()
+	buildLogger := b.createLogger()
+	defer buildLogger.Close()
 
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
@@ -259,107 +280,80 @@ func (b *DevcontainerBuilder) readConfiguration() error {
 		return err
 	}
 
-	postCreateCommands, err := devcontainer.ConvertCommands(root.MergedConfiguration.PostCreateCommands)
+	// Feature entrypoints keep running in the background while lifecycle commands are run by the agent
+	b.postStartCommands = append(b.postStartCommands, root.MergedConfiguration.Entrypoints...)
+
+	b.lifecycle, err = devcontainer.GetLifecycle(root.MergedConfiguration)
 	if err != nil {
-		projectLogger.Write([]byte(fmt.Sprintf("Error converting post create commands: %v\n", err)))
+		buildLogger.Write([]byte(fmt.Sprintf("Error converting lifecycle commands: %v\n", err)))
+	}
+	if b.lifecycle != nil {
+		// devcontainer up --prebuild runs these hooks before the container is committed
+		b.lifecycle.Prebuilt = []workspace.LifecycleHook{workspace.LifecycleHookOnCreate, workspace.LifecycleHookUpdateContent}
 	}
 
-	postStartCommands, err := devcontainer.ConvertCommands(root.MergedConfiguration.PostStartCommands)
+	b.forwardPorts, err = devcontainer.GetForwardPorts(root.MergedConfiguration)
 	if err != nil {
-		projectLogger.Write([]byte(fmt.Sprintf("Error converting post start commands: %v\n", err)))
+		buildLogger.Write([]byte(fmt.Sprintf("Error converting forward ports: %v\n", err)))
 	}
 
-	b.postCreateCommands = append(b.postCreateCommands, postCreateCommands...)
-	b.postStartCommands = append(b.postStartCommands, root.MergedConfiguration.Entrypoints...)
-	b.postStartCommands = append(b.postStartCommands, postStartCommands...)
-
-	return nil
-}
-
-func (b *DevcontainerBuilder) startContainer() error {
-	ctx := context.Background()
-
-	projectLogger := b.loggerFactory.CreateProjectLogger(b.project.WorkspaceId, b.project.Name, logs.LogSourceBuilder)
-	defer projectLogger.Close()
-
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	b.customizations, err = devcontainer.GetCustomizations(root.MergedConfiguration)
 	if err != nil {
-		return err
+		buildLogger.Write([]byte(fmt.Sprintf("Error converting customizations: %v\n", err)))
 	}
 
-	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
-		ApiClient: cli,
-	})
-
-	cr, err := b.containerRegistryService.FindByImageName(b.image)
-	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
-		return err
-	}
+	return b.readComposeServices(root.MergedConfiguration, buildLogger)
+}
 
-	err = dockerClient.PullImage(b.image, cr, projectLogger)
+// readComposeServices reads the services that run next to the project container of Docker Compose based devcontainers
+func (b *DevcontainerBuilder) readComposeServices(config devcontainer.MergedConfiguration, logWriter io.Writer) error {
+	composeFilePaths, err := devcontainer.GetComposeFilePaths(config.DockerComposeFile)
 	if err != nil {
 		return err
 	}
 
-	serverHost, err := containerregistry.GetServerHostname(b.containerRegistryServer)
-	if err != nil {
-		return err
+	if len(composeFilePaths) == 0 {
+		return nil
 	}
 
-	_, err = cli.ContainerCreate(ctx, &container.Config{
-		Image:      b.image,
-		Entrypoint: []string{"sudo", "dockerd", "-H", fmt.Sprintf("tcp://0.0.0.0:%d", b.builderDockerPort), "-H", "unix:///var/run/docker.sock", "--insecure-registry", serverHost},
-		ExposedPorts: nat.PortSet{
-			nat.Port(fmt.Sprintf("%d/tcp", b.builderDockerPort)): {},
-		},
-	}, &container.HostConfig{
-		Privileged: true,
-		Mounts: []mount.Mount{
-			{
-				Type:   mount.TypeBind,
-				Source: b.projectVolumePath,
-				Target: "/project",
-			},
-		},
-		PortBindings: nat.PortMap{
-			nat.Port(fmt.Sprintf("%d/tcp", b.builderDockerPort)): []nat.PortBinding{
-				{
-					HostIP:   "0.0.0.0",
-					HostPort: fmt.Sprint(b.builderDockerPort),
-				},
-			},
-		},
-	}, nil, nil, b.id)
-	if err != nil {
-		return err
+	devContainerFilePath := b.project.Build.Devcontainer.DevContainerFilePath
+	if devContainerFilePath == "" {
+		devContainerFilePath = ".devcontainer/devcontainer.json"
 	}
 
-	err = cli.ContainerStart(ctx, b.id, container.StartOptions{})
-	if err != nil {
-		return err
+	// Compose file paths are relative to the devcontainer config
+	configDir := filepath.Join(b.projectVolumePath, filepath.Dir(devContainerFilePath))
+	for i, path := range composeFilePaths {
+		composeFilePaths[i] = filepath.Join(configDir, path)
 	}
 
-	// Wait for docker to start
-	builderCli, err := b.getBuilderDockerClient()
-	if err != nil {
-		return err
-	}
+	b.services, err = devcontainer.GetComposeServices(composeFilePaths, config.Service, config.RunServices, logWriter)
+	return err
+}
 
-	for i := 0; i < 30; i++ {
-		time.Sleep(1 * time.Second)
-		_, err = builderCli.Ping(ctx)
-		if err == nil {
-			break
+func (b *DevcontainerBuilder) startContainer() error {
+	mounts := []mount.Mount{}
+
+	if len(b.buildSecrets) > 0 {
+		overrideConfigPath, err := b.writeSecretsOverrideConfig()
+		if err != nil {
+			return err
 		}
-	}
 
-	if err != nil {
-		return fmt.Errorf("timeout waiting for dockerd to start: %v", err)
+		if overrideConfigPath != "" {
+			mounts = append(mounts, mount.Mount{
+				Type:     mount.TypeBind,
+				Source:   overrideConfigPath,
+				Target:   builderOverrideConfigPath,
+				ReadOnly: true,
+			})
+			b.overrideConfig = true
+		}
 	}
 
-	return nil
+	return b.startBuilderContainer(b.builderDockerPort, mounts)
 }
 
 func (b *DevcontainerBuilder) getBuilderDockerClient() (*client.Client, error) {
-	return client.NewClientWithOpts(client.WithHost(fmt.Sprintf("tcp://127.0.0.1:%d", b.builderDockerPort)), client.WithAPIVersionNegotiation())
+	return getBuilderDockerClient(b.builderDockerPort)
 }
@@ -0,0 +1,339 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"gopkg.in/yaml.v2"
+)
+
+type composeFile struct {
+	Services map[string]composeService `yaml:"services"`
+}
+
+type composeService struct {
+	Image string      `yaml:"image"`
+	Build interface{} `yaml:"build"`
+	// Environment is either a map or a list of KEY=VALUE strings
+	Environment interface{} `yaml:"environment"`
+	// Command is either a string or a list of arguments
+	Command interface{} `yaml:"command"`
+	// DependsOn is either a list of service names or a map of service names to their condition
+	DependsOn interface{}   `yaml:"depends_on"`
+	Volumes   []interface{} `yaml:"volumes"`
+	Ports     []interface{} `yaml:"ports"`
+}
+
+// GetComposeFilePaths returns the compose file paths of the dockerComposeFile property, which is either a string or a list
+func GetComposeFilePaths(dockerComposeFile interface{}) ([]string, error) {
+	switch value := dockerComposeFile.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{value}, nil
+	case []interface{}:
+		paths := []string{}
+		for _, path := range value {
+			pathStr, ok := path.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid dockerComposeFile entry: %v", path)
+			}
+			paths = append(paths, pathStr)
+		}
+		return paths, nil
+	}
+
+	return nil, fmt.Errorf("invalid dockerComposeFile: %v", dockerComposeFile)
+}
+
+// GetComposeServices reads the compose files and returns the services that run next to the project service,
+// ordered so that services start after the services they depend on.
+// Later files override the services of earlier ones. If runServices is not empty, only the listed services
+// and the services they depend on are returned.
+// Services can only run an image and can't have volumes. Their ports are reachable from the project
+// by the service name, but they are not published on the host.
+func GetComposeServices(composeFilePaths []string, projectService string, runServices []string, logWriter io.Writer) ([]workspace.ProjectService, error) {
+	merged, err := readComposeFiles(composeFilePaths)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := merged[projectService]; !ok {
+		return nil, fmt.Errorf("service %s not found in the compose files", projectService)
+	}
+
+	for _, name := range runServices {
+		if _, ok := merged[name]; !ok {
+			return nil, fmt.Errorf("service %s from runServices not found in the compose files", name)
+		}
+	}
+
+	names := []string{}
+	for name := range merged {
+		if name == projectService {
+			continue
+		}
+		if len(runServices) > 0 && !slices.Contains(runServices, name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	names, err = orderComposeServices(names, merged, projectService)
+	if err != nil {
+		return nil, err
+	}
+
+	services := []workspace.ProjectService{}
+	for _, name := range names {
+		service := merged[name]
+		if service.Build != nil {
+			return nil, fmt.Errorf("service %s is built from source, which is only supported for the project service. Use an image for it or leave it out with runServices", name)
+		}
+		if service.Image == "" {
+			return nil, fmt.Errorf("service %s has no image", name)
+		}
+		if len(service.Volumes) > 0 {
+			return nil, fmt.Errorf("service %s has volumes, which are not supported for services that run next to the project", name)
+		}
+
+		if len(service.Ports) > 0 && logWriter != nil {
+			logWriter.Write([]byte(fmt.Sprintf("Ports of service %s are reachable from the project at %s:<port> and are not published on the host\n", name, name)))
+		}
+
+		envVars, err := convertEnvironment(service.Environment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid environment of service %s: %w", name, err)
+		}
+
+		command, err := convertCommand(service.Command)
+		if err != nil {
+			return nil, fmt.Errorf("invalid command of service %s: %w", name, err)
+		}
+
+		services = append(services, workspace.ProjectService{
+			Name:    name,
+			Image:   service.Image,
+			EnvVars: envVars,
+			Command: command,
+		})
+	}
+
+	return services, nil
+}
+
+// GetComposeServiceBuild returns the image of the service and, if the service is built from source, its build context
+// and Dockerfile. Relative build contexts are relative to the directory of the first compose file and the Dockerfile
+// is relative to the build context.
+func GetComposeServiceBuild(composeFilePaths []string, serviceName string) (image, context, dockerfile string, err error) {
+	merged, err := readComposeFiles(composeFilePaths)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	service, ok := merged[serviceName]
+	if !ok {
+		return "", "", "", fmt.Errorf("service %s not found in the compose files", serviceName)
+	}
+
+	switch build := service.Build.(type) {
+	case nil:
+		return service.Image, "", "", nil
+	case string:
+		context = build
+	case map[interface{}]interface{}:
+		if value, ok := build["context"].(string); ok {
+			context = value
+		}
+		if value, ok := build["dockerfile"].(string); ok {
+			dockerfile = value
+		}
+	default:
+		return "", "", "", fmt.Errorf("invalid build of service %s", serviceName)
+	}
+
+	if context == "" {
+		context = "."
+	}
+	if dockerfile == "" {
+		dockerfile = "Dockerfile"
+	}
+	if !filepath.IsAbs(context) && len(composeFilePaths) > 0 {
+		context = filepath.Join(filepath.Dir(composeFilePaths[0]), context)
+	}
+
+	return service.Image, context, dockerfile, nil
+}
+
+// readComposeFiles returns the services of the compose files. Later files override the services of earlier ones.
+func readComposeFiles(composeFilePaths []string) (map[string]composeService, error) {
+	merged := map[string]composeService{}
+
+	for _, path := range composeFilePaths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var file composeFile
+		err = yaml.Unmarshal(content, &file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse compose file %s: %w", path, err)
+		}
+
+		for name, service := range file.Services {
+			merged[name] = mergeComposeService(merged[name], service)
+		}
+	}
+
+	return merged, nil
+}
+
+// orderComposeServices adds the services that the named services depend on and orders all of them so
+// that each service comes after its dependencies. Independent services keep their order.
+func orderComposeServices(names []string, services map[string]composeService, projectService string) ([]string, error) {
+	ordered := []string{}
+	visited := map[string]bool{}
+	visiting := map[string]bool{}
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		if visiting[name] {
+			return fmt.Errorf("service %s has a circular dependency", name)
+		}
+		visiting[name] = true
+
+		dependencies, err := convertDependsOn(services[name].DependsOn)
+		if err != nil {
+			return fmt.Errorf("invalid depends_on of service %s: %w", name, err)
+		}
+
+		for _, dependency := range dependencies {
+			if dependency == projectService {
+				return fmt.Errorf("service %s depends on the project service %s, which starts after the other services", name, projectService)
+			}
+			if _, ok := services[dependency]; !ok {
+				return fmt.Errorf("service %s depends on service %s, which is not found in the compose files", name, dependency)
+			}
+
+			err := visit(dependency)
+			if err != nil {
+				return err
+			}
+		}
+
+		visiting[name] = false
+		visited[name] = true
+		ordered = append(ordered, name)
+
+		return nil
+	}
+
+	for _, name := range names {
+		err := visit(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
+func mergeComposeService(base, override composeService) composeService {
+	if override.Image != "" {
+		base.Image = override.Image
+	}
+	if override.Build != nil {
+		base.Build = override.Build
+	}
+	if override.Environment != nil {
+		base.Environment = override.Environment
+	}
+	if override.Command != nil {
+		base.Command = override.Command
+	}
+	if override.DependsOn != nil {
+		base.DependsOn = override.DependsOn
+	}
+	base.Volumes = append(base.Volumes, override.Volumes...)
+	base.Ports = append(base.Ports, override.Ports...)
+	return base
+}
+
+// convertDependsOn returns the sorted service names of a depends_on list or map
+func convertDependsOn(dependsOn interface{}) ([]string, error) {
+	dependencies := []string{}
+
+	switch value := dependsOn.(type) {
+	case nil:
+	case []interface{}:
+		for _, dependency := range value {
+			dependencies = append(dependencies, fmt.Sprint(dependency))
+		}
+	case map[interface{}]interface{}:
+		for dependency := range value {
+			dependencies = append(dependencies, fmt.Sprint(dependency))
+		}
+	default:
+		return nil, fmt.Errorf("unexpected type %T", dependsOn)
+	}
+	sort.Strings(dependencies)
+
+	return dependencies, nil
+}
+
+func convertEnvironment(environment interface{}) (map[string]string, error) {
+	envVars := map[string]string{}
+
+	switch value := environment.(type) {
+	case nil:
+	case map[interface{}]interface{}:
+		for key, val := range value {
+			if val == nil {
+				envVars[fmt.Sprint(key)] = ""
+				continue
+			}
+			envVars[fmt.Sprint(key)] = fmt.Sprint(val)
+		}
+	case []interface{}:
+		for _, entry := range value {
+			key, val, _ := strings.Cut(fmt.Sprint(entry), "=")
+			envVars[key] = val
+		}
+	default:
+		return nil, fmt.Errorf("unexpected type %T", environment)
+	}
+
+	return envVars, nil
+}
+
+// convertCommand splits string commands on whitespace. Quoted arguments are not supported in string commands.
+func convertCommand(command interface{}) ([]string, error) {
+	switch value := command.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return strings.Fields(value), nil
+	case []interface{}:
+		args := []string{}
+		for _, arg := range value {
+			args = append(args, fmt.Sprint(arg))
+		}
+		return args, nil
+	}
+
+	return nil, fmt.Errorf("unexpected type %T", command)
+}
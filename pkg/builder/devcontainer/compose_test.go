@@ -0,0 +1,155 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+const composeFileContent = `
+services:
+  app:
+    build: .
+  db:
+    image: postgres:16
+    environment:
+      POSTGRES_PASSWORD: postgres
+      POSTGRES_PORT: 5432
+    ports:
+      - 5432:5432
+  cache:
+    image: redis:7
+    command: redis-server --save 60 1
+    depends_on:
+      - db
+  mail:
+    image: mailhog/mailhog
+`
+
+const composeOverrideContent = `
+services:
+  db:
+    environment:
+      - POSTGRES_PASSWORD=secret
+  cache:
+    command: ["redis-server", "--appendonly", "yes"]
+`
+
+func writeComposeFiles(t *testing.T) []string {
+	dir := t.TempDir()
+	paths := []string{filepath.Join(dir, "docker-compose.yml"), filepath.Join(dir, "docker-compose.override.yml")}
+
+	require.NoError(t, os.WriteFile(paths[0], []byte(composeFileContent), 0644))
+	require.NoError(t, os.WriteFile(paths[1], []byte(composeOverrideContent), 0644))
+
+	return paths
+}
+
+func TestGetComposeServices(t *testing.T) {
+	paths := writeComposeFiles(t)
+
+	t.Run("Returns the sidecar services", func(t *testing.T) {
+		var logs bytes.Buffer
+		services, err := GetComposeServices(paths[:1], "app", nil, &logs)
+		require.NoError(t, err)
+		require.Equal(t, []workspace.ProjectService{
+			{Name: "db", Image: "postgres:16", EnvVars: map[string]string{"POSTGRES_PASSWORD": "postgres", "POSTGRES_PORT": "5432"}},
+			{Name: "cache", Image: "redis:7", EnvVars: map[string]string{}, Command: []string{"redis-server", "--save", "60", "1"}},
+			{Name: "mail", Image: "mailhog/mailhog", EnvVars: map[string]string{}},
+		}, services)
+		require.Contains(t, logs.String(), "Ports of service db are reachable from the project at db:<port>")
+	})
+
+	t.Run("Later files override earlier ones", func(t *testing.T) {
+		services, err := GetComposeServices(paths, "app", nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, []workspace.ProjectService{
+			{Name: "db", Image: "postgres:16", EnvVars: map[string]string{"POSTGRES_PASSWORD": "secret"}},
+			{Name: "cache", Image: "redis:7", EnvVars: map[string]string{}, Command: []string{"redis-server", "--appendonly", "yes"}},
+			{Name: "mail", Image: "mailhog/mailhog", EnvVars: map[string]string{}},
+		}, services)
+	})
+
+	t.Run("Only runs the listed services", func(t *testing.T) {
+		services, err := GetComposeServices(paths, "app", []string{"db"}, nil)
+		require.NoError(t, err)
+		require.Len(t, services, 1)
+		require.Equal(t, "db", services[0].Name)
+	})
+
+	t.Run("Runs the dependencies of the listed services", func(t *testing.T) {
+		services, err := GetComposeServices(paths, "app", []string{"cache"}, nil)
+		require.NoError(t, err)
+		require.Len(t, services, 2)
+		require.Equal(t, "db", services[0].Name)
+		require.Equal(t, "cache", services[1].Name)
+	})
+
+	t.Run("Fails if the project service is missing", func(t *testing.T) {
+		_, err := GetComposeServices(paths, "web", nil, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("Fails if a listed service is missing", func(t *testing.T) {
+		_, err := GetComposeServices(paths, "app", []string{"queue"}, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestGetComposeServicesUnsupported(t *testing.T) {
+	tests := map[string]struct {
+		services    string
+		expectedErr string
+	}{
+		"Service built from source": {
+			services:    "  worker:\n    build: ./worker\n",
+			expectedErr: "service worker is built from source",
+		},
+		"Service with volumes": {
+			services:    "  db:\n    image: postgres:16\n    volumes:\n      - data:/var/lib/postgresql/data\n",
+			expectedErr: "service db has volumes",
+		},
+		"Unknown dependency": {
+			services:    "  cache:\n    image: redis:7\n    depends_on:\n      queue:\n        condition: service_started\n",
+			expectedErr: "service cache depends on service queue",
+		},
+		"Dependency on the project service": {
+			services:    "  cache:\n    image: redis:7\n    depends_on:\n      - app\n",
+			expectedErr: "service cache depends on the project service app",
+		},
+		"Circular dependency": {
+			services:    "  a:\n    image: redis:7\n    depends_on: [b]\n  b:\n    image: redis:7\n    depends_on: [a]\n",
+			expectedErr: "circular dependency",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "docker-compose.yml")
+			require.NoError(t, os.WriteFile(path, []byte("services:\n  app:\n    build: .\n"+test.services), 0644))
+
+			_, err := GetComposeServices([]string{path}, "app", nil, nil)
+			require.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestGetComposeFilePaths(t *testing.T) {
+	paths, err := GetComposeFilePaths("docker-compose.yml")
+	require.NoError(t, err)
+	require.Equal(t, []string{"docker-compose.yml"}, paths)
+
+	paths, err = GetComposeFilePaths([]interface{}{"a.yml", "b.yml"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.yml", "b.yml"}, paths)
+
+	_, err = GetComposeFilePaths(42)
+	require.Error(t, err)
+}
@@ -3,27 +3,33 @@
 
 package devcontainer
 
-import "fmt"
-
 type Configuration struct {
 	Name              string                    `json:"name"`
 	DockerFile        string                    `json:"dockerFile"`
 	RunArgs           []string                  `json:"runArgs"`
 	InitializeCommand string                    `json:"initializeCommand"`
-	PostCreateCommand string                    `json:"postCreateCommand"`
 	RemoteUser        string                    `json:"remoteUser"`
 	Features          map[string]interface{}    `json:"features"`
-	ForwardPorts      map[string]PortAttributes `json:"forwardPorts"`
+	ForwardPorts      []interface{}             `json:"forwardPorts"`
+	PortsAttributes   map[string]PortAttributes `json:"portsAttributes"`
 	Customizations    map[string]interface{}    `json:"customizations"`
 	ConfigFilePath    ConfigFilePath            `json:"configFilePath"`
+
+	// Lifecycle commands are either a string, a list of arguments or an object of named commands that run in parallel
+	OnCreateCommand      interface{} `json:"onCreateCommand"`
+	UpdateContentCommand interface{} `json:"updateContentCommand"`
+	PostCreateCommand    interface{} `json:"postCreateCommand"`
+	PostStartCommand     interface{} `json:"postStartCommand"`
+	PostAttachCommand    interface{} `json:"postAttachCommand"`
+	WaitFor              string      `json:"waitFor"`
 }
 
 type PortAttributes struct {
-	Label            *string
-	OnAutoForward    *string
-	Protocol         *string
-	RequireLocalPort *bool
-	ElevateIfNeeded  *bool
+	Label            *string `json:"label"`
+	OnAutoForward    *string `json:"onAutoForward"`
+	Protocol         *string `json:"protocol"`
+	RequireLocalPort *bool   `json:"requireLocalPort"`
+	ElevateIfNeeded  *bool   `json:"elevateIfNeeded"`
 }
 
 type ConfigFilePath struct {
@@ -96,7 +102,7 @@ type MergedConfiguration struct {
 	RunArgs         []string                  `json:"runArgs"`
 	RemoteUser      string                    `json:"remoteUser"`
 	Features        map[string]interface{}    `json:"features"`
-	ForwardPorts    []int                     `json:"forwardPorts"`
+	ForwardPorts    []interface{}             `json:"forwardPorts"`
 	ConfigFilePath  ConfigFilePath            `json:"configFilePath"`
 	Init            bool                      `json:"init"`
 	Privileged      bool                      `json:"privileged"`
@@ -105,6 +111,13 @@ type MergedConfiguration struct {
 	RemoteEnv       map[string]string         `json:"remoteEnv"`
 	ContainerEnv    map[string]string         `json:"containerEnv"`
 	PortsAttributes map[string]PortAttributes `json:"portsAttributes"`
+	// Customizations hold a list of customizations per tool, one for each feature and the devcontainer config
+	Customizations map[string]interface{} `json:"customizations"`
+
+	// Docker Compose
+	DockerComposeFile interface{} `json:"dockerComposeFile"`
+	Service           string      `json:"service"`
+	RunServices       []string    `json:"runServices"`
 
 	// Commands
 	InitializeCommand     []interface{} `json:"initializeCommand"`
@@ -113,35 +126,5 @@ type MergedConfiguration struct {
 	PostCreateCommands    []interface{} `json:"postCreateCommands"`
 	PostStartCommands     []interface{} `json:"postStartCommands"`
 	PostAttachCommands    []interface{} `json:"postAttachCommands"`
-}
-
-func ConvertCommands(mergedCommands []interface{}) ([]string, error) {
-	// Convert the commands to a string array
-	var commandArray []string
-	for _, commands := range mergedCommands {
-		switch commands := commands.(type) {
-		case []interface{}:
-			for _, command := range commands {
-				commandString, ok := command.(string)
-				if !ok {
-					return nil, fmt.Errorf("invalid command type: %v", command)
-				}
-				commandArray = append(commandArray, commandString)
-			}
-		case map[string]interface{}:
-			for _, command := range commands {
-				commandString, ok := command.(string)
-				if !ok {
-					return nil, fmt.Errorf("invalid command type: %v", command)
-				}
-				commandArray = append(commandArray, commandString)
-			}
-		case string:
-			commandArray = append(commandArray, commands)
-		default:
-			return nil, fmt.Errorf("invalid command type")
-		}
-	}
-
-	return commandArray, nil
+	WaitFor               string        `json:"waitFor"`
 }
@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// GetCustomizations converts the tool customizations of the merged configuration.
+// Extensions of all entries are combined while settings of later entries override earlier ones.
+func GetCustomizations(config MergedConfiguration) (*workspace.ProjectCustomizations, error) {
+	vscode, err := getVSCodeCustomizations(config.Customizations["vscode"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid vscode customizations: %w", err)
+	}
+
+	if vscode == nil {
+		return nil, nil
+	}
+
+	return &workspace.ProjectCustomizations{
+		VSCode: vscode,
+	}, nil
+}
+
+func getVSCodeCustomizations(mergedCustomizations interface{}) (*workspace.VSCodeCustomizations, error) {
+	var entries []interface{}
+	switch mergedCustomizations := mergedCustomizations.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		entries = mergedCustomizations
+	case map[string]interface{}:
+		entries = []interface{}{mergedCustomizations}
+	default:
+		return nil, fmt.Errorf("invalid type: %v", mergedCustomizations)
+	}
+
+	customizations := &workspace.VSCodeCustomizations{
+		Extensions: []string{},
+		Settings:   map[string]interface{}{},
+	}
+
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+
+		entry, ok := entry.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid type: %v", entry)
+		}
+
+		if extensions, ok := entry["extensions"]; ok {
+			extensions, ok := extensions.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid extensions: %v", entry["extensions"])
+			}
+
+			for _, extension := range extensions {
+				extension, ok := extension.(string)
+				if !ok {
+					return nil, fmt.Errorf("invalid extension: %v", extension)
+				}
+				if !slices.Contains(customizations.Extensions, extension) {
+					customizations.Extensions = append(customizations.Extensions, extension)
+				}
+			}
+		}
+
+		if settings, ok := entry["settings"]; ok {
+			settings, ok := settings.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid settings: %v", entry["settings"])
+			}
+
+			for key, value := range settings {
+				customizations.Settings[key] = value
+			}
+		}
+	}
+
+	if len(customizations.Extensions) == 0 && len(customizations.Settings) == 0 {
+		return nil, nil
+	}
+
+	return customizations, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+const mergedCustomizationsConfiguration = `{
+	"customizations": {
+		"vscode": [
+			{"extensions": ["golang.go"], "settings": {"go.toolsManagement.checkForUpdates": "local", "editor.tabSize": 2}},
+			{"extensions": ["golang.go", "eamodio.gitlens"], "settings": {"editor.tabSize": 4}}
+		],
+		"jetbrains": [{"backend": "IntelliJ"}]
+	}
+}`
+
+func TestGetCustomizations(t *testing.T) {
+	var config MergedConfiguration
+	err := json.Unmarshal([]byte(mergedCustomizationsConfiguration), &config)
+	require.Nil(t, err)
+
+	customizations, err := GetCustomizations(config)
+	require.Nil(t, err)
+
+	require.Equal(t, &workspace.ProjectCustomizations{
+		VSCode: &workspace.VSCodeCustomizations{
+			Extensions: []string{"golang.go", "eamodio.gitlens"},
+			Settings: map[string]interface{}{
+				"go.toolsManagement.checkForUpdates": "local",
+				"editor.tabSize":                     float64(4),
+			},
+		},
+	}, customizations)
+}
+
+func TestGetCustomizationsEmpty(t *testing.T) {
+	customizations, err := GetCustomizations(MergedConfiguration{})
+	require.Nil(t, err)
+	require.Nil(t, customizations)
+
+	_, err = GetCustomizations(MergedConfiguration{Customizations: map[string]interface{}{
+		"vscode": []interface{}{map[string]interface{}{"extensions": "golang.go"}},
+	}})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// GetLifecycle converts the lifecycle commands of the merged configuration.
+// The merged configuration holds one entry per feature and one for the devcontainer config, in the order they run.
+func GetLifecycle(config MergedConfiguration) (*workspace.ProjectLifecycle, error) {
+	lifecycle := &workspace.ProjectLifecycle{}
+
+	hooks := []struct {
+		name     string
+		commands []interface{}
+		groups   *[]workspace.LifecycleCommandGroup
+	}{
+		{"onCreateCommand", config.OnCreateCommands, &lifecycle.OnCreate},
+		{"updateContentCommand", config.UpdateContentCommands, &lifecycle.UpdateContent},
+		{"postCreateCommand", config.PostCreateCommands, &lifecycle.PostCreate},
+		{"postStartCommand", config.PostStartCommands, &lifecycle.PostStart},
+		{"postAttachCommand", config.PostAttachCommands, &lifecycle.PostAttach},
+	}
+
+	for _, hook := range hooks {
+		groups, err := ConvertLifecycleCommands(hook.commands)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", hook.name, err)
+		}
+		*hook.groups = groups
+	}
+
+	if config.WaitFor != "" {
+		waitFor, err := convertWaitFor(config.WaitFor)
+		if err != nil {
+			return nil, err
+		}
+		lifecycle.WaitFor = waitFor
+	}
+
+	return lifecycle, nil
+}
+
+// ConvertLifecycleCommands converts merged lifecycle commands to command groups.
+// A command is either a string run in a shell, a list of arguments run without a shell
+// or an object of named commands that run in parallel.
+func ConvertLifecycleCommands(mergedCommands []interface{}) ([]workspace.LifecycleCommandGroup, error) {
+	groups := []workspace.LifecycleCommandGroup{}
+
+	for _, commands := range mergedCommands {
+		switch commands := commands.(type) {
+		case nil:
+			continue
+		case map[string]interface{}:
+			names := []string{}
+			for name := range commands {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			group := workspace.LifecycleCommandGroup{Commands: []workspace.LifecycleCommand{}}
+			for _, name := range names {
+				command, err := convertLifecycleCommand(commands[name])
+				if err != nil {
+					return nil, fmt.Errorf("invalid command %s: %w", name, err)
+				}
+				command.Name = name
+				group.Commands = append(group.Commands, command)
+			}
+
+			if len(group.Commands) > 0 {
+				groups = append(groups, group)
+			}
+		default:
+			command, err := convertLifecycleCommand(commands)
+			if err != nil {
+				return nil, err
+			}
+			groups = append(groups, workspace.LifecycleCommandGroup{Commands: []workspace.LifecycleCommand{command}})
+		}
+	}
+
+	return groups, nil
+}
+
+func convertLifecycleCommand(command interface{}) (workspace.LifecycleCommand, error) {
+	switch command := command.(type) {
+	case string:
+		return workspace.LifecycleCommand{Command: command}, nil
+	case []interface{}:
+		args := []string{}
+		for _, arg := range command {
+			argString, ok := arg.(string)
+			if !ok {
+				return workspace.LifecycleCommand{}, fmt.Errorf("invalid argument type: %v", arg)
+			}
+			args = append(args, argString)
+		}
+		if len(args) == 0 {
+			return workspace.LifecycleCommand{}, fmt.Errorf("empty command")
+		}
+		return workspace.LifecycleCommand{Args: args}, nil
+	}
+
+	return workspace.LifecycleCommand{}, fmt.Errorf("invalid command type: %v", command)
+}
+
+// convertWaitFor converts a waitFor value like postCreateCommand to its hook
+func convertWaitFor(waitFor string) (workspace.LifecycleHook, error) {
+	name, ok := strings.CutSuffix(waitFor, "Command")
+	hook := workspace.LifecycleHook(name)
+	if !ok || !slices.Contains(workspace.CreateLifecycleHooks, hook) && hook != workspace.LifecycleHookPostStart && hook != workspace.LifecycleHookPostAttach {
+		return "", fmt.Errorf("invalid waitFor: %s", waitFor)
+	}
+
+	return hook, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+const mergedLifecycleConfiguration = `{
+	"onCreateCommands": ["echo feature", "npm ci"],
+	"updateContentCommands": [["npm", "run", "build"]],
+	"postCreateCommands": [{"server": "npm start", "db": ["./migrate.sh", "--seed"]}],
+	"postAttachCommands": ["git fetch"],
+	"waitFor": "postCreateCommand"
+}`
+
+func TestGetLifecycle(t *testing.T) {
+	var config MergedConfiguration
+	err := json.Unmarshal([]byte(mergedLifecycleConfiguration), &config)
+	require.Nil(t, err)
+
+	lifecycle, err := GetLifecycle(config)
+	require.Nil(t, err)
+
+	require.Equal(t, []workspace.LifecycleCommandGroup{
+		{Commands: []workspace.LifecycleCommand{{Command: "echo feature"}}},
+		{Commands: []workspace.LifecycleCommand{{Command: "npm ci"}}},
+	}, lifecycle.OnCreate)
+	require.Equal(t, []workspace.LifecycleCommandGroup{
+		{Commands: []workspace.LifecycleCommand{{Args: []string{"npm", "run", "build"}}}},
+	}, lifecycle.UpdateContent)
+	require.Equal(t, []workspace.LifecycleCommandGroup{
+		{Commands: []workspace.LifecycleCommand{
+			{Name: "db", Args: []string{"./migrate.sh", "--seed"}},
+			{Name: "server", Command: "npm start"},
+		}},
+	}, lifecycle.PostCreate)
+	require.Empty(t, lifecycle.PostStart)
+	require.Equal(t, []workspace.LifecycleCommandGroup{
+		{Commands: []workspace.LifecycleCommand{{Command: "git fetch"}}},
+	}, lifecycle.PostAttach)
+	require.Equal(t, workspace.LifecycleHookPostCreate, lifecycle.GetWaitFor())
+}
+
+func TestGetLifecycleInvalid(t *testing.T) {
+	_, err := GetLifecycle(MergedConfiguration{PostStartCommands: []interface{}{float64(1)}})
+	require.NotNil(t, err)
+
+	_, err = GetLifecycle(MergedConfiguration{WaitFor: "initializeCommand"})
+	require.NotNil(t, err)
+
+	lifecycle, err := GetLifecycle(MergedConfiguration{})
+	require.Nil(t, err)
+	require.Equal(t, workspace.LifecycleHookUpdateContent, lifecycle.GetWaitFor())
+}
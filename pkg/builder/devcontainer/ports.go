@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// GetForwardPorts converts the forwardPorts of the merged configuration and applies their portsAttributes.
+// Ports of other hosts, e.g. Docker Compose services, are skipped since only ports of the project can be forwarded.
+func GetForwardPorts(config MergedConfiguration) ([]workspace.ProjectPort, error) {
+	ports := []workspace.ProjectPort{}
+
+	for _, forwardPort := range config.ForwardPorts {
+		port, ok, err := convertForwardPort(forwardPort)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || containsPort(ports, port) {
+			continue
+		}
+
+		projectPort := workspace.ProjectPort{
+			Port:          port,
+			OnAutoForward: workspace.PortAutoForwardActionNotify,
+		}
+
+		attributes, ok := getPortAttributes(config.PortsAttributes, port)
+		if ok {
+			if attributes.Label != nil {
+				projectPort.Label = *attributes.Label
+			}
+			if attributes.Protocol != nil {
+				projectPort.Protocol = *attributes.Protocol
+			}
+			if attributes.OnAutoForward != nil {
+				projectPort.OnAutoForward = workspace.PortAutoForwardAction(*attributes.OnAutoForward)
+			}
+			if attributes.RequireLocalPort != nil {
+				projectPort.RequireLocalPort = *attributes.RequireLocalPort
+			}
+		}
+
+		ports = append(ports, projectPort)
+	}
+
+	return ports, nil
+}
+
+// convertForwardPort converts a port number or a "host:port" string.
+// The returned bool is false if the port belongs to another host.
+func convertForwardPort(forwardPort interface{}) (uint16, bool, error) {
+	switch forwardPort := forwardPort.(type) {
+	case float64:
+		if forwardPort != math.Trunc(forwardPort) || forwardPort < 1 || forwardPort > math.MaxUint16 {
+			return 0, false, fmt.Errorf("invalid forward port: %v", forwardPort)
+		}
+		return uint16(forwardPort), true, nil
+	case string:
+		host, portString, found := strings.Cut(forwardPort, ":")
+		if !found {
+			portString = host
+			host = "localhost"
+		}
+
+		port, err := strconv.ParseUint(portString, 10, 16)
+		if err != nil || port == 0 {
+			return 0, false, fmt.Errorf("invalid forward port: %s", forwardPort)
+		}
+
+		if host != "localhost" && host != "127.0.0.1" {
+			return 0, false, nil
+		}
+
+		return uint16(port), true, nil
+	}
+
+	return 0, false, fmt.Errorf("invalid forward port type: %v", forwardPort)
+}
+
+// getPortAttributes returns the attributes of the port. Attributes are keyed by a port or a port range like 3000-3010.
+func getPortAttributes(portsAttributes map[string]PortAttributes, port uint16) (PortAttributes, bool) {
+	if attributes, ok := portsAttributes[strconv.Itoa(int(port))]; ok {
+		return attributes, true
+	}
+
+	for key, attributes := range portsAttributes {
+		start, end, found := strings.Cut(key, "-")
+		if !found {
+			continue
+		}
+
+		startPort, err := strconv.ParseUint(strings.TrimSpace(start), 10, 16)
+		if err != nil {
+			continue
+		}
+		endPort, err := strconv.ParseUint(strings.TrimSpace(end), 10, 16)
+		if err != nil {
+			continue
+		}
+
+		if uint64(port) >= startPort && uint64(port) <= endPort {
+			return attributes, true
+		}
+	}
+
+	return PortAttributes{}, false
+}
+
+func containsPort(ports []workspace.ProjectPort, port uint16) bool {
+	for _, p := range ports {
+		if p.Port == port {
+			return true
+		}
+	}
+
+	return false
+}
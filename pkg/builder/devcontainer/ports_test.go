@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+const mergedPortsConfiguration = `{
+	"forwardPorts": [3000, "localhost:8080", "db:5432", 3000, 9001],
+	"portsAttributes": {
+		"3000": {"label": "Frontend", "onAutoForward": "openBrowser", "requireLocalPort": true},
+		"8080": {"protocol": "https", "onAutoForward": "silent"},
+		"9000-9100": {"label": "Debug"}
+	}
+}`
+
+func TestGetForwardPorts(t *testing.T) {
+	var config MergedConfiguration
+	err := json.Unmarshal([]byte(mergedPortsConfiguration), &config)
+	require.Nil(t, err)
+
+	ports, err := GetForwardPorts(config)
+	require.Nil(t, err)
+
+	require.Equal(t, []workspace.ProjectPort{
+		{Port: 3000, Label: "Frontend", OnAutoForward: workspace.PortAutoForwardActionOpenBrowser, RequireLocalPort: true},
+		{Port: 8080, Protocol: "https", OnAutoForward: workspace.PortAutoForwardActionSilent},
+		{Port: 9001, Label: "Debug", OnAutoForward: workspace.PortAutoForwardActionNotify},
+	}, ports)
+}
+
+func TestGetForwardPortsInvalid(t *testing.T) {
+	_, err := GetForwardPorts(MergedConfiguration{ForwardPorts: []interface{}{float64(70000)}})
+	require.NotNil(t, err)
+
+	_, err = GetForwardPorts(MergedConfiguration{ForwardPorts: []interface{}{"localhost:http"}})
+	require.NotNil(t, err)
+
+	_, err = GetForwardPorts(MergedConfiguration{ForwardPorts: []interface{}{true}})
+	require.NotNil(t, err)
+}
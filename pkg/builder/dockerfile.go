@@ -0,0 +1,223 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+)
+
+type DockerfileBuilder struct {
+	*Builder
+	buildImageName string
+	// builderDockerPort is set if the image is built in a builder container, which is needed to pass build secrets
+	builderDockerPort uint16
+}
+
+func (b *DockerfileBuilder) Build() (*BuildResult, error) {
+	buildLogger := b.createLogger()
+	defer buildLogger.Close()
+
+	contextDir, dockerfile, err := b.getBuildPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	imageName := fmt.Sprintf("%s%s/p-%s:%s", b.containerRegistryServer, b.buildImageNamespace, b.id, b.project.Repository.Sha)
+
+	var cli *client.Client
+	if b.builderDockerPort != 0 {
+		cli, err = b.buildWithSecrets(contextDir, dockerfile, imageName, buildLogger)
+	} else {
+		cli, err = b.buildImage(contextDir, dockerfile, imageName, buildLogger)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b.buildImageName = imageName
+
+	imageInfo, _, err := cli.ImageInspectWithRaw(context.Background(), imageName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Images run as root unless the Dockerfile sets a USER
+	user := "root"
+	if imageInfo.Config != nil && imageInfo.Config.User != "" {
+		user = imageInfo.Config.User
+	}
+
+	return &BuildResult{
+		User:              user,
+		ImageName:         b.buildImageName,
+		ProjectVolumePath: b.projectVolumePath,
+	}, nil
+}
+
+// buildImage builds the image with the Docker daemon of the server and returns a client of that daemon
+func (b *DockerfileBuilder) buildImage(contextDir, dockerfile, imageName string, logWriter io.Writer) (*client.Client, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	err = dockerClient.BuildImage(docker.BuildImageOptions{
+		ContextDir: contextDir,
+		Dockerfile: dockerfile,
+		BuildArgs:  b.project.Build.Dockerfile.Args,
+		Target:     b.project.Build.Dockerfile.Target,
+		Tag:        imageName,
+	}, logWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli, nil
+}
+
+// buildWithSecrets builds the image in a builder container with the Docker CLI, which passes the build secrets
+// to the build as BuildKit secrets. It returns a client of the Docker daemon of the builder container.
+func (b *DockerfileBuilder) buildWithSecrets(contextDir, dockerfile, imageName string, logWriter io.Writer) (*client.Client, error) {
+	// Secrets are only needed while the image is built
+	defer b.removeBuildSecrets()
+
+	err := b.startBuilderContainer(b.builderDockerPort, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	relContextDir, err := filepath.Rel(b.projectVolumePath, contextDir)
+	if err != nil {
+		return nil, err
+	}
+	builderContextDir := path.Join("/project", filepath.ToSlash(relContextDir))
+
+	cmd := []string{"docker", "buildx", "build", "--load", "--tag", imageName, "--file", path.Join(builderContextDir, filepath.ToSlash(dockerfile))}
+	if b.project.Build.Dockerfile.Target != "" {
+		cmd = append(cmd, "--target", b.project.Build.Dockerfile.Target)
+	}
+
+	argNames := []string{}
+	for name := range b.project.Build.Dockerfile.Args {
+		argNames = append(argNames, name)
+	}
+	sort.Strings(argNames)
+	for _, name := range argNames {
+		cmd = append(cmd, "--build-arg", fmt.Sprintf("%s=%s", name, b.project.Build.Dockerfile.Args[name]))
+	}
+
+	cmd = append(cmd, b.getBuildKitSecretFlags()...)
+	cmd = append(cmd, builderContextDir)
+
+	logWriter.Write([]byte(fmt.Sprintf("Passing %d build secret(s) to the image build\n", len(b.buildSecrets))))
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	result, err := dockerClient.ExecSync(b.id, types.ExecConfig{Cmd: cmd}, logWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.ExitCode != 0 {
+		return nil, fmt.Errorf("failed to build image: %s", result.StdErr)
+	}
+
+	return getBuilderDockerClient(b.builderDockerPort)
+}
+
+func (b *DockerfileBuilder) CleanUp() error {
+	if b.builderDockerPort != 0 {
+		err := b.removeBuilderContainer()
+		if err != nil {
+			return err
+		}
+
+		err = b.removeBuildSecrets()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(b.projectVolumePath)
+}
+
+func (b *DockerfileBuilder) Publish() error {
+	buildLogger := b.createLogger()
+	defer buildLogger.Close()
+
+	var cli *client.Client
+	var err error
+	if b.builderDockerPort != 0 {
+		cli, err = getBuilderDockerClient(b.builderDockerPort)
+	} else {
+		cli, err = client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	}
+	if err != nil {
+		return err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	cr, err := b.containerRegistryService.Find(b.containerRegistryServer)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return err
+	}
+
+	return dockerClient.PushImage(b.buildImageName, cr, buildLogger)
+}
+
+// getBuildPaths returns the build context directory and the path of the Dockerfile relative to it.
+// Both have to be inside the cloned repository.
+func (b *DockerfileBuilder) getBuildPaths() (string, string, error) {
+	contextDir := filepath.Join(b.projectVolumePath, b.project.Build.Dockerfile.Context)
+	if !isSubPath(b.projectVolumePath, contextDir) {
+		return "", "", fmt.Errorf("build context %s is outside of the repository", b.project.Build.Dockerfile.Context)
+	}
+
+	dockerfilePath := b.project.Build.Dockerfile.Dockerfile
+	if dockerfilePath == "" {
+		dockerfilePath = filepath.Join(b.project.Build.Dockerfile.Context, DEFAULT_DOCKERFILE_PATH)
+	}
+
+	dockerfile, err := filepath.Rel(contextDir, filepath.Join(b.projectVolumePath, dockerfilePath))
+	if err != nil {
+		return "", "", err
+	}
+
+	if strings.HasPrefix(dockerfile, "..") {
+		return "", "", fmt.Errorf("dockerfile %s is outside of the build context", dockerfilePath)
+	}
+
+	return contextDir, dockerfile, nil
+}
+
+func isSubPath(basePath, path string) bool {
+	relPath, err := filepath.Rel(basePath, path)
+	return err == nil && !strings.HasPrefix(relPath, "..")
+}
@@ -4,25 +4,34 @@
 package builder
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/daytonaio/daytona/pkg/builder/buildpacks"
 	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/ports"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/workspace"
-	"github.com/docker/docker/pkg/stringid"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	log "github.com/sirupsen/logrus"
 )
 
+const DEFAULT_DOCKERFILE_PATH = "Dockerfile"
+
 type IBuilderFactory interface {
-	Create(p workspace.Project, gpc *gitprovider.GitProviderConfig) (IBuilder, error)
+	Create(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig) (IBuilder, error)
 	CheckExistingBuild(p workspace.Project) (*BuildResult, error)
+	GetContentHash(p workspace.Project, gpc *gitprovider.GitProviderConfig) (string, error)
+	ListBuilds() ([]*Build, error)
+	DeleteBuild(hash string) error
 }
 
 type BuilderFactory struct {
@@ -36,6 +45,8 @@ type BuilderFactory struct {
 	defaultProjectImage             string
 	defaultProjectUser              string
 	defaultProjectPostStartCommands []string
+	profileDataService              profiledata.IProfileDataService
+	buildpacksBuilderImage          string
 }
 
 func NewBuilderFactory(config BuilderConfig) IBuilderFactory {
@@ -50,13 +61,12 @@ func NewBuilderFactory(config BuilderConfig) IBuilderFactory {
 		defaultProjectImage:             config.DefaultProjectImage,
 		defaultProjectUser:              config.DefaultProjectUser,
 		defaultProjectPostStartCommands: config.DefaultProjectPostStartCommands,
+		profileDataService:              config.ProfileDataService,
+		buildpacksBuilderImage:          config.BuildpacksBuilderImage,
 	}
 }
 
-func (f *BuilderFactory) Create(p workspace.Project, gpc *gitprovider.GitProviderConfig) (IBuilder, error) {
-	buildId := stringid.GenerateRandomID()
-	buildId = stringid.TruncateID(buildId)
-
+func (f *BuilderFactory) Create(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig) (IBuilder, error) {
 	hash, err := p.GetConfigHash()
 	if err != nil {
 		return nil, err
@@ -68,51 +78,216 @@ func (f *BuilderFactory) Create(p workspace.Project, gpc *gitprovider.GitProvide
 		return nil, err
 	}
 
-	projectLogger := f.loggerFactory.CreateProjectLogger(p.WorkspaceId, p.Name, logs.LogSourceBuilder)
-	defer projectLogger.Close()
+	buildLogger := createBuildLogger(f.loggerFactory, buildId, p)
+	defer buildLogger.Close()
 
 	gitservice := git.Service{
 		ProjectDir:        projectDir,
 		GitConfigFileName: "",
-		LogWriter:         projectLogger,
+		LogWriter:         buildLogger,
+	}
+
+	err = gitservice.CloneRepository(&p, getGitAuth(gpc))
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Build == nil {
+		return nil, nil
 	}
 
-	var auth *http.BasicAuth
-	if gpc != nil {
-		auth = &http.BasicAuth{
-			Username: gpc.Username,
-			Password: gpc.Token,
+	if p.Build.Devcontainer == nil && p.Build.Dockerfile == nil {
+		err = autodetectBuild(projectDir, p.Build)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	err = gitservice.CloneRepository(&p, auth)
+	if p.Build.Devcontainer == nil && p.Build.Dockerfile == nil {
+		language, err := buildpacks.DetectLanguage(projectDir)
+		if err != nil {
+			return nil, err
+		}
+		if language == nil {
+			return nil, nil
+		}
+
+		buildSecrets, err := f.getBuildSecrets(p)
+		if err != nil {
+			return nil, err
+		}
+		if len(p.Build.Secrets) > 0 {
+			return nil, errors.New("build secrets can't be passed to buildpacks builds. Add a devcontainer config or a Dockerfile to the repository to use them")
+		}
+		if len(buildSecrets) > 0 {
+			buildLogger.Write([]byte(fmt.Sprintf("Warning: build secrets can't be passed to buildpacks builds. Skipping %d build secret(s) matching the repository\n", len(buildSecrets))))
+		}
+
+		// Buildpacks builds depend on the whole repository, so they are only reused for the same commit
+		return f.newBuildpacksBuilder(buildId, p, gpc, hash, projectDir, language), nil
+	}
+
+	contentHash, err := GetContentHash(projectDir, p)
+	if err != nil {
+		return nil, err
+	}
+
+	buildSecrets, err := f.getBuildSecrets(p)
+	if err != nil {
+		return nil, err
+	}
+
+	baseImages, err := GetBaseImages(projectDir, p)
 	if err != nil {
 		return nil, err
 	}
 
-	if p.Build == nil || *p.Build != (workspace.ProjectBuild{}) {
-		if p.Build != nil && p.Build.Devcontainer != nil {
-			return f.newDevcontainerBuilder(buildId, p, gpc, hash, projectDir)
+	cacheKey := f.getCacheKey(baseImages, contentHash, buildSecrets, buildLogger)
+
+	cachedBuild, err := f.findBuildByCacheKey(cacheKey)
+	if err != nil {
+		return nil, err
+	}
+	if cachedBuild != nil {
+		cachedBuild.LastUsedAt = time.Now()
+		err = saveBuild(f.serverConfigFolder, *cachedBuild)
+		if err != nil {
+			return nil, err
 		}
 
-		return nil, nil
+		buildLogger.Write([]byte(fmt.Sprintf("Build files and base images did not change since build %s. Reusing image %s\n", cachedBuild.Hash, cachedBuild.ImageName)))
+		cachedBuilder := &CachedBuilder{
+			Builder: f.newBuilder(buildId, p, gpc, hash, contentHash, cacheKey, projectDir),
+			build:   cachedBuild,
+		}
+		cachedBuilder.baseImages = baseImages
+
+		return cachedBuilder, nil
+	}
+
+	if p.Build.Devcontainer != nil {
+		devcontainerBuilder, err := f.newDevcontainerBuilder(buildId, p, gpc, hash, contentHash, cacheKey, projectDir)
+		if err != nil {
+			return nil, err
+		}
+		devcontainerBuilder.buildSecrets = buildSecrets
+		devcontainerBuilder.baseImages = baseImages
+
+		return devcontainerBuilder, nil
+	}
+
+	dockerfileBuilder := f.newDockerfileBuilder(buildId, p, gpc, hash, contentHash, cacheKey, projectDir)
+	dockerfileBuilder.baseImages = baseImages
+	if len(buildSecrets) > 0 {
+		// Build secrets can only be passed to the build by the Docker CLI of a builder container
+		dockerfileBuilder.builderDockerPort, err = ports.GetAvailableEphemeralPort()
+		if err != nil {
+			return nil, err
+		}
+		dockerfileBuilder.buildSecrets = buildSecrets
+	}
+
+	return dockerfileBuilder, nil
+}
+
+// GetContentHash returns the content hash of the build files of the project at the project commit.
+// The hash is saved per commit and build config, so the repository is only cloned the first time the hash is requested.
+// It returns an empty hash if the project is not built from its repository.
+func (f *BuilderFactory) GetContentHash(p workspace.Project, gpc *gitprovider.GitProviderConfig) (string, error) {
+	if p.Build == nil || p.Repository == nil {
+		return "", nil
+	}
+
+	hash, err := p.GetConfigHash()
+	if err != nil {
+		return "", err
+	}
+
+	contentHashPath := filepath.Join(f.serverConfigFolder, "content-hashes", hash)
+	savedContentHash, err := os.ReadFile(contentHashPath)
+	if err == nil {
+		return string(savedContentHash), nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	contentHash, err := f.cloneContentHash(p, gpc)
+	if err != nil {
+		return "", err
 	}
 
-	// Autodetect
+	err = os.MkdirAll(filepath.Dir(contentHashPath), 0755)
+	if err != nil {
+		return "", err
+	}
 
+	return contentHash, os.WriteFile(contentHashPath, []byte(contentHash), 0644)
+}
+
+// cloneContentHash clones the project repository at the project commit and returns the content hash of its build files
+func (f *BuilderFactory) cloneContentHash(p workspace.Project, gpc *gitprovider.GitProviderConfig) (string, error) {
+	err := os.MkdirAll(f.basePath, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	projectDir, err := os.MkdirTemp(f.basePath, "content-hash-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(projectDir)
+
+	gitservice := git.Service{
+		ProjectDir: projectDir,
+	}
+
+	err = gitservice.CloneRepository(&p, getGitAuth(gpc))
+	if err != nil {
+		return "", err
+	}
+
+	build := *p.Build
+	p.Build = &build
+
+	if p.Build.Devcontainer == nil && p.Build.Dockerfile == nil {
+		err = autodetectBuild(projectDir, p.Build)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if p.Build.Devcontainer == nil && p.Build.Dockerfile == nil {
+		return "", nil
+	}
+
+	return GetContentHash(projectDir, p)
+}
+
+// autodetectBuild sets the build config of the project from the files in the repository
+func autodetectBuild(projectDir string, build *workspace.ProjectBuild) error {
 	devcontainerConfigFilePath, err := detectDevcontainerConfigFilePath(projectDir)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if devcontainerConfigFilePath != "" {
-		p.Build.Devcontainer = &workspace.ProjectBuildDevcontainer{
+		build.Devcontainer = &workspace.ProjectBuildDevcontainer{
 			DevContainerFilePath: devcontainerConfigFilePath,
 		}
+		return nil
+	}
 
-		return f.newDevcontainerBuilder(buildId, p, gpc, hash, projectDir)
+	isDockerfile, err := fileExists(filepath.Join(projectDir, DEFAULT_DOCKERFILE_PATH))
+	if err != nil {
+		return err
+	}
+	if isDockerfile {
+		build.Dockerfile = &workspace.ProjectBuildDockerfile{
+			Dockerfile: DEFAULT_DOCKERFILE_PATH,
+		}
 	}
 
-	return nil, nil
+	return nil
 }
 
 func (f *BuilderFactory) CheckExistingBuild(p workspace.Project) (*BuildResult, error) {
@@ -121,9 +296,7 @@ func (f *BuilderFactory) CheckExistingBuild(p workspace.Project) (*BuildResult,
 		return nil, err
 	}
 
-	filePath := filepath.Join(f.serverConfigFolder, "builds", hash, "build.json")
-
-	_, err = os.Stat(filePath)
+	build, err := readBuild(f.serverConfigFolder, hash)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -131,55 +304,119 @@ func (f *BuilderFactory) CheckExistingBuild(p workspace.Project) (*BuildResult,
 		return nil, err
 	}
 
-	file, err := os.Open(filePath)
+	if !f.isRegistryImage(build.ImageName) {
+		return nil, nil
+	}
+
+	// The commit is the same, but base image tags may have moved and build secrets may have changed since the build.
+	// If a base image can't be resolved, the build is reused for the same commit like builds without a cache key.
+	if build.CacheKey != "" {
+		buildSecrets, err := f.getBuildSecrets(p)
+		if err != nil {
+			return nil, err
+		}
+
+		cacheKey := f.getCacheKey(build.BaseImages, build.ContentHash, buildSecrets, io.Discard)
+		if cacheKey != "" && cacheKey != build.CacheKey {
+			log.Debugf("Cache key of build %s changed. Building again", build.Hash)
+			return nil, nil
+		}
+	}
+
+	build.LastUsedAt = time.Now()
+	err = saveBuild(f.serverConfigFolder, *build)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
 
-	var result BuildResult
-	decoder := json.NewDecoder(file)
-	err = decoder.Decode(&result)
+	return &build.BuildResult, nil
+}
+
+// findBuildByCacheKey returns a saved build of the same build files and base images, e.g. from a previous commit
+func (f *BuilderFactory) findBuildByCacheKey(cacheKey string) (*Build, error) {
+	if cacheKey == "" {
+		return nil, nil
+	}
+
+	builds, err := f.ListBuilds()
 	if err != nil {
 		return nil, err
 	}
 
-	// If the builder registry changed, we need to rebuild and push again
-	if !strings.HasPrefix(result.ImageName, fmt.Sprintf("%s%s", f.containerRegistryServer, f.buildImageNamespace)) {
-		return nil, nil
+	for _, build := range builds {
+		if build.CacheKey == cacheKey && f.isRegistryImage(build.ImageName) {
+			return build, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getGitAuth(gpc *gitprovider.GitProviderConfig) *http.BasicAuth {
+	if gpc == nil {
+		return nil
 	}
 
-	return &result, nil
+	return &http.BasicAuth{
+		Username: gpc.Username,
+		Password: gpc.Token,
+	}
+}
+
+// isRegistryImage checks if the image was pushed to the current builder registry.
+// If the builder registry changed, we need to rebuild and push again.
+func (f *BuilderFactory) isRegistryImage(imageName string) bool {
+	return strings.HasPrefix(imageName, fmt.Sprintf("%s%s", f.containerRegistryServer, f.buildImageNamespace))
+}
+
+func (f *BuilderFactory) newBuilder(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig, hash, contentHash, cacheKey, projectDir string) *Builder {
+	return &Builder{
+		id:                              buildId,
+		project:                         p,
+		gitProviderConfig:               gpc,
+		hash:                            hash,
+		contentHash:                     contentHash,
+		cacheKey:                        cacheKey,
+		projectVolumePath:               projectDir,
+		image:                           f.image,
+		containerRegistryService:        f.containerRegistryService,
+		serverConfigFolder:              f.serverConfigFolder,
+		containerRegistryServer:         f.containerRegistryServer,
+		buildImageNamespace:             f.buildImageNamespace,
+		basePath:                        f.basePath,
+		loggerFactory:                   f.loggerFactory,
+		defaultProjectImage:             f.defaultProjectImage,
+		defaultProjectUser:              f.defaultProjectUser,
+		defaultProjectPostStartCommands: f.defaultProjectPostStartCommands,
+	}
 }
 
-func (f *BuilderFactory) newDevcontainerBuilder(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig, hash, projectDir string) (*DevcontainerBuilder, error) {
+func (f *BuilderFactory) newDevcontainerBuilder(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig, hash, contentHash, cacheKey, projectDir string) (*DevcontainerBuilder, error) {
 	builderDockerPort, err := ports.GetAvailableEphemeralPort()
 	if err != nil {
 		return nil, err
 	}
 
 	return &DevcontainerBuilder{
-		Builder: &Builder{
-			id:                              buildId,
-			project:                         p,
-			gitProviderConfig:               gpc,
-			hash:                            hash,
-			projectVolumePath:               projectDir,
-			image:                           f.image,
-			containerRegistryService:        f.containerRegistryService,
-			serverConfigFolder:              f.serverConfigFolder,
-			containerRegistryServer:         f.containerRegistryServer,
-			buildImageNamespace:             f.buildImageNamespace,
-			basePath:                        f.basePath,
-			loggerFactory:                   f.loggerFactory,
-			defaultProjectImage:             f.defaultProjectImage,
-			defaultProjectUser:              f.defaultProjectUser,
-			defaultProjectPostStartCommands: f.defaultProjectPostStartCommands,
-		},
+		Builder:           f.newBuilder(buildId, p, gpc, hash, contentHash, cacheKey, projectDir),
 		builderDockerPort: builderDockerPort,
 	}, nil
 }
 
+func (f *BuilderFactory) newBuildpacksBuilder(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig, hash, projectDir string, language *buildpacks.Language) *BuildpacksBuilder {
+	return &BuildpacksBuilder{
+		Builder:           f.newBuilder(buildId, p, gpc, hash, "", "", projectDir),
+		language:          language,
+		buildpacksBuilder: f.buildpacksBuilderImage,
+	}
+}
+
+func (f *BuilderFactory) newDockerfileBuilder(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig, hash, contentHash, cacheKey, projectDir string) *DockerfileBuilder {
+	return &DockerfileBuilder{
+		Builder: f.newBuilder(buildId, p, gpc, hash, contentHash, cacheKey, projectDir),
+	}
+}
+
 func fileExists(filePath string) (bool, error) {
 	_, err := os.Stat(filePath)
 	if os.IsNotExist(err) {
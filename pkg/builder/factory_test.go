@@ -0,0 +1,85 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	profiledata_store "github.com/daytonaio/daytona/internal/testing/server/profiledata"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/profiledata"
+	profiledata_service "github.com/daytonaio/daytona/pkg/server/profiledata"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+const testBaseImage = "alpine@sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+func TestCheckExistingBuild(t *testing.T) {
+	configDir := t.TempDir()
+
+	profileDataService := profiledata_service.NewProfileDataService(profiledata_service.ProfileDataServiceConfig{
+		ProfileDataStore: profiledata_store.NewInMemoryProfileDataStore(),
+	})
+	require.Nil(t, profileDataService.Save(&profiledata.ProfileData{
+		BuildSecrets: map[string]profiledata.BuildSecret{
+			"NPM_TOKEN": {Value: "token-1"},
+		},
+	}))
+
+	factory := NewBuilderFactory(BuilderConfig{
+		ServerConfigFolder:      configDir,
+		BasePath:                filepath.Join(configDir, "builds"),
+		ContainerRegistryServer: "registry",
+		ProfileDataService:      profileDataService,
+	}).(*BuilderFactory)
+
+	project := workspace.Project{
+		Name: "project",
+		Repository: &gitprovider.GitRepository{
+			Url: "https://github.com/daytonaio/daytona",
+			Sha: "sha",
+		},
+		Build: &workspace.ProjectBuild{
+			Dockerfile: &workspace.ProjectBuildDockerfile{Dockerfile: "Dockerfile"},
+			Secrets:    []string{"NPM_TOKEN"},
+		},
+	}
+
+	hash, err := project.GetConfigHash()
+	require.Nil(t, err)
+
+	buildSecrets, err := factory.getBuildSecrets(project)
+	require.Nil(t, err)
+
+	baseImages := []string{testBaseImage}
+	require.Nil(t, saveBuild(configDir, Build{
+		BuildResult: BuildResult{ImageName: "registry/p-project:1"},
+		Hash:        hash,
+		ContentHash: "content",
+		CacheKey:    factory.getCacheKey(baseImages, "content", buildSecrets, io.Discard),
+		BaseImages:  baseImages,
+	}))
+
+	t.Run("Reuses the build of the same commit if the cache key didn't change", func(t *testing.T) {
+		result, err := factory.CheckExistingBuild(project)
+		require.Nil(t, err)
+		require.NotNil(t, result)
+		require.Equal(t, "registry/p-project:1", result.ImageName)
+	})
+
+	t.Run("Builds the same commit again if a build secret changed", func(t *testing.T) {
+		require.Nil(t, profileDataService.Save(&profiledata.ProfileData{
+			BuildSecrets: map[string]profiledata.BuildSecret{
+				"NPM_TOKEN": {Value: "token-2"},
+			},
+		}))
+
+		result, err := factory.CheckExistingBuild(project)
+		require.Nil(t, err)
+		require.Nil(t, result)
+	})
+}
@@ -0,0 +1,481 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/builder/devcontainer"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/distribution/reference"
+	"github.com/docker/docker/client"
+	"github.com/tailscale/hujson"
+)
+
+// devcontainerFileReferences holds the fields of a devcontainer config that reference other files or images
+type devcontainerFileReferences struct {
+	Image             string                 `json:"image"`
+	DockerFile        string                 `json:"dockerFile"`
+	Context           string                 `json:"context"`
+	DockerComposeFile interface{}            `json:"dockerComposeFile"`
+	Service           string                 `json:"service"`
+	Features          map[string]interface{} `json:"features"`
+	Build             *struct {
+		Dockerfile string            `json:"dockerfile"`
+		Context    string            `json:"context"`
+		Args       map[string]string `json:"args"`
+	} `json:"build"`
+}
+
+// GetContentHash returns a SHA-256 hash of the build configuration and the content of the files the build depends on.
+// For devcontainers these are the devcontainer config, Docker Compose files, local features and the build context
+// of the Dockerfile. For Dockerfile builds it is the build context. Files excluded by the .dockerignore file of a build
+// context are not part of the hash.
+// Unlike the config hash, it does not change with commits that leave these files untouched. Base images are referenced
+// by tag, so the hash alone does not identify the image. See GetBaseImages.
+func GetContentHash(projectDir string, p workspace.Project) (string, error) {
+	h := sha256.New()
+
+	buildJson, err := json.Marshal(p.Build)
+	if err != nil {
+		return "", err
+	}
+	h.Write(buildJson)
+
+	if p.Build != nil && p.Build.Devcontainer != nil {
+		err = hashDevcontainerFiles(h, projectDir, p.Build.Devcontainer.DevContainerFilePath)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if p.Build != nil && p.Build.Dockerfile != nil {
+		err = hashBuildContext(h, projectDir, p.Build.Dockerfile.Context, getDockerfilePath(p.Build.Dockerfile))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// GetBaseImages returns the references of the images and devcontainer features that the build pulls from registries.
+// These are the base images of the Dockerfiles, the image of a devcontainer and the features from registries.
+// Dockerfile build arguments in the references are replaced by their values.
+func GetBaseImages(projectDir string, p workspace.Project) ([]string, error) {
+	images := []string{}
+
+	if p.Build != nil && p.Build.Dockerfile != nil {
+		dockerfileImages, err := getDockerfileBaseImages(filepath.Join(projectDir, getDockerfilePath(p.Build.Dockerfile)), p.Build.Dockerfile.Args)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, dockerfileImages...)
+	}
+
+	if p.Build != nil && p.Build.Devcontainer != nil {
+		configFilePath := p.Build.Devcontainer.DevContainerFilePath
+		_, references, err := readDevcontainerFileReferences(projectDir, configFilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		image, _, dockerfilePath, err := getDevcontainerBuild(projectDir, configFilePath, references)
+		if err != nil {
+			return nil, err
+		}
+		if image != "" {
+			images = append(images, image)
+		}
+		if dockerfilePath != "" {
+			var args map[string]string
+			if references.Build != nil {
+				args = references.Build.Args
+			}
+
+			dockerfileImages, err := getDockerfileBaseImages(filepath.Join(projectDir, dockerfilePath), args)
+			if err != nil {
+				return nil, err
+			}
+			images = append(images, dockerfileImages...)
+		}
+
+		features := []string{}
+		for feature := range references.Features {
+			if !isLocalFeature(feature) {
+				features = append(features, feature)
+			}
+		}
+		sort.Strings(features)
+		images = append(images, features...)
+	}
+
+	return images, nil
+}
+
+// getCacheKey returns the key that builds are reused by. Next to the content hash, it covers the digests the base
+// images currently point to and the build secrets, so a moved base image tag or a changed secret causes a rebuild.
+// The key is empty if a base image can't be resolved, in which case the build is only reused for the same commit.
+func (f *BuilderFactory) getCacheKey(baseImages []string, contentHash string, buildSecrets map[string]string, logWriter io.Writer) string {
+	h := sha256.New()
+	h.Write([]byte(contentHash))
+	h.Write([]byte{0})
+
+	for _, image := range baseImages {
+		digest, err := f.getImageDigest(image)
+		if err != nil {
+			logWriter.Write([]byte(fmt.Sprintf("Failed to resolve base image %s: %s. The image of this build is only reused for the same commit\n", image, err)))
+			return ""
+		}
+
+		h.Write([]byte(image + "@" + digest))
+		h.Write([]byte{0})
+	}
+
+	secretNames := []string{}
+	for name := range buildSecrets {
+		secretNames = append(secretNames, name)
+	}
+	sort.Strings(secretNames)
+	for _, name := range secretNames {
+		h.Write([]byte(name + "=" + buildSecrets[name]))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// getImageDigest returns the digest the image reference points to. Images that reference a digest are not resolved.
+func (f *BuilderFactory) getImageDigest(imageName string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(imageName)
+	if err != nil {
+		return "", err
+	}
+
+	if digested, ok := named.(reference.Digested); ok {
+		return digested.Digest().String(), nil
+	}
+
+	var cr *containerregistry.ContainerRegistry
+	if f.containerRegistryService != nil {
+		cr, err = f.containerRegistryService.FindByImageName(imageName)
+		if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+			return "", err
+		}
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return "", err
+	}
+	defer cli.Close()
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	return dockerClient.GetImageDigest(reference.TagNameOnly(named).String(), cr)
+}
+
+func hashDevcontainerFiles(h hash.Hash, projectDir, configFilePath string) error {
+	content, references, err := readDevcontainerFileReferences(projectDir, configFilePath)
+	if err != nil {
+		return err
+	}
+	h.Write(content)
+
+	configDir := filepath.Dir(configFilePath)
+
+	composeFilePaths, err := devcontainer.GetComposeFilePaths(references.DockerComposeFile)
+	if err != nil {
+		return err
+	}
+	for _, composeFilePath := range composeFilePaths {
+		err = hashFile(h, projectDir, filepath.Join(configDir, composeFilePath))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Features from registries are part of the base images, local features are hashed by their content
+	features := []string{}
+	for feature := range references.Features {
+		if isLocalFeature(feature) {
+			features = append(features, feature)
+		}
+	}
+	sort.Strings(features)
+	for _, feature := range features {
+		err = hashFile(h, projectDir, filepath.Join(configDir, feature))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, contextPath, dockerfilePath, err := getDevcontainerBuild(projectDir, configFilePath, references)
+	if err != nil {
+		return err
+	}
+	if dockerfilePath == "" {
+		return nil
+	}
+
+	// The Dockerfile doesn't have to be inside of the build context
+	err = hashFile(h, projectDir, dockerfilePath)
+	if err != nil {
+		return err
+	}
+
+	return hashBuildContext(h, projectDir, contextPath, dockerfilePath)
+}
+
+// readDevcontainerFileReferences returns the content of the devcontainer config and the files and images it references
+func readDevcontainerFileReferences(projectDir, configFilePath string) ([]byte, *devcontainerFileReferences, error) {
+	content, err := os.ReadFile(filepath.Join(projectDir, configFilePath))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// devcontainer.json allows comments and trailing commas
+	standardized, err := hujson.Standardize(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %w", configFilePath, err)
+	}
+
+	var references devcontainerFileReferences
+	err = json.Unmarshal(standardized, &references)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %w", configFilePath, err)
+	}
+
+	return content, &references, nil
+}
+
+// getDevcontainerBuild returns the image the devcontainer runs or the build context and the Dockerfile it is built from.
+// The paths are relative to the project directory. For Docker Compose configs, these are the ones of the project service.
+func getDevcontainerBuild(projectDir, configFilePath string, references *devcontainerFileReferences) (image, contextPath, dockerfilePath string, err error) {
+	configDir := filepath.Dir(configFilePath)
+
+	composeFilePaths, err := devcontainer.GetComposeFilePaths(references.DockerComposeFile)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if len(composeFilePaths) > 0 {
+		absComposeFilePaths := []string{}
+		for _, composeFilePath := range composeFilePaths {
+			absComposeFilePaths = append(absComposeFilePaths, filepath.Join(projectDir, configDir, composeFilePath))
+		}
+
+		image, context, dockerfile, err := devcontainer.GetComposeServiceBuild(absComposeFilePaths, references.Service)
+		if err != nil || context == "" {
+			return image, "", "", err
+		}
+
+		contextPath, err = filepath.Rel(projectDir, context)
+		if err != nil {
+			return "", "", "", err
+		}
+
+		return image, contextPath, filepath.Join(contextPath, dockerfile), nil
+	}
+
+	dockerfile := references.DockerFile
+	context := references.Context
+	if references.Build != nil && references.Build.Dockerfile != "" {
+		dockerfile = references.Build.Dockerfile
+		context = references.Build.Context
+	}
+
+	if dockerfile == "" {
+		return references.Image, "", "", nil
+	}
+
+	return "", filepath.Join(configDir, context), filepath.Join(configDir, dockerfile), nil
+}
+
+// getDockerfileBaseImages returns the images of the FROM instructions of a Dockerfile that aren't previous build stages.
+// ARG values declared before the first FROM instruction and the build arguments are replaced in the image references.
+// References with unknown arguments are returned as they are.
+func getDockerfileBaseImages(dockerfilePath string, buildArgs map[string]string) ([]string, error) {
+	content, err := os.ReadFile(dockerfilePath)
+	if os.IsNotExist(err) {
+		// Missing files fail the build itself with a clearer error
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	args := map[string]string{}
+	stages := map[string]bool{}
+	images := []string{}
+	fromSeen := false
+
+	// Instructions can continue on the next line after a backslash
+	dockerfile := strings.ReplaceAll(string(content), "\r\n", "\n")
+	instructions := strings.Split(strings.ReplaceAll(dockerfile, "\\\n", " "), "\n")
+	for _, instruction := range instructions {
+		fields := strings.Fields(instruction)
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "ARG":
+			if fromSeen {
+				continue
+			}
+			for _, arg := range fields[1:] {
+				name, value, _ := strings.Cut(arg, "=")
+				args[name] = strings.Trim(value, `"'`)
+			}
+		case "FROM":
+			fromSeen = true
+
+			fields = slices.DeleteFunc(fields[1:], func(field string) bool {
+				return strings.HasPrefix(field, "--")
+			})
+			if len(fields) == 0 {
+				continue
+			}
+
+			image := os.Expand(fields[0], func(name string) string {
+				if value, ok := buildArgs[name]; ok {
+					return value
+				}
+				if value, ok := args[name]; ok && value != "" {
+					return value
+				}
+				return "${" + name + "}"
+			})
+
+			if image != "scratch" && !stages[strings.ToLower(image)] {
+				images = append(images, image)
+			}
+
+			if len(fields) >= 3 && strings.EqualFold(fields[1], "AS") {
+				stages[strings.ToLower(fields[2])] = true
+			}
+		}
+	}
+
+	return images, nil
+}
+
+// hashBuildContext writes the paths, modes and contents of the files of a build context to the hash.
+// Build contexts outside of the project directory are skipped.
+func hashBuildContext(h hash.Hash, projectDir, contextPath, dockerfilePath string) error {
+	contextDir := filepath.Join(projectDir, contextPath)
+	if !isSubPath(projectDir, contextDir) {
+		return nil
+	}
+
+	// Missing directories fail the build itself with a clearer error
+	_, err := os.Stat(contextDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	dockerfile, err := filepath.Rel(contextDir, filepath.Join(projectDir, dockerfilePath))
+	if err != nil {
+		return err
+	}
+
+	return docker.WalkBuildContext(contextDir, dockerfile, func(relPath string, info os.FileInfo) error {
+		h.Write([]byte(filepath.ToSlash(filepath.Join(contextPath, relPath))))
+		h.Write([]byte{0})
+		h.Write([]byte(info.Mode().String()))
+		h.Write([]byte{0})
+
+		path := filepath.Join(contextDir, relPath)
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			h.Write([]byte(target))
+		} else if info.Mode().IsRegular() {
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			_, err = io.Copy(h, file)
+			if err != nil {
+				return err
+			}
+		}
+		h.Write([]byte{0})
+
+		return nil
+	})
+}
+
+func getDockerfilePath(dockerfile *workspace.ProjectBuildDockerfile) string {
+	if dockerfile.Dockerfile != "" {
+		return dockerfile.Dockerfile
+	}
+
+	return filepath.Join(dockerfile.Context, DEFAULT_DOCKERFILE_PATH)
+}
+
+func isLocalFeature(feature string) bool {
+	return strings.HasPrefix(feature, "./") || strings.HasPrefix(feature, "../")
+}
+
+// hashFile writes the path and the content of a file or of all files in a directory to the hash.
+// Paths outside of the project directory are skipped.
+func hashFile(h hash.Hash, projectDir, path string) error {
+	absPath := filepath.Join(projectDir, path)
+	if !isSubPath(projectDir, absPath) {
+		return nil
+	}
+
+	// Missing files fail the build itself with a clearer error
+	_, err := os.Stat(absPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return filepath.WalkDir(absPath, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(projectDir, filePath)
+		if err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		h.Write([]byte(relPath))
+		h.Write(content)
+
+		return nil
+	})
+}
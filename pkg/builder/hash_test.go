@@ -0,0 +1,158 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/builder"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+const devcontainerConfig = `{
+	// Comments are allowed in devcontainer.json
+	"build": {"dockerfile": "Dockerfile", "args": {"VARIANT": "1.22"}},
+	"features": {
+		"ghcr.io/devcontainers/features/node:1": {},
+		"./local-feature": {},
+	},
+}`
+
+func writeProjectFile(t *testing.T, projectDir, path, content string) {
+	require.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(projectDir, path)), 0755))
+	require.Nil(t, os.WriteFile(filepath.Join(projectDir, path), []byte(content), 0644))
+}
+
+func TestGetContentHash(t *testing.T) {
+	projectDir := t.TempDir()
+	writeProjectFile(t, projectDir, ".devcontainer/devcontainer.json", devcontainerConfig)
+	writeProjectFile(t, projectDir, ".devcontainer/Dockerfile", "FROM golang:1.22")
+	writeProjectFile(t, projectDir, ".devcontainer/local-feature/install.sh", "echo install")
+	writeProjectFile(t, projectDir, "main.go", "package main")
+
+	project := workspace.Project{
+		Build: &workspace.ProjectBuild{
+			Devcontainer: &workspace.ProjectBuildDevcontainer{
+				DevContainerFilePath: ".devcontainer/devcontainer.json",
+			},
+		},
+	}
+
+	getHash := func() string {
+		hash, err := builder.GetContentHash(projectDir, project)
+		require.Nil(t, err)
+		return hash
+	}
+
+	hash := getHash()
+	require.Equal(t, hash, getHash())
+
+	writeProjectFile(t, projectDir, "main.go", "package main\n\nfunc main() {}")
+	require.Equal(t, hash, getHash(), "files outside of the build context do not change the hash")
+
+	writeProjectFile(t, projectDir, ".devcontainer/setup.sh", "echo setup")
+	contextHash := getHash()
+	require.NotEqual(t, hash, contextHash, "files of the build context change the hash")
+	hash = contextHash
+
+	writeProjectFile(t, projectDir, ".devcontainer/Dockerfile", "FROM golang:1.23")
+	dockerfileHash := getHash()
+	require.NotEqual(t, hash, dockerfileHash)
+
+	writeProjectFile(t, projectDir, ".devcontainer/local-feature/install.sh", "echo install again")
+	featureHash := getHash()
+	require.NotEqual(t, dockerfileHash, featureHash)
+
+	writeProjectFile(t, projectDir, ".devcontainer/devcontainer.json", devcontainerConfig+"\n")
+	require.NotEqual(t, featureHash, getHash())
+}
+
+func TestGetContentHashDockerfile(t *testing.T) {
+	projectDir := t.TempDir()
+	writeProjectFile(t, projectDir, "Dockerfile", "FROM ubuntu:22.04")
+
+	project := workspace.Project{
+		Build: &workspace.ProjectBuild{
+			Dockerfile: &workspace.ProjectBuildDockerfile{
+				Args: map[string]string{"VERSION": "1"},
+			},
+		},
+	}
+
+	hash, err := builder.GetContentHash(projectDir, project)
+	require.Nil(t, err)
+
+	project.Build.Dockerfile.Args["VERSION"] = "2"
+	argsHash, err := builder.GetContentHash(projectDir, project)
+	require.Nil(t, err)
+	require.NotEqual(t, hash, argsHash)
+
+	writeProjectFile(t, projectDir, "Dockerfile", "FROM ubuntu:24.04")
+	dockerfileHash, err := builder.GetContentHash(projectDir, project)
+	require.Nil(t, err)
+	require.NotEqual(t, argsHash, dockerfileHash)
+
+	writeProjectFile(t, projectDir, "main.go", "package main")
+	sourceHash, err := builder.GetContentHash(projectDir, project)
+	require.Nil(t, err)
+	require.NotEqual(t, dockerfileHash, sourceHash, "files copied into the image change the hash")
+
+	writeProjectFile(t, projectDir, ".dockerignore", "*.md")
+	ignoreHash, err := builder.GetContentHash(projectDir, project)
+	require.Nil(t, err)
+
+	writeProjectFile(t, projectDir, "README.md", "# Project")
+	readmeHash, err := builder.GetContentHash(projectDir, project)
+	require.Nil(t, err)
+	require.Equal(t, ignoreHash, readmeHash, "files excluded by .dockerignore do not change the hash")
+}
+
+func TestGetBaseImages(t *testing.T) {
+	projectDir := t.TempDir()
+	writeProjectFile(t, projectDir, "Dockerfile", `ARG GO_VERSION=1.22
+FROM --platform=$BUILDPLATFORM golang:${GO_VERSION} AS build
+FROM build AS test
+FROM scratch AS empty
+FROM \
+	ubuntu:${UBUNTU_VERSION}
+COPY --from=build /app /app
+`)
+	writeProjectFile(t, projectDir, ".devcontainer/devcontainer.json", devcontainerConfig)
+	writeProjectFile(t, projectDir, ".devcontainer/Dockerfile", "FROM mcr.microsoft.com/devcontainers/go:${VARIANT}")
+	writeProjectFile(t, projectDir, ".devcontainer/compose/devcontainer.json", `{"dockerComposeFile": "docker-compose.yml", "service": "app"}`)
+	writeProjectFile(t, projectDir, ".devcontainer/compose/docker-compose.yml", "services:\n  app:\n    build:\n      context: ../..\n  db:\n    image: postgres:16\n")
+
+	images, err := builder.GetBaseImages(projectDir, workspace.Project{
+		Build: &workspace.ProjectBuild{
+			Dockerfile: &workspace.ProjectBuildDockerfile{
+				Args: map[string]string{"UBUNTU_VERSION": "24.04"},
+			},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"golang:1.22", "ubuntu:24.04"}, images)
+
+	images, err = builder.GetBaseImages(projectDir, workspace.Project{
+		Build: &workspace.ProjectBuild{
+			Devcontainer: &workspace.ProjectBuildDevcontainer{
+				DevContainerFilePath: ".devcontainer/devcontainer.json",
+			},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"mcr.microsoft.com/devcontainers/go:1.22", "ghcr.io/devcontainers/features/node:1"}, images)
+
+	images, err = builder.GetBaseImages(projectDir, workspace.Project{
+		Build: &workspace.ProjectBuild{
+			Devcontainer: &workspace.ProjectBuildDevcontainer{
+				DevContainerFilePath: ".devcontainer/compose/devcontainer.json",
+			},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"golang:1.22", "ubuntu:${UBUNTU_VERSION}"}, images, "unknown build arguments are kept")
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/profiledata"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/tailscale/hujson"
+)
+
+// BUILD_SECRETS_PATH is the directory the build secrets are mounted to in the devcontainer during the build.
+// Each secret is a file named after the secret, like BuildKit secret mounts.
+const BUILD_SECRETS_PATH = "/run/secrets"
+
+// builderSecretsPath is the directory the build secrets are mounted to in the builder container
+const builderSecretsPath = "/run/daytona/secrets"
+
+// builderOverrideConfigPath is the path the devcontainer config that passes the build secrets to the image build
+// is mounted to in the builder container
+const builderOverrideConfigPath = "/run/daytona/devcontainer.json"
+
+// GetBuildSecrets returns the values of the profile build secrets that are mounted into the build of the project.
+// These are the secrets with a repository pattern matching the project repository and the secrets referenced by the project build.
+func GetBuildSecrets(profileData *profiledata.ProfileData, p workspace.Project) (map[string]string, error) {
+	buildSecrets := map[string]string{}
+
+	var secrets map[string]profiledata.BuildSecret
+	if profileData != nil {
+		secrets = profileData.BuildSecrets
+	}
+
+	if p.Repository != nil {
+		repositoryUrl := getRepositoryPatternUrl(p.Repository.Url)
+
+		for name, secret := range secrets {
+			for _, pattern := range secret.RepositoryPatterns {
+				matched, err := path.Match(pattern, repositoryUrl)
+				if err != nil {
+					return nil, fmt.Errorf("invalid repository pattern %s of build secret %s: %w", pattern, name, err)
+				}
+				if matched {
+					buildSecrets[name] = secret.Value
+					break
+				}
+			}
+		}
+	}
+
+	if p.Build != nil {
+		for _, name := range p.Build.Secrets {
+			secret, ok := secrets[name]
+			if !ok {
+				return nil, fmt.Errorf("build secret %s not found", name)
+			}
+			buildSecrets[name] = secret.Value
+		}
+	}
+
+	for name := range buildSecrets {
+		if !profiledata.IsValidBuildSecretName(name) {
+			return nil, fmt.Errorf("build secret name %s is not valid. Only [a-zA-Z0-9-_.] are allowed", name)
+		}
+	}
+
+	return buildSecrets, nil
+}
+
+// getRepositoryPatternUrl returns the repository URL in the format repository patterns are matched against
+func getRepositoryPatternUrl(url string) string {
+	url = strings.TrimPrefix(url, "https://")
+	url = strings.TrimPrefix(url, "http://")
+	return strings.TrimSuffix(url, ".git")
+}
+
+func (f *BuilderFactory) getBuildSecrets(p workspace.Project) (map[string]string, error) {
+	if f.profileDataService == nil {
+		return GetBuildSecrets(nil, p)
+	}
+
+	profileData, err := f.profileDataService.Get()
+	if err != nil && !profiledata.IsProfileDataNotFound(err) {
+		return nil, err
+	}
+
+	return GetBuildSecrets(profileData, p)
+}
+
+// writeBuildSecrets writes the build secrets to files in a new directory next to the project volume and returns the directory.
+// The directory is kept out of the project volume so the secrets never end up in the build context.
+func (b *Builder) writeBuildSecrets() (string, error) {
+	// Only the server user can access the secrets on the host.
+	// The secrets themselves are readable by any user of the devcontainer.
+	privateDir := filepath.Join(filepath.Dir(b.projectVolumePath), "build-secrets")
+	err := os.MkdirAll(privateDir, 0700)
+	if err != nil {
+		return "", err
+	}
+
+	secretsDir := filepath.Join(privateDir, "secrets")
+	err = os.MkdirAll(secretsDir, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	for name, value := range b.buildSecrets {
+		err = os.WriteFile(filepath.Join(secretsDir, name), []byte(value), 0644)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return secretsDir, nil
+}
+
+// removeBuildSecrets removes the build secrets from the host
+func (b *Builder) removeBuildSecrets() error {
+	return os.RemoveAll(filepath.Join(filepath.Dir(b.projectVolumePath), "build-secrets"))
+}
+
+// getBuildKitSecretFlags returns the --secret flags that pass the build secrets mounted in the builder container
+// to the image build. Like the mounted secrets, the BuildKit secrets are available in /run/secrets during the build.
+func (b *Builder) getBuildKitSecretFlags() []string {
+	names := []string{}
+	for name := range b.buildSecrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	flags := []string{}
+	for _, name := range names {
+		flags = append(flags, "--secret", fmt.Sprintf("id=%s,src=%s", name, path.Join(builderSecretsPath, name)))
+	}
+
+	return flags
+}
+
+// writeSecretsOverrideConfig writes a copy of the devcontainer config that passes the build secrets to the image build
+// as BuildKit secrets and returns its path on the host. The devcontainer CLI resolves the paths of the override config
+// relative to the original config.
+// It returns an empty path and logs a warning if the devcontainer is not built from a Dockerfile, since the secrets
+// can then only be mounted into the lifecycle commands of the build.
+func (b *DevcontainerBuilder) writeSecretsOverrideConfig() (string, error) {
+	buildLogger := b.createLogger()
+	defer buildLogger.Close()
+
+	configFilePath := b.project.Build.Devcontainer.DevContainerFilePath
+	if configFilePath == "" {
+		var err error
+		configFilePath, err = detectDevcontainerConfigFilePath(b.projectVolumePath)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	content, err := os.ReadFile(filepath.Join(b.projectVolumePath, configFilePath))
+	if err != nil {
+		return "", err
+	}
+
+	// devcontainer.json allows comments and trailing commas
+	standardized, err := hujson.Standardize(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", configFilePath, err)
+	}
+
+	var config map[string]interface{}
+	err = json.Unmarshal(standardized, &config)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", configFilePath, err)
+	}
+
+	build, _ := config["build"].(map[string]interface{})
+
+	if _, ok := config["dockerComposeFile"]; ok {
+		buildLogger.Write([]byte("Warning: build secrets can't be passed to the image build of Docker Compose devcontainers. They are only mounted into the onCreate and updateContent commands\n"))
+		return "", nil
+	}
+	if _, ok := config["dockerFile"]; !ok && (build == nil || build["dockerfile"] == nil) {
+		buildLogger.Write([]byte("Warning: the devcontainer is not built from a Dockerfile. Build secrets are only mounted into the onCreate and updateContent commands\n"))
+		return "", nil
+	}
+
+	if build == nil {
+		build = map[string]interface{}{}
+	}
+	options, _ := build["options"].([]interface{})
+	for _, flag := range b.getBuildKitSecretFlags() {
+		options = append(options, flag)
+	}
+	build["options"] = options
+	config["build"] = build
+
+	overrideConfig, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	overrideConfigPath := filepath.Join(filepath.Dir(b.projectVolumePath), "build-secrets", "devcontainer.json")
+	err = os.MkdirAll(filepath.Dir(overrideConfigPath), 0700)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.WriteFile(overrideConfigPath, overrideConfig, 0644)
+	if err != nil {
+		return "", err
+	}
+
+	buildLogger.Write([]byte(fmt.Sprintf("Passing %d build secret(s) to the image build\n", len(b.buildSecrets))))
+
+	return overrideConfigPath, nil
+}
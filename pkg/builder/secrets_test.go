@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/builder"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/profiledata"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+var profileData = &profiledata.ProfileData{
+	BuildSecrets: map[string]profiledata.BuildSecret{
+		"npm_token": {
+			Value:              "npm-secret",
+			RepositoryPatterns: []string{"github.com/daytonaio/*"},
+		},
+		"pip_token": {
+			Value: "pip-secret",
+		},
+	},
+}
+
+func TestGetBuildSecrets(t *testing.T) {
+	t.Run("Matches repository patterns", func(t *testing.T) {
+		secrets, err := builder.GetBuildSecrets(profileData, workspace.Project{
+			Repository: &gitprovider.GitRepository{Url: "https://github.com/daytonaio/daytona.git"},
+			Build:      &workspace.ProjectBuild{},
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, map[string]string{"npm_token": "npm-secret"}, secrets)
+	})
+
+	t.Run("Adds secrets referenced by the project", func(t *testing.T) {
+		secrets, err := builder.GetBuildSecrets(profileData, workspace.Project{
+			Repository: &gitprovider.GitRepository{Url: "https://gitlab.com/other/project"},
+			Build: &workspace.ProjectBuild{
+				Secrets: []string{"pip_token"},
+			},
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, map[string]string{"pip_token": "pip-secret"}, secrets)
+	})
+
+	t.Run("Fails when a referenced secret does not exist", func(t *testing.T) {
+		_, err := builder.GetBuildSecrets(nil, workspace.Project{
+			Build: &workspace.ProjectBuild{
+				Secrets: []string{"unknown"},
+			},
+		})
+
+		require.NotNil(t, err)
+	})
+}
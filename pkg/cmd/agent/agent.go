@@ -83,7 +83,9 @@ var AgentCmd = &cobra.Command{
 			Tailscale:              tailscaleServer,
 			LogWriter:              agentLogWriter,
 			PostCreateLockFilePath: filepath.Join(os.Getenv("HOME"), ".daytona_post_create.lock"),
+			LifecycleLogsDir:       config.GetLifecycleLogsDir(),
 		}
+		sshServer.OnAttach = agent.RunPostAttachHook
 
 		err = agent.Start()
 		if err != nil {
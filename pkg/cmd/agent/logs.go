@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/agent/config"
@@ -16,6 +17,7 @@ import (
 )
 
 var followFlag bool
+var hookFlag string
 
 var logsCmd = &cobra.Command{
 	Use:   "logs",
@@ -23,6 +25,11 @@ var logsCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		logFilePath := config.GetLogFilePath()
 
+		if hookFlag != "" {
+			hookLogFilePath := filepath.Join(config.GetLifecycleLogsDir(), fmt.Sprintf("%s.log", hookFlag))
+			logFilePath = &hookLogFilePath
+		}
+
 		if logFilePath == nil {
 			log.Fatal("Log file path not set")
 		}
@@ -58,4 +65,5 @@ var logsCmd = &cobra.Command{
 
 func init() {
 	logsCmd.Flags().BoolVarP(&followFlag, "follow", "f", false, "Follow logs")
+	logsCmd.Flags().StringVar(&hookFlag, "hook", "", "Output the logs of a lifecycle hook (e.g. postCreate)")
 }
@@ -5,11 +5,13 @@ package apikey
 
 import (
 	"context"
+	"net/http"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
-	"github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views/server/apikey"
 )
 
@@ -20,14 +22,16 @@ var listCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := context.Background()
 
-		apiClient, err := apiclient.GetApiClient(nil)
+		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		apiKeyList, _, err := apiClient.ApiKeyAPI.ListClientApiKeys(ctx).Execute()
+		apiKeyList, _, err := apiclient_util.ListAll(func(cursor string, limit int32) ([]apiclient.ApiKey, *http.Response, error) {
+			return apiClient.ApiKeyAPI.ListClientApiKeys(ctx).Cursor(cursor).Limit(limit).Execute()
+		})
 		if err != nil {
-			log.Fatal(apiclient.HandleErrorResponse(nil, err))
+			log.Fatal(apiclient_util.HandleErrorResponse(nil, err))
 		}
 
 		apikey.ListApiKeys(apiKeyList)
@@ -0,0 +1,45 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/views"
+	build_view "github.com/daytonaio/daytona/pkg/views/build/list"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var buildListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "Lists cached builds",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		builds, res, err := apiClient.BuildAPI.ListBuilds(context.Background()).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = builds
+			return
+		}
+
+		if len(builds) == 0 {
+			views.RenderInfoMessage("No builds found")
+			return
+		}
+
+		build_view.ListBuilds(builds)
+	},
+}
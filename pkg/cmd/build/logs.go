@@ -0,0 +1,97 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/logs"
+	logs_view "github.com/daytonaio/daytona/pkg/views/logs"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var followFlag bool
+
+var buildLogsCmd = &cobra.Command{
+	Use:   "logs [BUILD_ID]",
+	Short: "Output the logs of a build",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		buildId := args[0]
+
+		c, err := config.GetConfig()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		job, res, err := apiClient.BuildAPI.GetBuildJob(context.Background(), buildId).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		query := ""
+		if followFlag && !isFinished(job) {
+			query = "follow=true"
+		}
+
+		ws, res, err := apiclient_util.GetWebsocketConn(fmt.Sprintf("/log/build/%s", buildId), &activeProfile, &query)
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+		defer ws.Close()
+
+		if query != "" {
+			// Following never reaches the end of the log so the connection is closed once the build finishes
+			go func() {
+				for {
+					time.Sleep(time.Second)
+
+					job, _, err := apiClient.BuildAPI.GetBuildJob(context.Background(), buildId).Execute()
+					if err != nil || isFinished(job) {
+						// Give the server time to send the last log entries
+						time.Sleep(time.Second)
+						ws.Close()
+						return
+					}
+				}
+			}()
+		}
+
+		logs_view.CalculateLongestPrefixLength([]string{job.GetProjectName()})
+
+		for {
+			var logEntry logs.LogEntry
+			err := ws.ReadJSON(&logEntry)
+			if err != nil {
+				return
+			}
+
+			logs_view.DisplayLogEntry(logEntry, 0)
+		}
+	},
+}
+
+func isFinished(job *apiclient.BuildJob) bool {
+	return job.GetState() == apiclient.BuildJobStateSuccess || job.GetState() == apiclient.BuildJobStateError
+}
+
+func init() {
+	buildLogsCmd.Flags().BoolVarP(&followFlag, "follow", "f", false, "Follow logs until the build finishes")
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/docker/go-units"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var olderThanFlag string
+var unusedFlag bool
+
+var buildPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete cached builds, their cloned sources and registry images",
+	Long:  "Delete cached builds, their cloned sources and registry images. Images that a workspace references are kept in the registry.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		req := apiClient.BuildAPI.PruneBuilds(context.Background()).Unused(unusedFlag)
+		if olderThanFlag != "" {
+			_, err := builds.ParseAge(olderThanFlag)
+			if err != nil {
+				log.Fatalf("invalid --older-than value: %s", err)
+			}
+			req = req.OlderThan(olderThanFlag)
+		}
+
+		pruned, res, err := req.Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = pruned
+			return
+		}
+
+		if len(pruned) == 0 {
+			views.RenderInfoMessage("No builds to prune")
+			return
+		}
+
+		var size int64
+		for _, build := range pruned {
+			size += build.GetSize()
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Pruned %d build(s), freed %s in the registry", len(pruned), units.HumanSize(float64(size))))
+	},
+}
+
+func init() {
+	buildPruneCmd.Flags().StringVar(&olderThanFlag, "older-than", "", "Only prune builds last used before this duration, e.g. 7d or 12h")
+	buildPruneCmd.Flags().BoolVar(&unusedFlag, "unused", false, "Only prune builds that no workspace references")
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/views"
+	queue_view "github.com/daytonaio/daytona/pkg/views/build/queue"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var buildQueueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Lists queued, running and recently finished builds",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		jobs, res, err := apiClient.BuildAPI.ListBuildJobs(context.Background()).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = jobs
+			return
+		}
+
+		if len(jobs) == 0 {
+			views.RenderInfoMessage("No builds in the queue")
+			return
+		}
+
+		queue_view.ListBuildJobs(jobs)
+	},
+}
@@ -7,11 +7,13 @@ import (
 	"os"
 
 	. "github.com/daytonaio/daytona/pkg/cmd/apikey"
+	. "github.com/daytonaio/daytona/pkg/cmd/build"
 	. "github.com/daytonaio/daytona/pkg/cmd/containerregistry"
 	. "github.com/daytonaio/daytona/pkg/cmd/gitprovider"
 	"github.com/daytonaio/daytona/pkg/cmd/output"
 	. "github.com/daytonaio/daytona/pkg/cmd/ports"
 	. "github.com/daytonaio/daytona/pkg/cmd/profile"
+	. "github.com/daytonaio/daytona/pkg/cmd/profiledata/buildsecret"
 	. "github.com/daytonaio/daytona/pkg/cmd/profiledata/env"
 	. "github.com/daytonaio/daytona/pkg/cmd/provider"
 	. "github.com/daytonaio/daytona/pkg/cmd/server"
@@ -43,6 +45,7 @@ func Execute() {
 	rootCmd.AddCommand(ServerCmd)
 	rootCmd.AddCommand(ApiKeyCmd)
 	rootCmd.AddCommand(ContainerRegistryCmd)
+	rootCmd.AddCommand(BuildCmd)
 	rootCmd.AddCommand(ProviderCmd)
 	rootCmd.AddCommand(TargetCmd)
 	rootCmd.AddCommand(ideCmd)
@@ -55,7 +58,9 @@ func Execute() {
 	rootCmd.AddCommand(StopCmd)
 	rootCmd.AddCommand(InfoCmd)
 	rootCmd.AddCommand(PortForwardCmd)
+	rootCmd.AddCommand(PortAutoForwardCmd)
 	rootCmd.AddCommand(EnvCmd)
+	rootCmd.AddCommand(BuildSecretCmd)
 
 	SetupRootCommand(rootCmd)
 
@@ -6,9 +6,11 @@ package gitprovider
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
-	"github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/output"
 	"github.com/daytonaio/daytona/pkg/views"
 	gitprovider_view "github.com/daytonaio/daytona/pkg/views/gitprovider"
@@ -21,14 +23,16 @@ var gitProviderListCmd = &cobra.Command{
 	Aliases: []string{"ls"},
 	Short:   "Lists your registered Git providers",
 	Run: func(cmd *cobra.Command, args []string) {
-		apiClient, err := apiclient.GetApiClient(nil)
+		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(context.Background()).Execute()
+		gitProviders, res, err := apiclient_util.ListAll(func(cursor string, limit int32) ([]apiclient.GitProvider, *http.Response, error) {
+			return apiClient.GitProviderAPI.ListGitProviders(context.Background()).Cursor(cursor).Limit(limit).Execute()
+		})
 		if err != nil {
-			log.Fatal(apiclient.HandleErrorResponse(res, err))
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 		}
 
 		if len(gitProviders) == 0 {
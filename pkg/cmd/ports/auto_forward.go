@@ -0,0 +1,117 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/pkg/browser"
+	log "github.com/sirupsen/logrus"
+)
+
+// ForwardProjectPorts forwards the ports declared by the project in the background and returns the number of forwarded ports.
+// The ports stay forwarded until the process exits. Ports with the openBrowserOnce action only open the browser the first
+// time they are forwarded for the project.
+func ForwardProjectPorts(workspaceId string, project apiclient.Project) int {
+	forwarded := 0
+
+	for _, projectPort := range project.ForwardPorts {
+		onAutoForward := projectPort.GetOnAutoForward()
+		if onAutoForward == apiclient.PortAutoForwardActionIgnore {
+			continue
+		}
+
+		port := uint16(projectPort.GetPort())
+		name := getPortName(projectPort)
+
+		if projectPort.GetRequireLocalPort() && !ports.IsPortAvailable(port) {
+			views.RenderInfoMessage(fmt.Sprintf("%s requires local port %d which is already in use. Skipping...", name, port))
+			continue
+		}
+
+		hostPort, errChan := tailscale.ForwardPort(workspaceId, project.GetName(), port)
+		if hostPort == nil {
+			log.Error(fmt.Sprintf("Failed to forward %s: %s", name, <-errChan))
+			continue
+		}
+
+		go func() {
+			for err := range errChan {
+				// Connection errors to the forwarded port should not exit the process
+				log.Debug(err)
+			}
+		}()
+
+		forwarded++
+
+		url := fmt.Sprintf("%s://localhost:%d", getPortScheme(projectPort), *hostPort)
+
+		switch onAutoForward {
+		case apiclient.PortAutoForwardActionSilent:
+			log.Debug(fmt.Sprintf("%s available at %s", name, url))
+		case apiclient.PortAutoForwardActionOpenBrowser, apiclient.PortAutoForwardActionOpenPreview:
+			openPortUrl(name, url)
+		case apiclient.PortAutoForwardActionOpenBrowserOnce:
+			opened, err := config.IsPortBrowserOpened(workspaceId, project.GetName(), port)
+			if err != nil {
+				log.Error(err)
+			}
+			if opened {
+				views.RenderInfoMessage(fmt.Sprintf("%s available at %s", name, url))
+				continue
+			}
+
+			openPortUrl(name, url)
+
+			err = config.SetPortBrowserOpened(workspaceId, project.GetName(), port)
+			if err != nil {
+				log.Error(err)
+			}
+		default:
+			views.RenderInfoMessage(fmt.Sprintf("%s available at %s", name, url))
+		}
+	}
+
+	return forwarded
+}
+
+// HasPortsToForward returns true if the project declares ports that are forwarded automatically
+func HasPortsToForward(project apiclient.Project) bool {
+	for _, projectPort := range project.ForwardPorts {
+		if projectPort.GetOnAutoForward() != apiclient.PortAutoForwardActionIgnore {
+			return true
+		}
+	}
+
+	return false
+}
+
+func openPortUrl(name, url string) {
+	views.RenderInfoMessage(fmt.Sprintf("%s available at %s. Opening browser...", name, url))
+	err := browser.OpenURL(url)
+	if err != nil {
+		log.Error("Error opening URL: " + err.Error())
+	}
+}
+
+func getPortName(projectPort apiclient.ProjectPort) string {
+	if projectPort.GetLabel() != "" {
+		return fmt.Sprintf("Port %d (%s)", projectPort.GetPort(), projectPort.GetLabel())
+	}
+
+	return fmt.Sprintf("Port %d", projectPort.GetPort())
+}
+
+func getPortScheme(projectPort apiclient.ProjectPort) string {
+	if projectPort.GetProtocol() == "https" {
+		return "https"
+	}
+
+	return "http"
+}
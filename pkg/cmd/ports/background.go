@@ -0,0 +1,168 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	projectStatePollInterval = 10 * time.Second
+	// Number of consecutive failed project state checks after which the forwarding stops
+	maxProjectStateErrors = 3
+)
+
+// PortAutoForwardCmd forwards the ports declared by a project until the project stops.
+// It is started in the background by `daytona code` so the command can return once the IDE opens.
+var PortAutoForwardCmd = &cobra.Command{
+	Use:    "port-auto-forward [WORKSPACE_ID] [PROJECT]",
+	Args:   cobra.ExactArgs(2),
+	Hidden: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		workspaceId := args[0]
+		projectName := args[1]
+
+		workspace, err := apiclient_util.GetWorkspace(workspaceId)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		project := getProject(workspace, projectName)
+		if project == nil {
+			log.Fatal(fmt.Errorf("project %s not found in workspace %s", projectName, workspaceId))
+		}
+
+		err = config.SetPortForwardPid(workspaceId, projectName, os.Getpid())
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer func() {
+			err := config.RemovePortForwardPid(workspaceId, projectName)
+			if err != nil {
+				log.Error(err)
+			}
+		}()
+
+		if ForwardProjectPorts(workspaceId, *project) == 0 {
+			return
+		}
+
+		interruptChannel := make(chan os.Signal, 1)
+		signal.Notify(interruptChannel, os.Interrupt)
+
+		ticker := time.NewTicker(projectStatePollInterval)
+		defer ticker.Stop()
+
+		stateErrors := 0
+		for {
+			select {
+			case <-interruptChannel:
+				return
+			case <-ticker.C:
+				running, err := isProjectRunning(workspaceId, projectName)
+				if err != nil {
+					stateErrors++
+					if stateErrors < maxProjectStateErrors {
+						log.Debug(err)
+						continue
+					}
+					log.Error(fmt.Sprintf("Failed to get the state of project %s: %s. Stopping port forwarding...", projectName, err))
+					return
+				}
+				stateErrors = 0
+
+				if !running {
+					log.Info(fmt.Sprintf("Project %s stopped. Stopping port forwarding...", projectName))
+					return
+				}
+			}
+		}
+	},
+}
+
+// StartBackgroundPortForwarding starts forwarding the ports declared by the project in a detached process and returns
+// the path of its log file. The process keeps the ports forwarded until the project stops.
+// If ports of the project are already forwarded in the background, no new process is started.
+func StartBackgroundPortForwarding(workspaceId, projectName string) (string, error) {
+	logPath, err := config.GetPortForwardLogPath(workspaceId, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	pid, err := config.GetPortForwardPid(workspaceId, projectName)
+	if err != nil {
+		log.Debug(err)
+	} else if pid != 0 && isProcessRunning(pid) {
+		return logPath, nil
+	}
+
+	daytonaPath, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+
+	err = os.MkdirAll(filepath.Dir(logPath), 0755)
+	if err != nil {
+		return "", err
+	}
+
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return "", err
+	}
+	defer logFile.Close()
+
+	forwardCmd := exec.Command(daytonaPath, PortAutoForwardCmd.Name(), workspaceId, projectName)
+	forwardCmd.Stdout = logFile
+	forwardCmd.Stderr = logFile
+	forwardCmd.SysProcAttr = detachedProcAttr()
+
+	err = forwardCmd.Start()
+	if err != nil {
+		return "", err
+	}
+
+	// The process outlives the command so it is not waited for
+	return logPath, forwardCmd.Process.Release()
+}
+
+func isProjectRunning(workspaceId, projectName string) (bool, error) {
+	workspace, err := apiclient_util.GetWorkspace(workspaceId)
+	if err != nil {
+		return false, err
+	}
+
+	if workspace.Info == nil {
+		return true, nil
+	}
+
+	for _, projectInfo := range workspace.Info.Projects {
+		if projectInfo.GetName() == projectName {
+			return projectInfo.GetIsRunning(), nil
+		}
+	}
+
+	return false, nil
+}
+
+func getProject(workspace *apiclient.WorkspaceDTO, projectName string) *apiclient.Project {
+	for _, project := range workspace.Projects {
+		if project.GetName() == projectName {
+			return &project
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+//go:build windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"os"
+	"syscall"
+)
+
+const (
+	createNewProcessGroup = 0x00000200
+	detachedProcess       = 0x00000008
+)
+
+// detachedProcAttr starts the process without a console so it is not stopped with the terminal
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{
+		CreationFlags: createNewProcessGroup | detachedProcess,
+	}
+}
+
+func isProcessRunning(pid int) bool {
+	// FindProcess opens a handle to the process on Windows and fails if it does not exist
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	process.Release()
+	return true
+}
@@ -75,6 +75,7 @@ func addProfile(profileView profile.ProfileAddView, c *config.Config, notify boo
 			Key: profileView.ApiKey,
 		},
 	}
+	setTlsFlags(&newProfile.Api)
 
 	newProfile.Api.Url = profileView.ApiUrl
 	err := c.AddProfile(newProfile)
@@ -95,9 +96,36 @@ func addProfile(profileView profile.ProfileAddView, c *config.Config, notify boo
 var profileNameFlag string
 var apiUrlFlag string
 var apiKeyFlag string
+var certFingerprintFlag string
+var caCertFlag string
+var clientCertFlag string
+var clientKeyFlag string
+
+func setTlsFlags(serverApi *config.ServerApi) {
+	if certFingerprintFlag != "" {
+		serverApi.CertFingerprint = certFingerprintFlag
+	}
+	if caCertFlag != "" {
+		serverApi.CaCertFile = caCertFlag
+	}
+	if clientCertFlag != "" {
+		serverApi.ClientCertFile = clientCertFlag
+	}
+	if clientKeyFlag != "" {
+		serverApi.ClientKeyFile = clientKeyFlag
+	}
+}
+
+func addTlsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&certFingerprintFlag, "cert-fingerprint", "", "SHA-256 fingerprint of the server certificate to trust, e.g. for self-signed certificates")
+	cmd.Flags().StringVar(&caCertFlag, "ca-cert", "", "Path to the CA certificate of the server")
+	cmd.Flags().StringVar(&clientCertFlag, "client-cert", "", "Path to the client certificate for mutual TLS")
+	cmd.Flags().StringVar(&clientKeyFlag, "client-key", "", "Path to the client certificate key for mutual TLS")
+}
 
 func init() {
 	ProfileAddCmd.Flags().StringVarP(&profileNameFlag, "name", "n", "", "Profile name")
 	ProfileAddCmd.Flags().StringVarP(&apiUrlFlag, "api-url", "a", "", "API URL")
 	ProfileAddCmd.Flags().StringVarP(&apiKeyFlag, "api-key", "k", "", "API Key")
+	addTlsFlags(ProfileAddCmd)
 }
@@ -98,10 +98,9 @@ func EditProfile(c *config.Config, notify bool, profileToEdit *config.Profile) e
 
 func editProfile(profileToEdit *config.Profile, profileView profile.ProfileAddView, c *config.Config, notify bool) error {
 	profileToEdit.Name = profileView.ProfileName
-	profileToEdit.Api = config.ServerApi{
-		Url: profileView.ApiUrl,
-		Key: profileView.ApiKey,
-	}
+	profileToEdit.Api.Url = profileView.ApiUrl
+	profileToEdit.Api.Key = profileView.ApiKey
+	setTlsFlags(&profileToEdit.Api)
 
 	err := c.EditProfile(*profileToEdit)
 	if err != nil {
@@ -122,4 +121,5 @@ func init() {
 	profileEditCmd.Flags().StringVarP(&profileNameFlag, "name", "n", "", "Profile name")
 	profileEditCmd.Flags().StringVarP(&apiUrlFlag, "api-url", "a", "", "API URL")
 	profileEditCmd.Flags().StringVarP(&apiKeyFlag, "api-key", "k", "", "API Key")
+	addTlsFlags(profileEditCmd)
 }
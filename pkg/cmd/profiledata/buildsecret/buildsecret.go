@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var BuildSecretCmd = &cobra.Command{
+	Use:   "build-secret",
+	Short: "Manage profile build secrets that are mounted into project builds",
+	Long:  "Manage profile build secrets that are mounted into project builds.\nSecrets are passed as BuildKit secrets to Dockerfile and devcontainer image builds, where they are read with RUN --mount=type=secret,id=<NAME>, and mounted as files to /run/secrets/<NAME> for the lifecycle commands of devcontainer builds. They are never saved in the built image.",
+}
+
+func init() {
+	BuildSecretCmd.AddCommand(setCmd)
+	BuildSecretCmd.AddCommand(listCmd)
+	BuildSecretCmd.AddCommand(deleteCmd)
+}
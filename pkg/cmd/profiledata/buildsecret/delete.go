@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:     "delete NAME...",
+	Short:   "Delete profile build secrets",
+	Aliases: []string{"remove", "rm"},
+	Args:    cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx := context.Background()
+
+		profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient.HandleErrorResponse(res, err))
+		}
+
+		for _, name := range args {
+			if _, ok := profileData.GetBuildSecrets()[name]; !ok {
+				log.Fatalf("Build secret %s not found", name)
+			}
+			delete(*profileData.BuildSecrets, name)
+		}
+
+		res, err = apiClient.ProfileAPI.SetProfileData(ctx).ProfileData(*profileData).Execute()
+		if err != nil {
+			log.Fatal(apiclient.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessageBold("Build secrets have been successfully deleted")
+	},
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/buildsecret"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var listCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List profile build secrets",
+	Long:    "List profile build secrets. Secret values are not shown.",
+	Aliases: []string{"ls"},
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx := context.Background()
+
+		profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient.HandleErrorResponse(res, err))
+		}
+
+		repositoryPatterns := map[string][]string{}
+		for name, secret := range profileData.GetBuildSecrets() {
+			repositoryPatterns[name] = secret.GetRepositoryPatterns()
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = repositoryPatterns
+			return
+		}
+
+		if len(repositoryPatterns) == 0 {
+			views.RenderInfoMessageBold("No build secrets set")
+			return
+		}
+
+		buildsecret.List(repositoryPatterns)
+	},
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util/apiclient"
+	daytona_apiclient "github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/profiledata"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/buildsecret"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var repositoryFlag []string
+
+var setCmd = &cobra.Command{
+	Use:     "set NAME [VALUE]",
+	Short:   "Set a profile build secret",
+	Long:    "Set a profile build secret. The value is prompted for if it is not passed as an argument.\nSecrets are mounted into builds of repositories matching a --repository pattern and of projects created with --build-secret NAME.",
+	Aliases: []string{"s", "update", "add"},
+	Args:    cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		if !profiledata.IsValidBuildSecretName(name) {
+			log.Fatalf("Invalid build secret name %s. Only [a-zA-Z0-9-_.] are allowed", name)
+		}
+
+		var value string
+		if len(args) == 2 {
+			value = args[1]
+		} else {
+			err := buildsecret.ValueInput(name, &value)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		apiClient, err := apiclient.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx := context.Background()
+
+		profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient.HandleErrorResponse(res, err))
+		}
+
+		if profileData.BuildSecrets == nil {
+			profileData.BuildSecrets = &map[string]daytona_apiclient.BuildSecret{}
+		}
+
+		repositoryPatterns := repositoryFlag
+		// Updating the value keeps the repository patterns unless new ones are passed
+		if !cmd.Flags().Changed("repository") {
+			repositoryPatterns = (*profileData.BuildSecrets)[name].RepositoryPatterns
+		}
+
+		(*profileData.BuildSecrets)[name] = daytona_apiclient.BuildSecret{
+			Value:              &value,
+			RepositoryPatterns: repositoryPatterns,
+		}
+
+		res, err = apiClient.ProfileAPI.SetProfileData(ctx).ProfileData(*profileData).Execute()
+		if err != nil {
+			log.Fatal(apiclient.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Build secret %s has been successfully set", name))
+	},
+}
+
+func init() {
+	setCmd.Flags().StringArrayVar(&repositoryFlag, "repository", nil, "Mount the secret into builds of repositories matching the pattern (e.g. 'github.com/daytonaio/*')")
+}
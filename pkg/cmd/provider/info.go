@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/views/provider"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var providerInfoCmd = &cobra.Command{
+	Use:   "info [PROVIDER]",
+	Short: "Show provider info and capabilities",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		providerList, err := apiclient.GetProviderList()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			selectedProvider, err := provider.GetProviderFromPrompt(providerList, "Choose a provider", false)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if selectedProvider == nil {
+				return
+			}
+
+			args = append(args, *selectedProvider.Name)
+		}
+
+		for _, p := range providerList {
+			if p.GetName() != args[0] {
+				continue
+			}
+
+			if output.FormatFlag != "" {
+				output.Output = p
+				return
+			}
+
+			provider.RenderInfo(&p)
+			return
+		}
+
+		log.Fatalf("Provider %s is not installed", args[0])
+	},
+}
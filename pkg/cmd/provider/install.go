@@ -4,7 +4,15 @@
 package provider
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	goos "os"
+	"path/filepath"
+	"strings"
 
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -17,10 +25,17 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+var allowUnsignedFlag bool
+var fromFileFlag string
+var signatureFlag string
+var nameFlag string
+var versionFlag string
+var pinFlag bool
+
 var providerInstallCmd = &cobra.Command{
-	Use:     "install",
+	Use:     "install [PROVIDER]",
 	Short:   "Install provider",
-	Args:    cobra.NoArgs,
+	Args:    cobra.MaximumNArgs(1),
 	Aliases: []string{"i"},
 	Run: func(cmd *cobra.Command, args []string) {
 		apiClient, err := apiclient_util.GetApiClient(nil)
@@ -28,6 +43,16 @@ var providerInstallCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		if fromFileFlag != "" {
+			providerName, err := installProviderFromFile(apiClient, fromFileFlag, nameFlag, signatureFlag)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			views.RenderInfoMessageBold(fmt.Sprintf("Provider %s has been successfully installed", providerName))
+			return
+		}
+
 		serverConfig, res, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
@@ -37,36 +62,27 @@ var providerInstallCmd = &cobra.Command{
 
 		providersManifest, err := providerManager.GetProvidersManifest()
 		if err != nil {
-			log.Fatal(err)
-		}
-
-		if providersManifest == nil {
-			log.Fatal("Could not get providers manifest")
-		}
-
-		providersManifestLatest := providersManifest.GetLatestVersions()
-		if providersManifestLatest == nil {
-			log.Fatal("Could not get providers manifest")
-		}
-
-		providerList := convertToDTO(providersManifestLatest)
-		specificProviderName := "Select a specific version"
-		specificProviderVersion := ""
-		providerList = append(providerList, apiclient.Provider{Name: &specificProviderName, Version: &specificProviderVersion})
-
-		providerToInstall, err := provider.GetProviderFromPrompt(providerList, "Choose a provider to install", false)
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		if providerToInstall == nil {
-			return
+			// Versions kept on the server can be reinstalled without the registry
+			if len(args) == 0 || versionFlag == "" {
+				log.Fatal(err)
+			}
+			log.Warnf("Could not get providers manifest: %s", err)
+			providersManifest = &manager.ProvidersManifest{}
 		}
 
-		if *providerToInstall.Name == specificProviderName {
-			providerList = convertToDTO(providersManifest)
-
-			providerToInstall, err = provider.GetProviderFromPrompt(providerList, "Choose a specific provider to install", false)
+		var providerName, versionName string
+		if len(args) == 1 {
+			providerName = args[0]
+			versionName = versionFlag
+			if versionName == "" {
+				providerManifest, ok := (*providersManifest)[providerName]
+				if !ok {
+					log.Fatalf("Provider %s not found in manifest", providerName)
+				}
+				versionName, _ = providerManifest.FindLatestVersion()
+			}
+		} else {
+			providerToInstall, err := selectProviderToInstall(providersManifest)
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -74,22 +90,30 @@ var providerInstallCmd = &cobra.Command{
 			if providerToInstall == nil {
 				return
 			}
+
+			providerName = *providerToInstall.Name
+			versionName = *providerToInstall.Version
 		}
 
-		downloadUrls := convertToStringMap((*providersManifest)[*providerToInstall.Name].Versions[*providerToInstall.Version].DownloadUrls)
-		res, err = apiClient.ProviderAPI.InstallProviderExecute(apiclient.ApiInstallProviderRequest{}.Provider(apiclient.InstallProviderRequest{
-			Name:         providerToInstall.Name,
-			DownloadUrls: &downloadUrls,
-		}))
+		version := (*providersManifest)[providerName].Versions[versionName]
+		res, err = apiClient.ProviderAPI.InstallProviderExecute(apiclient.ApiInstallProviderRequest{}.Provider(getInstallProviderRequest(providerName, versionName, version)))
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 		}
 
-		if err != nil {
-			log.Fatal(err)
+		if pinFlag {
+			if versionName == "latest" {
+				providerManifest := (*providersManifest)[providerName]
+				versionName, _ = providerManifest.FindLatestVersion()
+			}
+
+			err = setPinnedVersion(apiClient, providerName, versionName)
+			if err != nil {
+				log.Fatal(err)
+			}
 		}
 
-		views.RenderInfoMessageBold(fmt.Sprintf("Provider %s has been successfully installed", *providerToInstall.Name))
+		views.RenderInfoMessageBold(fmt.Sprintf("Provider %s has been successfully installed", providerName))
 	},
 }
 
@@ -107,6 +131,83 @@ func convertToDTO(manifest *manager.ProvidersManifest) []apiclient.Provider {
 	return pluginList
 }
 
+func selectProviderToInstall(providersManifest *manager.ProvidersManifest) (*apiclient.Provider, error) {
+	providersManifestLatest := providersManifest.GetLatestVersions()
+	if providersManifestLatest == nil {
+		return nil, errors.New("could not get providers manifest")
+	}
+
+	providerList := convertToDTO(providersManifestLatest)
+	specificProviderName := "Select a specific version"
+	specificProviderVersion := ""
+	providerList = append(providerList, apiclient.Provider{Name: &specificProviderName, Version: &specificProviderVersion})
+
+	providerToInstall, err := provider.GetProviderFromPrompt(providerList, "Choose a provider to install", false)
+	if err != nil || providerToInstall == nil {
+		return nil, err
+	}
+
+	if *providerToInstall.Name == specificProviderName {
+		providerList = convertToDTO(providersManifest)
+
+		return provider.GetProviderFromPrompt(providerList, "Choose a specific provider to install", false)
+	}
+
+	return providerToInstall, nil
+}
+
+// installProviderFromFile uploads a local provider binary to the server, which verifies it against the signature.
+// The provider name defaults to the file name without the .exe extension.
+func installProviderFromFile(apiClient *apiclient.APIClient, path string, name string, signature string) (string, error) {
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(path), ".exe")
+	}
+
+	file, err := goos.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, file)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return "", err
+	}
+
+	req := apiClient.ProviderAPI.InstallProviderFromFile(context.Background()).Name(name).File(file).Checksum(hex.EncodeToString(h.Sum(nil))).AllowUnsigned(allowUnsignedFlag)
+	if signature != "" {
+		req = req.Signature(signature)
+	}
+
+	res, err := req.Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return name, nil
+}
+
+func getInstallProviderRequest(name string, versionName string, version manager.Version) apiclient.InstallProviderRequest {
+	downloadUrls := convertToStringMap(version.DownloadUrls)
+	checksums := convertToStringMap(version.Checksums)
+	signatures := convertToStringMap(version.Signatures)
+
+	return apiclient.InstallProviderRequest{
+		Name:          &name,
+		Version:       &versionName,
+		DownloadUrls:  &downloadUrls,
+		Checksums:     &checksums,
+		Signatures:    &signatures,
+		AllowUnsigned: &allowUnsignedFlag,
+	}
+}
+
 func convertToStringMap(downloadUrls map[os.OperatingSystem]string) map[string]string {
 	stringMap := map[string]string{}
 	for os, url := range downloadUrls {
@@ -115,3 +216,12 @@ func convertToStringMap(downloadUrls map[os.OperatingSystem]string) map[string]s
 
 	return stringMap
 }
+
+func init() {
+	providerInstallCmd.Flags().BoolVar(&allowUnsignedFlag, "allow-unsigned", false, "Install providers without a checksum or signature (for development builds)")
+	providerInstallCmd.Flags().StringVar(&fromFileFlag, "from-file", "", "Install the provider from a local binary instead of the registry")
+	providerInstallCmd.Flags().StringVar(&versionFlag, "version", "", "Version to install (defaults to the latest version)")
+	providerInstallCmd.Flags().BoolVar(&pinFlag, "pin", false, "Pin the provider to the installed version so updates don't move past it")
+	providerInstallCmd.Flags().StringVar(&nameFlag, "name", "", "Provider name when installing from a file (defaults to the file name)")
+	providerInstallCmd.Flags().StringVar(&signatureFlag, "signature", "", "Base64 encoded signature of the binary when installing from a file")
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var registryUrlFlag string
+var latestOnlyFlag bool
+
+var providerMirrorCmd = &cobra.Command{
+	Use:   "mirror [DIRECTORY]",
+	Short: "Export the providers manifest and binaries to a directory",
+	Long:  "Export the providers manifest and binaries to a directory that can be used as the registry of servers without internet access, e.g. by setting their registry URL to file:///path/to/directory",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		registryUrl := registryUrlFlag
+		if registryUrl == "" {
+			apiClient, err := apiclient_util.GetApiClient(nil)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			serverConfig, res, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			registryUrl = *serverConfig.RegistryUrl
+		}
+
+		dir, err := filepath.Abs(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		providerManager := manager.NewProviderManager(manager.ProviderManagerConfig{RegistryUrl: registryUrl})
+
+		err = providerManager.MirrorProviders(dir, latestOnlyFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Providers have been mirrored to %s", dir))
+	},
+}
+
+func init() {
+	providerMirrorCmd.Flags().StringVar(&registryUrlFlag, "registry-url", "", "Registry to mirror (defaults to the registry of the server)")
+	providerMirrorCmd.Flags().BoolVar(&latestOnlyFlag, "latest", false, "Only mirror the latest version of each provider")
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var providerUnpinCmd = &cobra.Command{
+	Use:   "unpin [PROVIDER]",
+	Short: "Remove the version pin of a provider",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = setPinnedVersion(apiClient, args[0], "")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Provider %s is no longer pinned", args[0]))
+	},
+}
+
+// setPinnedVersion pins a provider to a version in the server config, or removes the pin if version is empty
+func setPinnedVersion(apiClient *apiclient.APIClient, providerName string, version string) error {
+	ctx := context.Background()
+
+	serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	pinnedVersions := serverConfig.GetPinnedProviderVersions()
+	if pinnedVersions == nil {
+		pinnedVersions = map[string]string{}
+	}
+
+	if version == "" {
+		delete(pinnedVersions, providerName)
+	} else {
+		pinnedVersions[providerName] = version
+	}
+	serverConfig.SetPinnedProviderVersions(pinnedVersions)
+
+	_, res, err = apiClient.ServerAPI.SetConfig(ctx).Config(*serverConfig).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
@@ -14,7 +14,11 @@ var ProviderCmd = &cobra.Command{
 
 func init() {
 	ProviderCmd.AddCommand(providerListCmd)
+	ProviderCmd.AddCommand(providerInfoCmd)
 	ProviderCmd.AddCommand(providerUninstallCmd)
 	ProviderCmd.AddCommand(providerInstallCmd)
 	ProviderCmd.AddCommand(providerUpdateCmd)
+	ProviderCmd.AddCommand(providerMirrorCmd)
+	ProviderCmd.AddCommand(providerRollbackCmd)
+	ProviderCmd.AddCommand(providerUnpinCmd)
 }
@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/provider"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var providerRollbackCmd = &cobra.Command{
+	Use:   "rollback [PROVIDER]",
+	Short: "Reinstall the previous version of a provider",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var providerName string
+		if len(args) == 1 {
+			providerName = args[0]
+		} else {
+			providerList, err := apiclient_util.GetProviderList()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			providerToRollback, err := provider.GetProviderFromPrompt(providerList, "Choose a provider to roll back", false)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if providerToRollback == nil {
+				return
+			}
+
+			providerName = *providerToRollback.Name
+		}
+
+		rolledBack, res, err := apiClient.ProviderAPI.RollbackProvider(context.Background(), providerName).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Provider %s has been rolled back to %s", providerName, rolledBack.GetVersion()))
+		views.RenderInfoMessage(fmt.Sprintf("The provider is now pinned to %s. Run 'daytona provider unpin %s' to allow updates again", rolledBack.GetVersion(), providerName))
+	},
+}
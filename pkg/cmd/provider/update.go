@@ -9,6 +9,7 @@ import (
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/daytonaio/daytona/pkg/views/provider"
 	"github.com/spf13/cobra"
 
@@ -48,7 +49,7 @@ var providerUpdateCmd = &cobra.Command{
 		if allFlag {
 			for _, provider := range providerList {
 				fmt.Printf("Updating provider %s\n", *provider.Name)
-				err := updateProvider(&provider, providersManifest, apiClient)
+				err := updateProvider(&provider, providersManifest, serverConfig.GetPinnedProviderVersions(), apiClient)
 				if err != nil {
 					log.Error(fmt.Sprintf("Failed to update provider %s: %s", *provider.Name, err))
 				} else {
@@ -67,7 +68,7 @@ var providerUpdateCmd = &cobra.Command{
 			return
 		}
 
-		err = updateProvider(providerToUpdate, providersManifest, apiClient)
+		err = updateProvider(providerToUpdate, providersManifest, serverConfig.GetPinnedProviderVersions(), apiClient)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -76,24 +77,29 @@ var providerUpdateCmd = &cobra.Command{
 	},
 }
 
-func updateProvider(providerToUpdate *apiclient.Provider, providersManifest *manager.ProvidersManifest, apiClient *apiclient.APIClient) error {
+// updateProvider installs the latest version of a provider, or the version it is pinned to
+func updateProvider(providerToUpdate *apiclient.Provider, providersManifest *manager.ProvidersManifest, pinnedVersions map[string]string, apiClient *apiclient.APIClient) error {
 	providerManifest, ok := (*providersManifest)[*providerToUpdate.Name]
 	if !ok {
 		return fmt.Errorf("Provider %s not found in manifest", *providerToUpdate.Name)
 	}
 
-	version, ok := providerManifest.Versions["latest"]
-	if !ok {
-		_, latest := providerManifest.FindLatestVersion()
-		version = *latest
+	versionName, pinned := pinnedVersions[*providerToUpdate.Name]
+	if pinned {
+		views.RenderInfoMessage(fmt.Sprintf("Provider %s is pinned to %s", *providerToUpdate.Name, versionName))
+	} else {
+		versionName = "latest"
 	}
 
-	downloadUrls := convertToStringMap(version.DownloadUrls)
+	// Pinned versions that are missing from the manifest can still be reinstalled from the versions kept on the server
+	version, ok := providerManifest.Versions[versionName]
+	if !ok && !pinned {
+		var latest *manager.Version
+		versionName, latest = providerManifest.FindLatestVersion()
+		version = *latest
+	}
 
-	res, err := apiClient.ProviderAPI.InstallProviderExecute(apiclient.ApiInstallProviderRequest{}.Provider(apiclient.InstallProviderRequest{
-		Name:         providerToUpdate.Name,
-		DownloadUrls: &downloadUrls,
-	}))
+	res, err := apiClient.ProviderAPI.InstallProviderExecute(apiclient.ApiInstallProviderRequest{}.Provider(getInstallProviderRequest(*providerToUpdate.Name, versionName, version)))
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
@@ -103,4 +109,5 @@ func updateProvider(providerToUpdate *apiclient.Provider, providersManifest *man
 
 func init() {
 	providerUpdateCmd.Flags().BoolVarP(&allFlag, "all", "a", false, "Update all providers")
+	providerUpdateCmd.Flags().BoolVar(&allowUnsignedFlag, "allow-unsigned", false, "Update providers without a checksum or signature (for development builds)")
 }
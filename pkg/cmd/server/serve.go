@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal"
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/api"
 	"github.com/daytonaio/daytona/pkg/apikey"
@@ -22,7 +23,9 @@ import (
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/headscale"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
@@ -55,10 +58,45 @@ var ServeCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		if c.Tls != nil {
+			err = server.EnsureTLSCertificate(c.Tls)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			fingerprint, err := server.GetCertificateFingerprint(c.Tls.CertFile)
+			if err != nil {
+				log.Fatal(err)
+			}
+			log.Infof("API server certificate fingerprint: %s", fingerprint)
+		}
+
+		var socketPath string
+		if api.LocalSocketSupported {
+			socketPath, err = server.GetApiSocketPath()
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		localAddrFile, err := server.GetApiLocalAddrFilePath()
+		if err != nil {
+			log.Fatal(err)
+		}
+
 		apiServer := api.NewApiServer(api.ApiServerConfig{
-			ApiPort: int(c.ApiPort),
+			ApiPort:       int(c.ApiPort),
+			TLS:           c.Tls,
+			SocketPath:    socketPath,
+			LocalAddrFile: localAddrFile,
 		})
 
+		// The listeners are opened before the server starts its tunnels, which connect to them
+		err = apiServer.Listen()
+		if err != nil {
+			log.Fatal(err)
+		}
+
 		logsDir, err := server.GetWorkspaceLogsDir()
 		if err != nil {
 			log.Fatal(err)
@@ -95,6 +133,10 @@ var ServeCmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
+		idempotencyKeyStore, err := db.NewIdempotencyKeyStore(dbConnection)
+		if err != nil {
+			log.Fatal(err)
+		}
 
 		headscaleServer := headscale.NewHeadscaleServer(&headscale.HeadscaleServerConfig{
 			ServerId:      c.Id,
@@ -139,6 +181,16 @@ var ServeCmd = &cobra.Command{
 
 		headscaleUrl := util.GetFrpcHeadscaleUrl(c.Frps.Protocol, c.Id, c.Frps.Domain)
 
+		providersPublicKey := getProvidersPublicKey(c)
+		if providersPublicKey == "" {
+			log.Warn("No providers public key is set, so providers can not be verified and default providers won't be installed. Set providersPublicKey with 'daytona server configure'")
+		} else {
+			err = manager.ValidatePublicKey(providersPublicKey)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
 		providerManager := manager.NewProviderManager(manager.ProviderManagerConfig{
 			LogsDir:               logsDir,
 			ProviderTargetService: providerTargetService,
@@ -152,6 +204,7 @@ var ServeCmd = &cobra.Command{
 			},
 			ServerPort: c.HeadscalePort,
 			ApiPort:    c.ApiPort,
+			PublicKey:  providersPublicKey,
 		})
 
 		buildImageNamespace := c.BuildImageNamespace
@@ -160,6 +213,10 @@ var ServeCmd = &cobra.Command{
 		}
 		buildImageNamespace = strings.TrimSuffix(buildImageNamespace, "/")
 
+		profileDataService := profiledata.NewProfileDataService(profiledata.ProfileDataServiceConfig{
+			ProfileDataStore: profileDataStore,
+		})
+
 		builderFactory := builder.NewBuilderFactory(builder.BuilderConfig{
 			ServerConfigFolder:              configDir,
 			ContainerRegistryServer:         c.BuilderRegistryServer,
@@ -170,7 +227,9 @@ var ServeCmd = &cobra.Command{
 			DefaultProjectUser:              c.DefaultProjectUser,
 			DefaultProjectPostStartCommands: c.DefaultProjectPostStartCommands,
 			Image:                           c.BuilderImage,
+			BuildpacksBuilderImage:          c.BuildpacksBuilderImage,
 			ContainerRegistryService:        containerRegistryService,
+			ProfileDataService:              profileDataService,
 		})
 		provisioner := provisioner.NewProvisioner(provisioner.ProvisionerConfig{
 			ProviderManager: providerManager,
@@ -179,6 +238,16 @@ var ServeCmd = &cobra.Command{
 			ConfigStore: gitProviderConfigStore,
 		})
 
+		eventService := events.NewEventService(events.EventServiceConfig{})
+
+		buildService := builds.NewBuildService(builds.BuildServiceConfig{
+			BuilderFactory:           builderFactory,
+			WorkspaceStore:           workspaceStore,
+			ContainerRegistryService: containerRegistryService,
+			LoggerFactory:            loggerFactory,
+			Workers:                  int(c.BuildWorkers),
+		})
+
 		workspaceService := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
 			WorkspaceStore:                  workspaceStore,
 			TargetStore:                     providerTargetStore,
@@ -193,9 +262,8 @@ var ServeCmd = &cobra.Command{
 			Provisioner:                     provisioner,
 			LoggerFactory:                   loggerFactory,
 			BuilderFactory:                  builderFactory,
-		})
-		profileDataService := profiledata.NewProfileDataService(profiledata.ProfileDataServiceConfig{
-			ProfileDataStore: profileDataStore,
+			BuildService:                    buildService,
+			EventService:                    eventService,
 		})
 
 		server := server.GetInstance(&server.ServerInstanceConfig{
@@ -209,6 +277,11 @@ var ServeCmd = &cobra.Command{
 			GitProviderService:       gitProviderService,
 			ProviderManager:          providerManager,
 			ProfileDataService:       profileDataService,
+			EventService:             eventService,
+			Provisioner:              provisioner,
+			BuildService:             buildService,
+			IdempotencyKeyStore:      idempotencyKeyStore,
+			ApiLocalAddr:             apiServer.LocalAddr(),
 		})
 
 		errCh := make(chan error)
@@ -240,7 +313,7 @@ var ServeCmd = &cobra.Command{
 
 		printServerStartedMessage(c, false)
 
-		err = setDefaultConfig(server, c.ApiPort)
+		err = setDefaultConfig(server, c, apiServer.LocalAddr())
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -272,6 +345,15 @@ func getDaytonaScriptUrl(config *server.Config) string {
 	return url
 }
 
+// getProvidersPublicKey returns the providers public key from the server config or the key built into the server.
+// Providers without a public key can only be installed unsigned.
+func getProvidersPublicKey(c *server.Config) string {
+	if c.ProvidersPublicKey != "" {
+		return c.ProvidersPublicKey
+	}
+	return internal.ProvidersPublicKey
+}
+
 func printServerStartedMessage(c *server.Config, runAsDaemon bool) {
 	started_view.Render(c.ApiPort, util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain), runAsDaemon)
 }
@@ -292,7 +374,12 @@ func getDbPath() (string, error) {
 	return filepath.Join(dir, "db"), nil
 }
 
-func setDefaultConfig(server *server.Server, apiPort uint32) error {
+func setDefaultConfig(server *server.Server, c *server.Config, localAddr string) error {
+	defaultApi, err := getDefaultServerApi(c, localAddr)
+	if err != nil {
+		return err
+	}
+
 	existingConfig, err := config.GetConfig()
 	if err != nil && !config.IsNotExist(err) {
 		return err
@@ -301,14 +388,26 @@ func setDefaultConfig(server *server.Server, apiPort uint32) error {
 	if existingConfig != nil {
 		for _, profile := range existingConfig.Profiles {
 			if profile.Id == "default" {
-				return nil
+				if profile.Api.Url == defaultApi.Url && profile.Api.CertFingerprint == defaultApi.CertFingerprint && profile.Api.Socket == defaultApi.Socket {
+					return nil
+				}
+
+				// The server's listeners changed since the default profile was created
+				profile.Api.Url = defaultApi.Url
+				profile.Api.CertFingerprint = defaultApi.CertFingerprint
+				profile.Api.Socket = defaultApi.Socket
+				return existingConfig.EditProfile(profile)
 			}
 		}
 	}
 
-	apiKey, err := server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, "default")
-	if err != nil {
-		return err
+	// Requests on the local socket are authenticated by the user running the client
+	apiKey := ""
+	if defaultApi.Socket == "" {
+		apiKey, err = server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, "default")
+		if err != nil {
+			return err
+		}
 	}
 
 	if existingConfig != nil {
@@ -316,8 +415,10 @@ func setDefaultConfig(server *server.Server, apiPort uint32) error {
 			Id:   "default",
 			Name: "default",
 			Api: config.ServerApi{
-				Url: fmt.Sprintf("http://localhost:%d", apiPort),
-				Key: apiKey,
+				Url:             defaultApi.Url,
+				Key:             apiKey,
+				CertFingerprint: defaultApi.CertFingerprint,
+				Socket:          defaultApi.Socket,
 			},
 		})
 		if err != nil {
@@ -335,8 +436,10 @@ func setDefaultConfig(server *server.Server, apiPort uint32) error {
 				Id:   "default",
 				Name: "default",
 				Api: config.ServerApi{
-					Url: fmt.Sprintf("http://localhost:%d", apiPort),
-					Key: apiKey,
+					Url:             defaultApi.Url,
+					Key:             apiKey,
+					CertFingerprint: defaultApi.CertFingerprint,
+					Socket:          defaultApi.Socket,
 				},
 			},
 		},
@@ -344,3 +447,41 @@ func setDefaultConfig(server *server.Server, apiPort uint32) error {
 
 	return config.Save()
 }
+
+// getDefaultServerApi returns the API settings of the local default profile.
+// The local socket is preferred. Otherwise, with TLS, the server certificate is pinned since it is usually not valid for localhost.
+// If the API port requires client certificates, localAddr is the address of the API server's listener for local clients.
+func getDefaultServerApi(c *server.Config, localAddr string) (config.ServerApi, error) {
+	if api.LocalSocketSupported {
+		socketPath, err := server.GetApiSocketPath()
+		if err != nil {
+			return config.ServerApi{}, err
+		}
+
+		return config.ServerApi{
+			Url:    fmt.Sprintf("http://localhost:%d", c.ApiPort),
+			Socket: socketPath,
+		}, nil
+	}
+
+	if c.Tls == nil {
+		return config.ServerApi{
+			Url: fmt.Sprintf("http://localhost:%d", c.ApiPort),
+		}, nil
+	}
+
+	fingerprint, err := server.GetCertificateFingerprint(c.Tls.CertFile)
+	if err != nil {
+		return config.ServerApi{}, err
+	}
+
+	url := fmt.Sprintf("https://localhost:%d", c.ApiPort)
+	if localAddr != "" {
+		url = fmt.Sprintf("https://%s", localAddr)
+	}
+
+	return config.ServerApi{
+		Url:             url,
+		CertFingerprint: fingerprint,
+	}, nil
+}
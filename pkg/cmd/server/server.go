@@ -41,8 +41,26 @@ var ServerCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		// The daemon's API server requires client certificates if mutual TLS is configured,
+		// so its health is checked through the local socket or the listener for local clients
+		var socketPath string
+		if api.LocalSocketSupported {
+			socketPath, err = server.GetApiSocketPath()
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		localAddrFile, err := server.GetApiLocalAddrFilePath()
+		if err != nil {
+			log.Fatal(err)
+		}
+
 		apiServer := api.NewApiServer(api.ApiServerConfig{
-			ApiPort: int(c.ApiPort),
+			ApiPort:       int(c.ApiPort),
+			TLS:           c.Tls,
+			SocketPath:    socketPath,
+			LocalAddrFile: localAddrFile,
 		})
 
 		views.RenderInfoMessageBold("Starting the Daytona Server daemon...")
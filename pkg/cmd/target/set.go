@@ -5,6 +5,12 @@ package target
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	internal_util "github.com/daytonaio/daytona/internal/util"
@@ -18,6 +24,10 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+var providerFlag string
+var nameFlag string
+var optionFlags []string
+
 var TargetSetCmd = &cobra.Command{
 	Use:     "set",
 	Short:   "Set provider target",
@@ -39,6 +49,16 @@ var TargetSetCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		if providerFlag != "" || nameFlag != "" || len(optionFlags) > 0 {
+			err = setTargetFromFlags(pluginList)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			views.RenderInfoMessage("Target set successfully")
+			return
+		}
+
 		selectedProvider, err := provider.GetProviderFromPrompt(pluginList, "Choose a provider", false)
 		if err != nil {
 			log.Fatal(err)
@@ -103,3 +123,111 @@ var TargetSetCmd = &cobra.Command{
 		views.RenderInfoMessage("Target set successfully")
 	},
 }
+
+// setTargetFromFlags sets a target without prompts. Options are merged into the options of an existing target
+// and converted to the type of their property. The server validates the resulting options.
+func setTargetFromFlags(pluginList []apiclient.Provider) error {
+	if providerFlag == "" || nameFlag == "" {
+		return errors.New("--provider and --name are required when setting a target with flags")
+	}
+
+	var selectedProvider *apiclient.Provider
+	for _, p := range pluginList {
+		if p.GetName() == providerFlag {
+			selectedProvider = &p
+			break
+		}
+	}
+	if selectedProvider == nil {
+		return fmt.Errorf("provider %s is not installed", providerFlag)
+	}
+
+	targets, err := apiclient_util.GetTargetList()
+	if err != nil {
+		return err
+	}
+
+	options := map[string]interface{}{}
+	for _, t := range targets {
+		if t.GetName() != nameFlag {
+			continue
+		}
+
+		if t.ProviderInfo.GetName() != providerFlag {
+			return fmt.Errorf("target %s belongs to provider %s", nameFlag, t.ProviderInfo.GetName())
+		}
+
+		err = json.Unmarshal([]byte(t.GetOptions()), &options)
+		if err != nil {
+			return err
+		}
+	}
+
+	client, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		return err
+	}
+
+	targetManifest, res, err := client.ProviderAPI.GetTargetManifest(context.Background(), providerFlag).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for _, option := range optionFlags {
+		name, value, ok := strings.Cut(option, "=")
+		if !ok {
+			return fmt.Errorf("invalid option %s, expected key=value", option)
+		}
+
+		options[name], err = parseOptionValue((*targetManifest)[name], value)
+		if err != nil {
+			return fmt.Errorf("invalid value for option %s: %s", name, err)
+		}
+	}
+
+	content, err := json.MarshalIndent(options, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	res, err = client.TargetAPI.SetTarget(context.Background()).Target(apiclient.ProviderTarget{
+		Name:    &nameFlag,
+		Options: apiclient.PtrString(string(content)),
+		ProviderInfo: &apiclient.ProviderProviderInfo{
+			Name:    selectedProvider.Name,
+			Version: selectedProvider.Version,
+		},
+	}).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+// parseOptionValue converts a flag value to the JSON type of the property.
+// Values of unknown options are kept as strings.
+func parseOptionValue(property apiclient.ProviderProviderTargetProperty, value string) (interface{}, error) {
+	if property.Type == nil {
+		return value, nil
+	}
+
+	switch *property.Type {
+	case apiclient.ProviderTargetPropertyTypeInt:
+		return strconv.Atoi(value)
+	case apiclient.ProviderTargetPropertyTypeFloat:
+		return strconv.ParseFloat(value, 64)
+	case apiclient.ProviderTargetPropertyTypeBoolean:
+		return strconv.ParseBool(value)
+	case apiclient.ProviderTargetPropertyTypeFilePath:
+		return filepath.Abs(value)
+	}
+
+	return value, nil
+}
+
+func init() {
+	TargetSetCmd.Flags().StringVar(&providerFlag, "provider", "", "Provider of the target when setting it without prompts")
+	TargetSetCmd.Flags().StringVar(&nameFlag, "name", "", "Name of the target when setting it without prompts")
+	TargetSetCmd.Flags().StringArrayVar(&optionFlags, "option", nil, "Target option as key=value, validated against the provider's target manifest. Can be repeated")
+}
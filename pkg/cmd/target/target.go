@@ -16,4 +16,5 @@ func init() {
 	TargetCmd.AddCommand(targetListCmd)
 	TargetCmd.AddCommand(TargetSetCmd)
 	TargetCmd.AddCommand(targetRemoveCmd)
+	TargetCmd.AddCommand(targetTestCmd)
 }
@@ -0,0 +1,80 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/target"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var targetTestCmd = &cobra.Command{
+	Use:   "test [TARGET_NAME]",
+	Short: "Check that a target's credentials and connectivity work",
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var selectedTargetName string
+
+		if len(args) == 0 {
+			c, err := config.GetConfig()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			activeProfile, err := c.GetActiveProfile()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			targets, err := apiclient_util.GetTargetList()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			selectedTarget, err := target.GetTargetFromPrompt(targets, activeProfile.Name, false)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			selectedTargetName = *selectedTarget.Name
+		} else {
+			selectedTargetName = args[0]
+		}
+
+		client, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if output.FormatFlag == "" {
+			views.RenderInfoMessage(fmt.Sprintf("Testing target %s...", selectedTargetName))
+		}
+
+		result, res, err := client.TargetAPI.TestTarget(context.Background(), selectedTargetName).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = result
+			return
+		}
+
+		target.RenderTestResult(selectedTargetName, result)
+
+		if !result.GetPassed() {
+			log.Fatalf("Target %s failed one or more checks", selectedTargetName)
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Target %s passed all checks", selectedTargetName))
+	},
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/daytonaio/daytona/internal/jetbrains"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/ports"
 	"github.com/daytonaio/daytona/pkg/ide"
 	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
@@ -89,10 +90,30 @@ var CodeCmd = &cobra.Command{
 
 		views.RenderInfoMessage(fmt.Sprintf("Opening the project '%s' from workspace '%s' in your preferred IDE.", projectName, *workspace.Name))
 
-		err = openIDE(ideId, activeProfile, workspaceId, projectName)
+		var customizations *apiclient.ProjectCustomizations
+		project := getWorkspaceProject(workspace, projectName)
+		if project != nil {
+			customizations = project.Customizations
+			// The terminal SSH session and the browser IDE keep the command running, so the ports are forwarded alongside them
+			if ideId == "ssh" || ideId == "browser" {
+				ports.ForwardProjectPorts(workspaceId, *project)
+			}
+		}
+
+		err = openIDE(ideId, activeProfile, workspaceId, projectName, customizations)
 		if err != nil {
 			log.Fatal(err)
 		}
+
+		if project != nil && ideId != "ssh" && ideId != "browser" && ports.HasPortsToForward(*project) {
+			logPath, err := ports.StartBackgroundPortForwarding(workspaceId, projectName)
+			if err != nil {
+				log.Error(fmt.Sprintf("Failed to forward project ports: %s", err))
+				return
+			}
+
+			views.RenderInfoMessage(fmt.Sprintf("Forwarding project ports in the background until the project stops. Logs are available at %s", logPath))
+		}
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) >= 2 {
@@ -132,14 +153,24 @@ func selectWorkspaceProject(workspaceId string, profile *config.Profile) (*strin
 	return nil, errors.New("no projects found in workspace")
 }
 
-func openIDE(ideId string, activeProfile config.Profile, workspaceId string, projectName string) error {
+func getWorkspaceProject(workspace *apiclient.WorkspaceDTO, projectName string) *apiclient.Project {
+	for _, project := range workspace.Projects {
+		if project.GetName() == projectName {
+			return &project
+		}
+	}
+
+	return nil
+}
+
+func openIDE(ideId string, activeProfile config.Profile, workspaceId string, projectName string, customizations *apiclient.ProjectCustomizations) error {
 	switch ideId {
 	case "vscode":
-		return ide.OpenVSCode(activeProfile, workspaceId, projectName)
+		return ide.OpenVSCode(activeProfile, workspaceId, projectName, customizations)
 	case "ssh":
 		return ide.OpenTerminalSsh(activeProfile, workspaceId, projectName)
 	case "browser":
-		return ide.OpenBrowserIDE(activeProfile, workspaceId, projectName)
+		return ide.OpenBrowserIDE(activeProfile, workspaceId, projectName, customizations)
 	default:
 		_, ok := jetbrains.GetIdes()[jetbrains.Id(ideId)]
 		if ok {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
 	"strings"
@@ -25,6 +26,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/views/workspace/info"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/docker/docker/pkg/stringid"
+	"github.com/google/uuid"
 	"tailscale.com/tsnet"
 
 	log "github.com/sirupsen/logrus"
@@ -96,6 +98,29 @@ var CreateCmd = &cobra.Command{
 			return
 		}
 
+		if dockerfileFlag != "" {
+			if len(projects) > 1 {
+				log.Fatal("--dockerfile can only be used with a single project")
+			}
+
+			projects[0].Build = &apiclient.ProjectBuild{
+				Dockerfile: &apiclient.ProjectBuildDockerfile{
+					Dockerfile: &dockerfileFlag,
+				},
+			}
+			projects[0].Image = nil
+			projects[0].User = nil
+		}
+
+		if len(buildSecretFlag) > 0 {
+			for i := range projects {
+				if projects[i].Build == nil {
+					log.Fatalf("--build-secret can not be used with project %s since it uses a prebuilt image", projects[i].Name)
+				}
+				projects[i].Build.Secrets = append(projects[i].Build.Secrets, buildSecretFlag...)
+			}
+		}
+
 		visited := make(map[string]bool)
 
 		for i := range projects {
@@ -143,12 +168,12 @@ var CreateCmd = &cobra.Command{
 
 		go apiclient_util.ReadWorkspaceLogs(activeProfile, id, projectNames, &stopLogs)
 
-		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(apiclient.CreateWorkspaceRequest{
+		createdWorkspace, res, err := createWorkspace(ctx, apiClient, apiclient.CreateWorkspaceRequest{
 			Id:       &id,
 			Name:     &workspaceName,
 			Target:   target.Name,
 			Projects: projects,
-		}).Execute()
+		})
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 		}
@@ -183,7 +208,7 @@ var CreateCmd = &cobra.Command{
 		}
 
 		fmt.Println()
-		info.Render(wsInfo, chosenIde.Name, false)
+		info.Render(wsInfo, chosenIde.Name, false, nil)
 
 		if !codeFlag {
 			views.RenderCreationInfoMessage("Run 'daytona code' when you're ready to start developing")
@@ -192,7 +217,7 @@ var CreateCmd = &cobra.Command{
 
 		views.RenderCreationInfoMessage("Opening the workspace in your preferred editor ...")
 
-		err = openIDE(chosenIdeId, activeProfile, *createdWorkspace.Id, *wsInfo.Projects[0].Name)
+		err = openIDE(chosenIdeId, activeProfile, *createdWorkspace.Id, *wsInfo.Projects[0].Name, wsInfo.Projects[0].Customizations)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -205,6 +230,8 @@ var targetNameFlag string
 var manualFlag bool
 var multiProjectFlag bool
 var codeFlag bool
+var dockerfileFlag string
+var buildSecretFlag []string
 
 func init() {
 	CreateCmd.Flags().StringVar(&nameFlag, "name", "", "Specify the workspace name")
@@ -214,6 +241,8 @@ func init() {
 	CreateCmd.Flags().BoolVar(&manualFlag, "manual", false, "Manually enter the git repositories")
 	CreateCmd.Flags().BoolVar(&multiProjectFlag, "multi-project", false, "Workspace with multiple projects/repos")
 	CreateCmd.Flags().BoolVarP(&codeFlag, "code", "c", false, "Open the workspace in the IDE after workspace creation")
+	CreateCmd.Flags().StringVar(&dockerfileFlag, "dockerfile", "", "Build the project from the Dockerfile at this path in the repository")
+	CreateCmd.Flags().StringArrayVar(&buildSecretFlag, "build-secret", nil, "Mount the profile build secret into the project builds")
 }
 
 func getTarget(activeProfileName string) (*apiclient.ProviderTarget, error) {
@@ -295,6 +324,30 @@ func processCmdArguments(args []string, apiClient *apiclient.APIClient, projects
 	return nil
 }
 
+const createWorkspaceAttempts = 3
+
+// createWorkspace retries requests that failed without a response from the server.
+// All attempts share an idempotency key, so a request that did reach the server isn't executed twice.
+func createWorkspace(ctx context.Context, apiClient *apiclient.APIClient, request apiclient.CreateWorkspaceRequest) (*apiclient.Workspace, *http.Response, error) {
+	idempotencyKey := uuid.NewString()
+
+	var err error
+	for attempt := 1; attempt <= createWorkspaceAttempts; attempt++ {
+		var workspace *apiclient.Workspace
+		var res *http.Response
+
+		workspace, res, err = apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(request).IdempotencyKey(idempotencyKey).Execute()
+		if err == nil || res != nil {
+			return workspace, res, err
+		}
+
+		log.Debugf("Failed to create workspace (attempt %d/%d): %s", attempt, createWorkspaceAttempts, err)
+		time.Sleep(time.Duration(attempt) * time.Second)
+	}
+
+	return nil, nil, err
+}
+
 func waitForDial(tsConn *tsnet.Server, workspaceId string, projectName string, dialStartTime time.Time, dialTimeout time.Duration) error {
 	for {
 		if time.Since(dialStartTime) > dialTimeout {
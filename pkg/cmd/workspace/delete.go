@@ -182,6 +182,11 @@ func removeWorkspace(ctx context.Context, apiClient *apiclient.APIClient, worksp
 		return err
 	}
 
+	err = config.RemoveWorkspacePortForwardData(*workspace.Id)
+	if err != nil {
+		return err
+	}
+
 	views.RenderInfoMessage(fmt.Sprintf("Workspace %s successfully deleted", *workspace.Name))
 	return nil
 }
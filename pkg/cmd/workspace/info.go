@@ -53,7 +53,7 @@ var InfoCmd = &cobra.Command{
 			return
 		}
 
-		info.Render(workspace, "", false)
+		info.Render(workspace, "", false, getProjectImageStatuses(ctx, apiClient, workspace))
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) > 0 {
@@ -63,3 +63,24 @@ var InfoCmd = &cobra.Command{
 		return getWorkspaceNameCompletions()
 	},
 }
+
+// getProjectImageStatuses returns the image statuses of the projects that are built from their repository
+func getProjectImageStatuses(ctx context.Context, apiClient *apiclient.APIClient, workspace *apiclient.WorkspaceDTO) map[string]*apiclient.ProjectImageStatus {
+	imageStatuses := map[string]*apiclient.ProjectImageStatus{}
+
+	for _, project := range workspace.Projects {
+		if project.Build == nil {
+			continue
+		}
+
+		imageStatus, res, err := apiClient.WorkspaceAPI.GetProjectImageStatus(ctx, workspace.GetId(), project.GetName()).Execute()
+		if err != nil {
+			log.Debug(apiclient_util.HandleErrorResponse(res, err))
+			continue
+		}
+
+		imageStatuses[project.GetName()] = imageStatus
+	}
+
+	return imageStatuses
+}
@@ -5,10 +5,16 @@ package workspace
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/internal/util/apiclient"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_pkg "github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/views"
 	list_view "github.com/daytonaio/daytona/pkg/views/workspace/list"
 	log "github.com/sirupsen/logrus"
@@ -16,6 +22,7 @@ import (
 )
 
 var verbose bool
+var watchFlag bool
 
 var ListCmd = &cobra.Command{
 	Use:     "list",
@@ -23,43 +30,135 @@ var ListCmd = &cobra.Command{
 	Args:    cobra.ExactArgs(0),
 	Aliases: []string{"ls"},
 	Run: func(cmd *cobra.Command, args []string) {
-		ctx := context.Background()
-		var specifyGitProviders bool
+		if watchFlag {
+			if output.FormatFlag != "" {
+				log.Fatal("the --watch flag can not be used with the --output flag")
+			}
 
-		apiClient, err := apiclient_util.GetApiClient(nil)
+			err := watchWorkspaces()
+			if err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+
+		err := listWorkspaces()
 		if err != nil {
 			log.Fatal(err)
 		}
+	},
+}
 
-		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(verbose).Execute()
+func listWorkspaces() error {
+	ctx := context.Background()
+	var specifyGitProviders bool
 
-		if err != nil {
-			log.Fatal(apiclient.HandleErrorResponse(res, err))
-		}
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		return err
+	}
 
-		gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(ctx).Execute()
-		if err != nil {
-			log.Fatal(apiclient.HandleErrorResponse(res, err))
-		}
+	workspaceList, res, err := apiclient_util.ListAll(func(cursor string, limit int32) ([]apiclient_pkg.WorkspaceDTO, *http.Response, error) {
+		return apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(verbose).Cursor(cursor).Limit(limit).Execute()
+	})
+	if err != nil {
+		return apiclient.HandleErrorResponse(res, err)
+	}
+
+	gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(ctx).Execute()
+	if err != nil {
+		return apiclient.HandleErrorResponse(res, err)
+	}
+
+	if len(gitProviders) > 1 {
+		specifyGitProviders = true
+	}
 
-		if len(gitProviders) > 1 {
-			specifyGitProviders = true
+	if output.FormatFlag != "" {
+		output.Output = workspaceList
+		return nil
+	}
+
+	if len(workspaceList) == 0 {
+		views.RenderInfoMessage("The workspace list is empty. Start off by running 'daytona create'.")
+		return nil
+	}
+
+	list_view.ListWorkspaces(workspaceList, specifyGitProviders, verbose)
+	return nil
+}
+
+// watchDebounce is how long the list waits for more events before it is re-rendered
+const watchDebounce = 500 * time.Millisecond
+
+// watchedEventTypes are the events that change the workspace list. Project state updates are sent
+// periodically by the agents and are not watched.
+var watchedEventTypes = []events.EventType{
+	events.EventTypeWorkspaceCreating,
+	events.EventTypeWorkspaceCreated,
+	events.EventTypeWorkspaceStarted,
+	events.EventTypeWorkspaceStopped,
+	events.EventTypeWorkspaceRemoved,
+	events.EventTypeProjectStarted,
+	events.EventTypeProjectStopped,
+}
+
+// watchWorkspaces renders the workspace list and re-renders it whenever the server reports a change
+func watchWorkspaces() error {
+	types := []string{}
+	for _, eventType := range watchedEventTypes {
+		types = append(types, string(eventType))
+	}
+	query := "types=" + strings.Join(types, ",")
+
+	ws, res, err := apiclient_util.GetWebsocketConn("/events", nil, &query)
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+	defer ws.Close()
+
+	changed := make(chan struct{}, 1)
+	readErr := make(chan error, 1)
+	go func() {
+		for {
+			var event events.Event
+			err := ws.ReadJSON(&event)
+			if err != nil {
+				readErr <- err
+				return
+			}
+
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
 		}
+	}()
 
-		if output.FormatFlag != "" {
-			output.Output = workspaceList
-			return
+	for {
+		fmt.Print("\033[H\033[2J")
+
+		err = listWorkspaces()
+		if err != nil {
+			return err
 		}
 
-		if len(workspaceList) == 0 {
-			views.RenderInfoMessage("The workspace list is empty. Start off by running 'daytona create'.")
-			return
+		select {
+		case err := <-readErr:
+			return err
+		case <-changed:
 		}
 
-		list_view.ListWorkspaces(workspaceList, specifyGitProviders, verbose)
-	},
+		// Events come in bursts, like when all projects of a workspace start, so they are rendered once
+		time.Sleep(watchDebounce)
+		select {
+		case <-changed:
+		default:
+		}
+	}
 }
 
 func init() {
 	ListCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Show verbose output")
+	ListCmd.Flags().BoolVarP(&watchFlag, "watch", "w", false, "Watch the workspace list and refresh it on changes")
 }
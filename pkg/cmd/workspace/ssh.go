@@ -7,7 +7,9 @@ import (
 	"context"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
-	"github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/ports"
 	"github.com/daytonaio/daytona/pkg/ide"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
 
@@ -33,8 +35,9 @@ var SshCmd = &cobra.Command{
 		ctx := context.Background()
 		var workspaceId string
 		var projectName string
+		var workspace *apiclient.WorkspaceDTO
 
-		apiClient, err := apiclient.GetApiClient(&activeProfile)
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -42,16 +45,16 @@ var SshCmd = &cobra.Command{
 		if len(args) == 0 {
 			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
 			if err != nil {
-				log.Fatal(apiclient.HandleErrorResponse(res, err))
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 			}
 
-			workspace := selection.GetWorkspaceFromPrompt(workspaceList, "SSH Into")
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "SSH Into")
 			if workspace == nil {
 				return
 			}
 			workspaceId = *workspace.Id
 		} else {
-			workspace, err := apiclient.GetWorkspace(args[0])
+			workspace, err = apiclient_util.GetWorkspace(args[0])
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -73,6 +76,11 @@ var SshCmd = &cobra.Command{
 			projectName = args[1]
 		}
 
+		project := getWorkspaceProject(workspace, projectName)
+		if project != nil {
+			ports.ForwardProjectPorts(workspaceId, *project)
+		}
+
 		err = ide.OpenTerminalSsh(activeProfile, workspaceId, projectName)
 		if err != nil {
 			log.Fatal(err)
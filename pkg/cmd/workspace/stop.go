@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_pkg "github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
 	log "github.com/sirupsen/logrus"
@@ -52,7 +53,7 @@ var StopCmd = &cobra.Command{
 				log.Fatal(apiclient.HandleErrorResponse(res, err))
 			}
 
-			workspace := selection.GetWorkspaceFromPrompt(workspaceList, "Stop")
+			workspace := selection.GetWorkspaceFromPrompt(filterStoppableWorkspaces(workspaceList), "Stop")
 			if workspace == nil {
 				return
 			}
@@ -103,7 +104,7 @@ func stopAllWorkspaces() error {
 		return apiclient.HandleErrorResponse(res, err)
 	}
 
-	for _, workspace := range workspaceList {
+	for _, workspace := range filterStoppableWorkspaces(workspaceList) {
 		res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, *workspace.Id).Execute()
 		if err != nil {
 			log.Errorf("Failed to stop workspace %s: %v", *workspace.Name, apiclient.HandleErrorResponse(res, err))
@@ -113,3 +114,39 @@ func stopAllWorkspaces() error {
 	}
 	return nil
 }
+
+// filterStoppableWorkspaces leaves out workspaces whose provider can't stop them without destroying them.
+// Workspaces are not filtered if the provider capabilities can't be fetched; the server rejects the stop instead.
+func filterStoppableWorkspaces(workspaceList []apiclient_pkg.WorkspaceDTO) []apiclient_pkg.WorkspaceDTO {
+	targetList, err := apiclient.GetTargetList()
+	if err != nil {
+		return workspaceList
+	}
+
+	providerList, err := apiclient.GetProviderList()
+	if err != nil {
+		return workspaceList
+	}
+
+	canStop := map[string]bool{}
+	for _, provider := range providerList {
+		capabilities, ok := provider.GetCapabilitiesOk()
+		canStop[provider.GetName()] = !ok || capabilities.GetStopWithoutDestroy()
+	}
+
+	targetCanStop := map[string]bool{}
+	for _, target := range targetList {
+		providerCanStop, ok := canStop[target.ProviderInfo.GetName()]
+		targetCanStop[target.GetName()] = !ok || providerCanStop
+	}
+
+	stoppable := []apiclient_pkg.WorkspaceDTO{}
+	for _, workspace := range workspaceList {
+		if stop, ok := targetCanStop[workspace.GetTarget()]; ok && !stop {
+			continue
+		}
+		stoppable = append(stoppable, workspace)
+	}
+
+	return stoppable
+}
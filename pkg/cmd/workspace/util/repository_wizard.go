@@ -74,16 +74,32 @@ func getRepositoryFromWizard(userGitProviders []apiclient.GitProvider, additiona
 	}
 
 	var providerRepos []apiclient.GitRepository
-	err = views_util.With(func() error {
-		providerRepos, _, err = apiClient.GitProviderAPI.GetRepositories(ctx, providerId, namespaceId).Execute()
-		return err
-	})
+	var chosenRepo *apiclient.GitRepository
+	var cursor string
+
+	for {
+		err = views_util.With(func() error {
+			repos, res, err := apiClient.GitProviderAPI.GetRepositories(ctx, providerId, namespaceId).Cursor(cursor).Execute()
+			if err != nil {
+				return err
+			}
 
-	if err != nil {
-		return nil, err
+			providerRepos = append(providerRepos, repos...)
+			cursor = apiclient_util.GetNextCursor(res)
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		var loadMore bool
+		chosenRepo, loadMore = selection.GetRepositoryFromPrompt(providerRepos, additionalProjectOrder, cursor != "")
+		if !loadMore {
+			break
+		}
 	}
 
-	chosenRepo := selection.GetRepositoryFromPrompt(providerRepos, additionalProjectOrder)
 	if chosenRepo == nil {
 		return nil, errors.New("must select a repository")
 	}
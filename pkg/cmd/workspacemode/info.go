@@ -34,6 +34,6 @@ var infoCmd = &cobra.Command{
 			return
 		}
 
-		info.Render(workspace, "", false)
+		info.Render(workspace, "", false, nil)
 	},
 }
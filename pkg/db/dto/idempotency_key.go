@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/idempotency"
+)
+
+type IdempotencyKeyDTO struct {
+	Key         string `gorm:"primaryKey"`
+	RequestHash string
+	ResourceId  string
+	ExpiresAt   time.Time `gorm:"index"`
+}
+
+func ToIdempotencyKeyDTO(idempotencyKey idempotency.IdempotencyKey) IdempotencyKeyDTO {
+	return IdempotencyKeyDTO{
+		Key:         idempotencyKey.Key,
+		RequestHash: idempotencyKey.RequestHash,
+		ResourceId:  idempotencyKey.ResourceId,
+		ExpiresAt:   idempotencyKey.ExpiresAt,
+	}
+}
+
+func ToIdempotencyKey(idempotencyKeyDTO IdempotencyKeyDTO) idempotency.IdempotencyKey {
+	return idempotency.IdempotencyKey{
+		Key:         idempotencyKeyDTO.Key,
+		RequestHash: idempotencyKeyDTO.RequestHash,
+		ResourceId:  idempotencyKeyDTO.ResourceId,
+		ExpiresAt:   idempotencyKeyDTO.ExpiresAt,
+	}
+}
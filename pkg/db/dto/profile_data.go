@@ -8,19 +8,49 @@ import "github.com/daytonaio/daytona/pkg/profiledata"
 const ProfileDataId = "profile_data"
 
 type ProfileDataDTO struct {
-	Id      string            `gorm:"primaryKey"`
-	EnvVars map[string]string `gorm:"serializer:json"`
+	Id           string                    `gorm:"primaryKey"`
+	EnvVars      map[string]string         `gorm:"serializer:json"`
+	BuildSecrets map[string]BuildSecretDTO `gorm:"serializer:json"`
+}
+
+type BuildSecretDTO struct {
+	Value              string   `json:"value"`
+	RepositoryPatterns []string `json:"repositoryPatterns,omitempty"`
 }
 
 func ToProfileDataDTO(profileData *profiledata.ProfileData) ProfileDataDTO {
-	return ProfileDataDTO{
+	profileDataDTO := ProfileDataDTO{
 		Id:      ProfileDataId,
 		EnvVars: profileData.EnvVars,
 	}
+
+	if profileData.BuildSecrets != nil {
+		profileDataDTO.BuildSecrets = map[string]BuildSecretDTO{}
+		for name, secret := range profileData.BuildSecrets {
+			profileDataDTO.BuildSecrets[name] = BuildSecretDTO{
+				Value:              secret.Value,
+				RepositoryPatterns: secret.RepositoryPatterns,
+			}
+		}
+	}
+
+	return profileDataDTO
 }
 
 func ToProfileData(profileDataDTO ProfileDataDTO) *profiledata.ProfileData {
-	return &profiledata.ProfileData{
+	profileData := &profiledata.ProfileData{
 		EnvVars: profileDataDTO.EnvVars,
 	}
+
+	if profileDataDTO.BuildSecrets != nil {
+		profileData.BuildSecrets = map[string]profiledata.BuildSecret{}
+		for name, secret := range profileDataDTO.BuildSecrets {
+			profileData.BuildSecrets[name] = profiledata.BuildSecret{
+				Value:              secret.Value,
+				RepositoryPatterns: secret.RepositoryPatterns,
+			}
+		}
+	}
+
+	return profileData
 }
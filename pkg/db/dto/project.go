@@ -32,32 +32,96 @@ type GitStatusDTO struct {
 	Files         []*FileStatusDTO `json:"fileStatus"`
 }
 
+type LifecycleHookStatusDTO struct {
+	Hook  string `json:"hook"`
+	State string `json:"state"`
+	Error string `json:"error,omitempty"`
+}
+
 type ProjectStateDTO struct {
-	UpdatedAt string        `json:"updatedAt"`
-	Uptime    uint64        `json:"uptime"`
-	GitStatus *GitStatusDTO `json:"gitStatus"`
+	UpdatedAt      string                   `json:"updatedAt"`
+	Uptime         uint64                   `json:"uptime"`
+	GitStatus      *GitStatusDTO            `json:"gitStatus"`
+	LifecycleHooks []LifecycleHookStatusDTO `json:"lifecycleHooks,omitempty"`
 }
 
 type ProjectBuildDevcontainerDTO struct {
 	DevContainerFilePath string `json:"devContainerFilePath"`
 }
 
+type ProjectBuildDockerfileDTO struct {
+	Context    string            `json:"context"`
+	Dockerfile string            `json:"dockerfile"`
+	Args       map[string]string `json:"args"`
+	Target     string            `json:"target"`
+}
+
 type ProjectBuildDTO struct {
 	Devcontainer *ProjectBuildDevcontainerDTO `json:"devcontainer"`
+	Dockerfile   *ProjectBuildDockerfileDTO   `json:"dockerfile,omitempty"`
+	Secrets      []string                     `json:"secrets,omitempty"`
+}
+
+type ProjectServiceDTO struct {
+	Name    string            `json:"name"`
+	Image   string            `json:"image"`
+	EnvVars map[string]string `json:"envVars"`
+	Command []string          `json:"command,omitempty"`
+}
+
+type LifecycleCommandDTO struct {
+	Name    string   `json:"name,omitempty"`
+	Command string   `json:"command,omitempty"`
+	Args    []string `json:"args,omitempty"`
+}
+
+type LifecycleCommandGroupDTO struct {
+	Commands []LifecycleCommandDTO `json:"commands"`
+}
+
+type ProjectLifecycleDTO struct {
+	OnCreate      []LifecycleCommandGroupDTO `json:"onCreate,omitempty"`
+	UpdateContent []LifecycleCommandGroupDTO `json:"updateContent,omitempty"`
+	PostCreate    []LifecycleCommandGroupDTO `json:"postCreate,omitempty"`
+	PostStart     []LifecycleCommandGroupDTO `json:"postStart,omitempty"`
+	PostAttach    []LifecycleCommandGroupDTO `json:"postAttach,omitempty"`
+	WaitFor       string                     `json:"waitFor,omitempty"`
+	Prebuilt      []string                   `json:"prebuilt,omitempty"`
+}
+
+type ProjectPortDTO struct {
+	Port             uint16 `json:"port"`
+	Label            string `json:"label,omitempty"`
+	Protocol         string `json:"protocol,omitempty"`
+	OnAutoForward    string `json:"onAutoForward,omitempty"`
+	RequireLocalPort bool   `json:"requireLocalPort,omitempty"`
+}
+
+type VSCodeCustomizationsDTO struct {
+	Extensions []string               `json:"extensions,omitempty"`
+	Settings   map[string]interface{} `json:"settings,omitempty"`
+}
+
+type ProjectCustomizationsDTO struct {
+	VSCode *VSCodeCustomizationsDTO `json:"vscode,omitempty"`
 }
 
 type ProjectDTO struct {
-	Name               string           `json:"name"`
-	Image              string           `json:"image"`
-	User               string           `json:"user"`
-	Build              *ProjectBuildDTO `json:"build,omitempty" gorm:"serializer:json"`
-	Repository         RepositoryDTO    `json:"repository"`
-	WorkspaceId        string           `json:"workspaceId"`
-	Target             string           `json:"target"`
-	ApiKey             string           `json:"apiKey"`
-	State              *ProjectStateDTO `json:"state,omitempty" gorm:"serializer:json"`
-	PostStartCommands  []string         `json:"postStartCommands,omitempty"`
-	PostCreateCommands []string         `json:"postCreateCommands,omitempty"`
+	Name               string                    `json:"name"`
+	Image              string                    `json:"image"`
+	User               string                    `json:"user"`
+	Build              *ProjectBuildDTO          `json:"build,omitempty" gorm:"serializer:json"`
+	Repository         RepositoryDTO             `json:"repository"`
+	WorkspaceId        string                    `json:"workspaceId"`
+	Target             string                    `json:"target"`
+	ApiKey             string                    `json:"apiKey"`
+	State              *ProjectStateDTO          `json:"state,omitempty" gorm:"serializer:json"`
+	PostStartCommands  []string                  `json:"postStartCommands,omitempty"`
+	PostCreateCommands []string                  `json:"postCreateCommands,omitempty"`
+	Services           []ProjectServiceDTO       `json:"services,omitempty"`
+	Lifecycle          *ProjectLifecycleDTO      `json:"lifecycle,omitempty"`
+	ForwardPorts       []ProjectPortDTO          `json:"forwardPorts,omitempty"`
+	Customizations     *ProjectCustomizationsDTO `json:"customizations,omitempty"`
 }
 
 func ToProjectDTO(project *workspace.Project, workspace *workspace.Workspace) ProjectDTO {
@@ -72,6 +136,10 @@ func ToProjectDTO(project *workspace.Project, workspace *workspace.Workspace) Pr
 		State:              ToProjectStateDTO(project.State),
 		PostStartCommands:  project.PostStartCommands,
 		PostCreateCommands: project.PostCreateCommands,
+		Services:           ToProjectServicesDTO(project.Services),
+		Lifecycle:          ToProjectLifecycleDTO(project.Lifecycle),
+		ForwardPorts:       ToProjectPortsDTO(project.ForwardPorts),
+		Customizations:     ToProjectCustomizationsDTO(project.Customizations),
 		ApiKey:             workspace.ApiKey,
 	}
 }
@@ -92,6 +160,93 @@ func ToRepositoryDTO(repo *gitprovider.GitRepository) RepositoryDTO {
 	return repoDTO
 }
 
+func ToProjectServicesDTO(services []workspace.ProjectService) []ProjectServiceDTO {
+	var servicesDTO []ProjectServiceDTO
+	for _, service := range services {
+		servicesDTO = append(servicesDTO, ProjectServiceDTO{
+			Name:    service.Name,
+			Image:   service.Image,
+			EnvVars: service.EnvVars,
+			Command: service.Command,
+		})
+	}
+
+	return servicesDTO
+}
+
+func ToProjectPortsDTO(ports []workspace.ProjectPort) []ProjectPortDTO {
+	var portsDTO []ProjectPortDTO
+	for _, port := range ports {
+		portsDTO = append(portsDTO, ProjectPortDTO{
+			Port:             port.Port,
+			Label:            port.Label,
+			Protocol:         port.Protocol,
+			OnAutoForward:    string(port.OnAutoForward),
+			RequireLocalPort: port.RequireLocalPort,
+		})
+	}
+
+	return portsDTO
+}
+
+func ToProjectCustomizationsDTO(customizations *workspace.ProjectCustomizations) *ProjectCustomizationsDTO {
+	if customizations == nil {
+		return nil
+	}
+
+	customizationsDTO := &ProjectCustomizationsDTO{}
+
+	if customizations.VSCode != nil {
+		customizationsDTO.VSCode = &VSCodeCustomizationsDTO{
+			Extensions: customizations.VSCode.Extensions,
+			Settings:   customizations.VSCode.Settings,
+		}
+	}
+
+	return customizationsDTO
+}
+
+func ToProjectLifecycleDTO(lifecycle *workspace.ProjectLifecycle) *ProjectLifecycleDTO {
+	if lifecycle == nil {
+		return nil
+	}
+
+	return &ProjectLifecycleDTO{
+		OnCreate:      toLifecycleCommandGroupsDTO(lifecycle.OnCreate),
+		UpdateContent: toLifecycleCommandGroupsDTO(lifecycle.UpdateContent),
+		PostCreate:    toLifecycleCommandGroupsDTO(lifecycle.PostCreate),
+		PostStart:     toLifecycleCommandGroupsDTO(lifecycle.PostStart),
+		PostAttach:    toLifecycleCommandGroupsDTO(lifecycle.PostAttach),
+		WaitFor:       string(lifecycle.WaitFor),
+		Prebuilt:      toLifecycleHooksDTO(lifecycle.Prebuilt),
+	}
+}
+
+func toLifecycleHooksDTO(hooks []workspace.LifecycleHook) []string {
+	var hooksDTO []string
+	for _, hook := range hooks {
+		hooksDTO = append(hooksDTO, string(hook))
+	}
+	return hooksDTO
+}
+
+func toLifecycleCommandGroupsDTO(groups []workspace.LifecycleCommandGroup) []LifecycleCommandGroupDTO {
+	var groupsDTO []LifecycleCommandGroupDTO
+	for _, group := range groups {
+		groupDTO := LifecycleCommandGroupDTO{}
+		for _, command := range group.Commands {
+			groupDTO.Commands = append(groupDTO.Commands, LifecycleCommandDTO{
+				Name:    command.Name,
+				Command: command.Command,
+				Args:    command.Args,
+			})
+		}
+		groupsDTO = append(groupsDTO, groupDTO)
+	}
+
+	return groupsDTO
+}
+
 func ToFileStatusDTO(status *workspace.FileStatus) *FileStatusDTO {
 	if status == nil {
 		return nil
@@ -126,11 +281,21 @@ func ToProjectStateDTO(state *workspace.ProjectState) *ProjectStateDTO {
 		return nil
 	}
 
-	return &ProjectStateDTO{
+	stateDTO := &ProjectStateDTO{
 		UpdatedAt: state.UpdatedAt,
 		Uptime:    state.Uptime,
 		GitStatus: ToGitStatusDTO(state.GitStatus),
 	}
+
+	for _, hook := range state.LifecycleHooks {
+		stateDTO.LifecycleHooks = append(stateDTO.LifecycleHooks, LifecycleHookStatusDTO{
+			Hook:  string(hook.Hook),
+			State: string(hook.State),
+			Error: hook.Error,
+		})
+	}
+
+	return stateDTO
 }
 
 func ToProjectBuildDTO(build *workspace.ProjectBuild) *ProjectBuildDTO {
@@ -138,15 +303,26 @@ func ToProjectBuildDTO(build *workspace.ProjectBuild) *ProjectBuildDTO {
 		return nil
 	}
 
-	if build.Devcontainer == nil {
-		return &ProjectBuildDTO{}
+	buildDTO := &ProjectBuildDTO{
+		Secrets: build.Secrets,
 	}
 
-	return &ProjectBuildDTO{
-		Devcontainer: &ProjectBuildDevcontainerDTO{
+	if build.Devcontainer != nil {
+		buildDTO.Devcontainer = &ProjectBuildDevcontainerDTO{
 			DevContainerFilePath: build.Devcontainer.DevContainerFilePath,
-		},
+		}
 	}
+
+	if build.Dockerfile != nil {
+		buildDTO.Dockerfile = &ProjectBuildDockerfileDTO{
+			Context:    build.Dockerfile.Context,
+			Dockerfile: build.Dockerfile.Dockerfile,
+			Args:       build.Dockerfile.Args,
+			Target:     build.Dockerfile.Target,
+		}
+	}
+
+	return buildDTO
 }
 
 func ToProject(projectDTO ProjectDTO) *workspace.Project {
@@ -161,10 +337,101 @@ func ToProject(projectDTO ProjectDTO) *workspace.Project {
 		State:              ToProjectState(projectDTO.State),
 		PostStartCommands:  projectDTO.PostStartCommands,
 		PostCreateCommands: projectDTO.PostCreateCommands,
+		Services:           ToProjectServices(projectDTO.Services),
+		Lifecycle:          ToProjectLifecycle(projectDTO.Lifecycle),
+		ForwardPorts:       ToProjectPorts(projectDTO.ForwardPorts),
+		Customizations:     ToProjectCustomizations(projectDTO.Customizations),
 		ApiKey:             projectDTO.ApiKey,
 	}
 }
 
+func ToProjectServices(servicesDTO []ProjectServiceDTO) []workspace.ProjectService {
+	var services []workspace.ProjectService
+	for _, serviceDTO := range servicesDTO {
+		services = append(services, workspace.ProjectService{
+			Name:    serviceDTO.Name,
+			Image:   serviceDTO.Image,
+			EnvVars: serviceDTO.EnvVars,
+			Command: serviceDTO.Command,
+		})
+	}
+
+	return services
+}
+
+func ToProjectPorts(portsDTO []ProjectPortDTO) []workspace.ProjectPort {
+	var ports []workspace.ProjectPort
+	for _, portDTO := range portsDTO {
+		ports = append(ports, workspace.ProjectPort{
+			Port:             portDTO.Port,
+			Label:            portDTO.Label,
+			Protocol:         portDTO.Protocol,
+			OnAutoForward:    workspace.PortAutoForwardAction(portDTO.OnAutoForward),
+			RequireLocalPort: portDTO.RequireLocalPort,
+		})
+	}
+
+	return ports
+}
+
+func ToProjectCustomizations(customizationsDTO *ProjectCustomizationsDTO) *workspace.ProjectCustomizations {
+	if customizationsDTO == nil {
+		return nil
+	}
+
+	customizations := &workspace.ProjectCustomizations{}
+
+	if customizationsDTO.VSCode != nil {
+		customizations.VSCode = &workspace.VSCodeCustomizations{
+			Extensions: customizationsDTO.VSCode.Extensions,
+			Settings:   customizationsDTO.VSCode.Settings,
+		}
+	}
+
+	return customizations
+}
+
+func ToProjectLifecycle(lifecycleDTO *ProjectLifecycleDTO) *workspace.ProjectLifecycle {
+	if lifecycleDTO == nil {
+		return nil
+	}
+
+	return &workspace.ProjectLifecycle{
+		OnCreate:      toLifecycleCommandGroups(lifecycleDTO.OnCreate),
+		UpdateContent: toLifecycleCommandGroups(lifecycleDTO.UpdateContent),
+		PostCreate:    toLifecycleCommandGroups(lifecycleDTO.PostCreate),
+		PostStart:     toLifecycleCommandGroups(lifecycleDTO.PostStart),
+		PostAttach:    toLifecycleCommandGroups(lifecycleDTO.PostAttach),
+		WaitFor:       workspace.LifecycleHook(lifecycleDTO.WaitFor),
+		Prebuilt:      toLifecycleHooks(lifecycleDTO.Prebuilt),
+	}
+}
+
+func toLifecycleHooks(hooksDTO []string) []workspace.LifecycleHook {
+	var hooks []workspace.LifecycleHook
+	for _, hook := range hooksDTO {
+		hooks = append(hooks, workspace.LifecycleHook(hook))
+	}
+	return hooks
+}
+
+func toLifecycleCommandGroups(groupsDTO []LifecycleCommandGroupDTO) []workspace.LifecycleCommandGroup {
+	var groups []workspace.LifecycleCommandGroup
+	for _, groupDTO := range groupsDTO {
+		group := workspace.LifecycleCommandGroup{}
+		for _, commandDTO := range groupDTO.Commands {
+			group.Commands = append(group.Commands, workspace.LifecycleCommand{
+				Name:    commandDTO.Name,
+				Command: commandDTO.Command,
+				Args:    commandDTO.Args,
+			})
+		}
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
 func ToFileStatus(statusDTO *FileStatusDTO) *workspace.FileStatus {
 	if statusDTO == nil {
 		return nil
@@ -199,11 +466,21 @@ func ToProjectState(stateDTO *ProjectStateDTO) *workspace.ProjectState {
 		return nil
 	}
 
-	return &workspace.ProjectState{
+	state := &workspace.ProjectState{
 		UpdatedAt: stateDTO.UpdatedAt,
 		Uptime:    stateDTO.Uptime,
 		GitStatus: ToGitStatus(stateDTO.GitStatus),
 	}
+
+	for _, hookDTO := range stateDTO.LifecycleHooks {
+		state.LifecycleHooks = append(state.LifecycleHooks, workspace.LifecycleHookStatus{
+			Hook:  workspace.LifecycleHook(hookDTO.Hook),
+			State: workspace.LifecycleHookState(hookDTO.State),
+			Error: hookDTO.Error,
+		})
+	}
+
+	return state
 }
 
 func ToRepository(repoDTO RepositoryDTO) *gitprovider.GitRepository {
@@ -227,13 +504,24 @@ func ToProjectBuild(buildDTO *ProjectBuildDTO) *workspace.ProjectBuild {
 		return nil
 	}
 
-	if buildDTO.Devcontainer == nil {
-		return &workspace.ProjectBuild{}
+	build := &workspace.ProjectBuild{
+		Secrets: buildDTO.Secrets,
 	}
 
-	return &workspace.ProjectBuild{
-		Devcontainer: &workspace.ProjectBuildDevcontainer{
+	if buildDTO.Devcontainer != nil {
+		build.Devcontainer = &workspace.ProjectBuildDevcontainer{
 			DevContainerFilePath: buildDTO.Devcontainer.DevContainerFilePath,
-		},
+		}
 	}
+
+	if buildDTO.Dockerfile != nil {
+		build.Dockerfile = &workspace.ProjectBuildDockerfile{
+			Context:    buildDTO.Dockerfile.Context,
+			Dockerfile: buildDTO.Dockerfile.Dockerfile,
+			Args:       buildDTO.Dockerfile.Args,
+			Target:     buildDTO.Dockerfile.Target,
+		}
+	}
+
+	return build
 }
@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"time"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/idempotency"
+	"gorm.io/gorm"
+)
+
+type IdempotencyKeyStore struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyKeyStore(db *gorm.DB) (*IdempotencyKeyStore, error) {
+	err := db.AutoMigrate(&IdempotencyKeyDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &IdempotencyKeyStore{db: db}, nil
+}
+
+func (s *IdempotencyKeyStore) Find(key string) (*idempotency.IdempotencyKey, error) {
+	idempotencyKeyDTO := IdempotencyKeyDTO{}
+	tx := s.db.Where("key = ? AND expires_at > ?", key, time.Now()).First(&idempotencyKeyDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, idempotency.ErrIdempotencyKeyNotFound
+		}
+		return nil, tx.Error
+	}
+
+	idempotencyKey := ToIdempotencyKey(idempotencyKeyDTO)
+
+	return &idempotencyKey, nil
+}
+
+// Save stores the key and deletes the keys that expired
+func (s *IdempotencyKeyStore) Save(idempotencyKey *idempotency.IdempotencyKey) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("expires_at <= ?", time.Now()).Delete(&IdempotencyKeyDTO{}).Error
+		if err != nil {
+			return err
+		}
+
+		idempotencyKeyDTO := ToIdempotencyKeyDTO(*idempotencyKey)
+		return tx.Save(&idempotencyKeyDTO).Error
+	})
+}
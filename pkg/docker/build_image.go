@@ -0,0 +1,210 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/moby/patternmatcher"
+	"github.com/moby/patternmatcher/ignorefile"
+)
+
+type BuildImageOptions struct {
+	// ContextDir is the local directory sent to the daemon as the build context
+	ContextDir string
+	// Dockerfile is the path of the Dockerfile relative to ContextDir
+	Dockerfile string
+	BuildArgs  map[string]string
+	// Target is the build stage to build. The last stage is built if empty.
+	Target string
+	Tag    string
+}
+
+// BuildImage builds an image from a Dockerfile with the Docker API.
+// Files matched by the .dockerignore file of the context are not sent to the daemon.
+func (d *DockerClient) BuildImage(options BuildImageOptions, logWriter io.Writer) error {
+	ctx := context.Background()
+
+	dockerfile := filepath.Clean(options.Dockerfile)
+	if dockerfile == "." || filepath.IsAbs(dockerfile) || strings.HasPrefix(dockerfile, "..") {
+		return fmt.Errorf("dockerfile %s must be inside the build context", options.Dockerfile)
+	}
+
+	buildContext, buildContextWriter := io.Pipe()
+	go func() {
+		buildContextWriter.CloseWithError(writeBuildContext(buildContextWriter, options.ContextDir, dockerfile))
+	}()
+	defer buildContext.Close()
+
+	buildArgs := map[string]*string{}
+	for key, value := range options.BuildArgs {
+		buildArgs[key] = &value
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte("Building image...\n"))
+	}
+
+	response, err := d.apiClient.ImageBuild(ctx, buildContext, types.ImageBuildOptions{
+		Tags:        []string{options.Tag},
+		Dockerfile:  filepath.ToSlash(dockerfile),
+		BuildArgs:   buildArgs,
+		Target:      options.Target,
+		Remove:      true,
+		ForceRemove: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if logWriter == nil {
+		logWriter = io.Discard
+	}
+
+	err = jsonmessage.DisplayJSONMessagesStream(response.Body, logWriter, 0, false, nil)
+	if err != nil {
+		return err
+	}
+
+	logWriter.Write([]byte("Image built successfully\n"))
+
+	return nil
+}
+
+// readDockerignore returns the exclude patterns of the context's .dockerignore file
+func readDockerignore(contextDir string) ([]string, error) {
+	file, err := os.Open(filepath.Join(contextDir, ".dockerignore"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	return ignorefile.ReadAll(file)
+}
+
+// WalkBuildContext calls fn with the path relative to contextDir of every file and directory that is sent
+// to the daemon as the build context, i.e. that isn't excluded by the context's .dockerignore file.
+// Like with the Docker CLI, the Dockerfile and the .dockerignore file are never excluded.
+func WalkBuildContext(contextDir string, dockerfile string, fn func(relPath string, info os.FileInfo) error) error {
+	excludes, err := readDockerignore(contextDir)
+	if err != nil {
+		return err
+	}
+	if len(excludes) > 0 {
+		excludes = append(excludes, "!"+filepath.ToSlash(dockerfile), "!.dockerignore")
+	}
+
+	pm, err := patternmatcher.New(excludes)
+	if err != nil {
+		return fmt.Errorf("invalid .dockerignore pattern: %w", err)
+	}
+
+	parentMatchInfo := map[string]patternmatcher.MatchInfo{}
+
+	return filepath.Walk(contextDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(contextDir, path)
+		if err != nil {
+			return err
+		}
+
+		if relPath == "." {
+			return nil
+		}
+
+		excluded, matchInfo, err := pm.MatchesUsingParentResults(relPath, parentMatchInfo[filepath.Dir(relPath)])
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			parentMatchInfo[relPath] = matchInfo
+		}
+
+		if excluded {
+			// Excluded directories are still walked if an exception pattern might re-include files inside them
+			if info.IsDir() && !hasExceptionsInDir(pm, relPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		return fn(relPath, info)
+	})
+}
+
+func hasExceptionsInDir(pm *patternmatcher.PatternMatcher, dir string) bool {
+	if !pm.Exclusions() {
+		return false
+	}
+
+	dirPrefix := dir + string(filepath.Separator)
+	for _, pattern := range pm.Patterns() {
+		if pattern.Exclusion() && strings.HasPrefix(pattern.String()+string(filepath.Separator), dirPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeBuildContext(w io.Writer, contextDir string, dockerfile string) error {
+	tw := tar.NewWriter(w)
+
+	err := WalkBuildContext(contextDir, dockerfile, func(relPath string, info os.FileInfo) error {
+		path := filepath.Join(contextDir, relPath)
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			var err error
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+
+		err = tw.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tw, file)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
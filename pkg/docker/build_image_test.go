@@ -0,0 +1,86 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestBuildImage() {
+	contextDir := s.T().TempDir()
+	for path, content := range map[string]string{
+		".dockerignore":         "# comment\n*.log\nnode_modules\n!keep.log\n**/*.tmp\nvendor\n!vendor/keep/*.go\nbuild\n",
+		"build/Dockerfile":      "FROM alpine\n",
+		"build/script.sh":       "echo\n",
+		"main.go":               "package main\n",
+		"debug.log":             "debug\n",
+		"keep.log":              "keep\n",
+		"node_modules/a/b.js":   "b\n",
+		"src/node_modules/c.js": "c\n",
+		"src/a/b/cache.tmp":     "tmp\n",
+		"vendor/lib.go":         "package lib\n",
+		"vendor/keep/keep.go":   "package keep\n",
+	} {
+		require.Nil(s.T(), os.MkdirAll(filepath.Dir(filepath.Join(contextDir, path)), 0755))
+		require.Nil(s.T(), os.WriteFile(filepath.Join(contextDir, path), []byte(content), 0644))
+	}
+
+	arg := "value"
+	var files []string
+
+	s.mockClient.On("ImageBuild", mock.Anything, mock.Anything, types.ImageBuildOptions{
+		Tags:        []string{"registry/image:tag"},
+		Dockerfile:  "build/Dockerfile",
+		BuildArgs:   map[string]*string{"ARG": &arg},
+		Target:      "dev",
+		Remove:      true,
+		ForceRemove: true,
+	}).Run(func(args mock.Arguments) {
+		tr := tar.NewReader(args.Get(1).(io.Reader))
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.Nil(s.T(), err)
+			if header.Typeflag == tar.TypeReg {
+				files = append(files, header.Name)
+			}
+		}
+	}).Return(types.ImageBuildResponse{
+		Body: io.NopCloser(strings.NewReader(`{"stream":"Step 1/1 : FROM alpine\n"}` + "\n")),
+	}, nil)
+
+	var logs bytes.Buffer
+	err := s.dockerClient.BuildImage(docker.BuildImageOptions{
+		ContextDir: contextDir,
+		Dockerfile: "build/Dockerfile",
+		BuildArgs:  map[string]string{"ARG": "value"},
+		Target:     "dev",
+		Tag:        "registry/image:tag",
+	}, &logs)
+	require.Nil(s.T(), err)
+
+	require.ElementsMatch(s.T(), []string{".dockerignore", "build/Dockerfile", "main.go", "keep.log", "src/node_modules/c.js", "vendor/keep/keep.go"}, files)
+	require.Contains(s.T(), logs.String(), "Step 1/1 : FROM alpine")
+}
+
+func (s *DockerClientTestSuite) TestBuildImageDockerfileOutsideContext() {
+	err := s.dockerClient.BuildImage(docker.BuildImageOptions{
+		ContextDir: s.T().TempDir(),
+		Dockerfile: "../Dockerfile",
+		Tag:        "registry/image:tag",
+	}, nil)
+	require.NotNil(s.T(), err)
+}
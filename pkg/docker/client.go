@@ -26,11 +26,14 @@ type IDockerClient interface {
 	GetWorkspaceInfo(ws *workspace.Workspace) (*workspace.WorkspaceInfo, error)
 
 	GetProjectContainerName(project *workspace.Project) string
+	GetProjectServiceContainerName(project *workspace.Project, serviceName string) string
 	GetProjectVolumeName(project *workspace.Project) string
 	ExecSync(containerID string, config types.ExecConfig, outputWriter io.Writer) (*ExecResult, error)
 	GetContainerLogs(containerName string, logWriter io.Writer) error
 	PullImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
+	GetImageDigest(imageName string, cr *containerregistry.ContainerRegistry) (string, error)
 	PushImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
+	BuildImage(options BuildImageOptions, logWriter io.Writer) error
 }
 
 type DockerClientConfig struct {
@@ -56,6 +56,11 @@ func (d *DockerClient) CreateProject(project *workspace.Project, daytonaDownload
 		return err
 	}
 
+	err = d.createServiceContainers(project, logWriter)
+	if err != nil {
+		return err
+	}
+
 	return d.initProjectContainer(project, daytonaDownloadUrl)
 }
 
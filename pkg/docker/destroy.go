@@ -32,7 +32,14 @@ func (d *DockerClient) DestroyWorkspace(workspace *workspace.Workspace) error {
 	return nil
 }
 
+// DestroyProject removes the project's sidecar services before the project container so that
+// a failure doesn't leave services behind without their project
 func (d *DockerClient) DestroyProject(project *workspace.Project) error {
+	err := d.removeServiceContainers(project)
+	if err != nil {
+		return err
+	}
+
 	return d.removeProjectContainer(project)
 }
 
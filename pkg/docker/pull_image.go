@@ -74,6 +74,16 @@ func (d *DockerClient) PullImage(imageName string, cr *containerregistry.Contain
 	return nil
 }
 
+// GetImageDigest returns the digest of the manifest that the image reference currently points to in its registry
+func (d *DockerClient) GetImageDigest(imageName string, cr *containerregistry.ContainerRegistry) (string, error) {
+	inspect, err := d.apiClient.DistributionInspect(context.Background(), imageName, getRegistryAuth(cr))
+	if err != nil {
+		return "", err
+	}
+
+	return inspect.Descriptor.Digest.String(), nil
+}
+
 func getRegistryAuth(cr *containerregistry.ContainerRegistry) string {
 	if cr == nil {
 		// Sometimes registry auth fails if "" is sent, so sending "empty" instead
@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+)
+
+// serviceRestartRetries is the number of times a crashed service container is restarted
+const serviceRestartRetries = 5
+
+func (d *DockerClient) GetProjectServiceContainerName(project *workspace.Project, serviceName string) string {
+	return d.GetProjectContainerName(project) + "-" + serviceName
+}
+
+// createServiceContainers creates the containers of the project's sidecar services in the workspace network.
+// Services can be reached from the project container by their name.
+func (d *DockerClient) createServiceContainers(project *workspace.Project, logWriter io.Writer) error {
+	ctx := context.Background()
+
+	for _, service := range project.Services {
+		err := d.PullImage(service.Image, nil, logWriter)
+		if err != nil {
+			return err
+		}
+
+		if logWriter != nil {
+			logWriter.Write([]byte(fmt.Sprintf("Creating service %s\n", service.Name)))
+		}
+
+		_, err = d.apiClient.ContainerCreate(ctx, GetServiceContainerCreateConfig(project, service), GetServiceContainerHostConfig(project), &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{
+				project.WorkspaceId: {
+					Aliases: []string{service.Name},
+				},
+			},
+		}, nil, d.GetProjectServiceContainerName(project, service.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DockerClient) startServiceContainers(project *workspace.Project) error {
+	for _, service := range project.Services {
+		err := d.startContainer(d.GetProjectServiceContainerName(project, service.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DockerClient) stopServiceContainers(project *workspace.Project) error {
+	for _, service := range project.Services {
+		err := d.stopContainer(d.GetProjectServiceContainerName(project, service.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeServiceContainers removes the services in reverse order so that services are removed before
+// the services they depend on
+func (d *DockerClient) removeServiceContainers(project *workspace.Project) error {
+	ctx := context.Background()
+
+	for i := len(project.Services) - 1; i >= 0; i-- {
+		service := project.Services[i]
+		err := d.apiClient.ContainerRemove(ctx, d.GetProjectServiceContainerName(project, service.Name), container.RemoveOptions{
+			Force:         true,
+			RemoveVolumes: true,
+		})
+		if err != nil && !client.IsErrNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetServiceContainerHostConfig restarts services that crash so a failing dependency doesn't stay down
+func GetServiceContainerHostConfig(project *workspace.Project) *container.HostConfig {
+	return &container.HostConfig{
+		NetworkMode: container.NetworkMode(project.WorkspaceId),
+		RestartPolicy: container.RestartPolicy{
+			Name:              container.RestartPolicyOnFailure,
+			MaximumRetryCount: serviceRestartRetries,
+		},
+	}
+}
+
+func GetServiceContainerCreateConfig(project *workspace.Project, service workspace.ProjectService) *container.Config {
+	envVars := []string{}
+
+	for key, value := range service.EnvVars {
+		envVars = append(envVars, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	return &container.Config{
+		Hostname: service.Name,
+		Image:    service.Image,
+		Labels: map[string]string{
+			"daytona.workspace.id":              project.WorkspaceId,
+			"daytona.workspace.project.name":    project.Name,
+			"daytona.workspace.project.service": service.Name,
+		},
+		Env: envVars,
+		Cmd: service.Command,
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"fmt"
+
+	t_docker "github.com/daytonaio/daytona/internal/testing/docker"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var projectWithServices = &workspace.Project{
+	Name:        "compose",
+	Repository:  project1.Repository,
+	Image:       "test-image:tag",
+	User:        "test-user",
+	WorkspaceId: "123",
+	Target:      "local",
+	Services: []workspace.ProjectService{
+		{
+			Name:    "db",
+			Image:   "postgres:16",
+			EnvVars: map[string]string{"POSTGRES_PASSWORD": "password"},
+			Command: []string{"postgres", "-c", "log_statement=all"},
+		},
+	},
+}
+
+func (s *DockerClientTestSuite) TestCreateProjectWithServices() {
+	var networkingConfig *network.NetworkingConfig
+	var platform *v1.Platform
+
+	service := projectWithServices.Services[0]
+
+	for _, imageName := range []string{service.Image, projectWithServices.Image} {
+		s.mockClient.On("ImageList", mock.Anything,
+			image.ListOptions{
+				Filters: filters.NewArgs(filters.Arg("reference", imageName)),
+			},
+		).Return([]image.Summary{}, nil)
+		s.mockClient.On("ImagePull", mock.Anything, imageName, mock.Anything).Return(t_docker.NewPipeReader(""), nil)
+	}
+
+	s.mockClient.On("ContainerCreate", mock.Anything, docker.GetServiceContainerCreateConfig(projectWithServices, service),
+		docker.GetServiceContainerHostConfig(projectWithServices),
+		&network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{
+				projectWithServices.WorkspaceId: {
+					Aliases: []string{service.Name},
+				},
+			},
+		},
+		platform,
+		s.dockerClient.GetProjectServiceContainerName(projectWithServices, service.Name),
+	).Return(container.CreateResponse{ID: "456"}, nil)
+
+	s.mockClient.On("ContainerCreate", mock.Anything, docker.GetContainerCreateConfig(projectWithServices, "download-url"),
+		&container.HostConfig{
+			Privileged:  true,
+			NetworkMode: container.NetworkMode(projectWithServices.WorkspaceId),
+			Mounts: []mount.Mount{
+				{
+					Type:   mount.TypeVolume,
+					Source: s.dockerClient.GetProjectVolumeName(projectWithServices),
+					Target: fmt.Sprintf("/home/%s/%s", projectWithServices.User, projectWithServices.Name),
+				},
+			},
+			ExtraHosts: []string{
+				"host.docker.internal:host-gateway",
+			},
+		},
+		networkingConfig,
+		platform,
+		s.dockerClient.GetProjectContainerName(projectWithServices),
+	).Return(container.CreateResponse{ID: "123"}, nil)
+
+	err := s.dockerClient.CreateProject(projectWithServices, "download-url", nil, nil)
+	require.Nil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) TestStartProjectWithServices() {
+	containerNames := []string{
+		s.dockerClient.GetProjectServiceContainerName(projectWithServices, "db"),
+		s.dockerClient.GetProjectContainerName(projectWithServices),
+	}
+
+	for _, containerName := range containerNames {
+		s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+			ContainerJSONBase: &types.ContainerJSONBase{
+				State: &types.ContainerState{
+					Running: false,
+				},
+			},
+		}, nil).Once()
+		s.mockClient.On("ContainerStart", mock.Anything, containerName, container.StartOptions{}).Return(nil)
+		s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+			ContainerJSONBase: &types.ContainerJSONBase{
+				State: &types.ContainerState{
+					Running: true,
+				},
+			},
+		}, nil)
+	}
+
+	err := s.dockerClient.StartProject(projectWithServices)
+	require.Nil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) TestStopProjectWithServices() {
+	containerNames := []string{
+		s.dockerClient.GetProjectContainerName(projectWithServices),
+		s.dockerClient.GetProjectServiceContainerName(projectWithServices, "db"),
+	}
+
+	for _, containerName := range containerNames {
+		s.mockClient.On("ContainerStop", mock.Anything, containerName, container.StopOptions{}).Return(nil)
+		s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+			ContainerJSONBase: &types.ContainerJSONBase{
+				State: &types.ContainerState{
+					Running: false,
+				},
+			},
+		}, nil)
+	}
+
+	err := s.dockerClient.StopProject(projectWithServices)
+	require.Nil(s.T(), err)
+}
+
+func (s *DockerClientTestSuite) TestDestroyProjectWithServices() {
+	removeOptions := container.RemoveOptions{
+		Force:         true,
+		RemoveVolumes: true,
+	}
+
+	removed := []string{}
+	recordRemoved := func(args mock.Arguments) {
+		removed = append(removed, args.String(1))
+	}
+
+	s.mockClient.On("ContainerRemove", mock.Anything, s.dockerClient.GetProjectContainerName(projectWithServices), removeOptions).Run(recordRemoved).Return(nil)
+	s.mockClient.On("VolumeRemove", mock.Anything, s.dockerClient.GetProjectVolumeName(projectWithServices), true).Return(nil)
+	s.mockClient.On("ContainerRemove", mock.Anything, s.dockerClient.GetProjectServiceContainerName(projectWithServices, "db"), removeOptions).Run(recordRemoved).Return(nil)
+
+	err := s.dockerClient.DestroyProject(projectWithServices)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), []string{
+		s.dockerClient.GetProjectServiceContainerName(projectWithServices, "db"),
+		s.dockerClient.GetProjectContainerName(projectWithServices),
+	}, removed)
+}
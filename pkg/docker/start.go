@@ -4,20 +4,35 @@
 package docker
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
 )
 
+// containerStartTimeout is how long to wait for a started container to be running
+const containerStartTimeout = 2 * time.Minute
+
+// containerExitLogLines is the number of log lines included in the error of a container that exited on start
+const containerExitLogLines = "20"
+
 func (d *DockerClient) StartProject(project *workspace.Project) error {
-	return d.startProjectContainer(project)
+	err := d.startServiceContainers(project)
+	if err != nil {
+		return err
+	}
+
+	return d.startContainer(d.GetProjectContainerName(project))
 }
 
-func (d *DockerClient) startProjectContainer(project *workspace.Project) error {
-	containerName := d.GetProjectContainerName(project)
-	ctx := context.Background()
+func (d *DockerClient) startContainer(containerName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), containerStartTimeout)
+	defer cancel()
 
 	inspect, err := d.apiClient.ContainerInspect(ctx, containerName)
 
@@ -31,10 +46,12 @@ func (d *DockerClient) startProjectContainer(project *workspace.Project) error {
 	}
 
 	// make sure container is running
-	//	TODO: timeout
 	for {
 		inspect, err := d.apiClient.ContainerInspect(ctx, containerName)
 		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("container %s is not running after %s", containerName, containerStartTimeout)
+			}
 			return err
 		}
 
@@ -42,8 +59,37 @@ func (d *DockerClient) startProjectContainer(project *workspace.Project) error {
 			break
 		}
 
-		time.Sleep(1 * time.Second)
+		if inspect.State.Status == "exited" || inspect.State.Status == "dead" {
+			return fmt.Errorf("container %s exited with code %d: %s", containerName, inspect.State.ExitCode, d.getContainerLogTail(containerName))
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("container %s is not running after %s", containerName, containerStartTimeout)
+		case <-time.After(1 * time.Second):
+		}
 	}
 
 	return nil
 }
+
+// getContainerLogTail returns the last log lines of a container or a note if they can't be read
+func (d *DockerClient) getContainerLogTail(containerName string) string {
+	logs, err := d.apiClient.ContainerLogs(context.Background(), containerName, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Tail:       containerExitLogLines,
+	})
+	if err != nil {
+		return fmt.Sprintf("failed to get the container logs: %s", err)
+	}
+	defer logs.Close()
+
+	var buf bytes.Buffer
+	_, err = stdcopy.StdCopy(&buf, &buf, logs)
+	if err != nil {
+		return fmt.Sprintf("failed to read the container logs: %s", err)
+	}
+
+	return strings.TrimSpace(buf.String())
+}
@@ -4,8 +4,12 @@
 package docker_test
 
 import (
+	"bytes"
+	"io"
+
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -32,3 +36,28 @@ func (s *DockerClientTestSuite) TestStartProject() {
 	err := s.dockerClient.StartProject(project1)
 	require.Nil(s.T(), err)
 }
+
+func (s *DockerClientTestSuite) TestStartProjectExitedContainer() {
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	s.mockClient.On("ContainerStart", mock.Anything, containerName, container.StartOptions{}).Return(nil)
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			State: &types.ContainerState{
+				Status:   "exited",
+				ExitCode: 127,
+			},
+		},
+	}, nil)
+
+	var logs bytes.Buffer
+	stdcopy.NewStdWriter(&logs, stdcopy.Stderr).Write([]byte("sh: entrypoint.sh: not found\n"))
+	s.mockClient.On("ContainerLogs", mock.Anything, containerName, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Tail:       "20",
+	}).Return(io.NopCloser(&logs), nil)
+
+	err := s.dockerClient.StartProject(project1)
+	require.EqualError(s.T(), err, "container "+containerName+" exited with code 127: sh: entrypoint.sh: not found")
+}
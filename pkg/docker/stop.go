@@ -12,11 +12,15 @@ import (
 )
 
 func (d *DockerClient) StopProject(project *workspace.Project) error {
-	return d.stopProjectContainer(project)
+	err := d.stopContainer(d.GetProjectContainerName(project))
+	if err != nil {
+		return err
+	}
+
+	return d.stopServiceContainers(project)
 }
 
-func (d *DockerClient) stopProjectContainer(project *workspace.Project) error {
-	containerName := d.GetProjectContainerName(project)
+func (d *DockerClient) stopContainer(containerName string) error {
 	ctx := context.Background()
 
 	err := d.apiClient.ContainerStop(ctx, containerName, container.StopOptions{})
@@ -16,6 +16,10 @@ type FrpcConnectParams struct {
 	Name         string
 	SubDomain    string
 	Port         int
+	// LocalTLS is set when the local service only accepts HTTPS connections
+	LocalTLS bool
+	// LocalAddr is optional. If set, HTTPS connections to the local service are made to it instead of 127.0.0.1:Port
+	LocalAddr string
 }
 
 type HealthCheckFunc func() error
@@ -33,6 +37,21 @@ func GetService(params FrpcConnectParams) (HealthCheckFunc, *client.Service, err
 	httpConfig.GetBaseConfig().Type = string(v1.ProxyTypeHTTP)
 	httpConfig.SubDomain = params.SubDomain
 
+	if params.LocalTLS {
+		localAddr := params.LocalAddr
+		if localAddr == "" {
+			localAddr = fmt.Sprintf("127.0.0.1:%d", params.Port)
+		}
+
+		httpConfig.Plugin = v1.TypedClientPluginOptions{
+			Type: v1.PluginHTTP2HTTPS,
+			ClientPluginOptions: &v1.HTTP2HTTPSPluginOptions{
+				Type:      v1.PluginHTTP2HTTPS,
+				LocalAddr: localAddr,
+			},
+		}
+	}
+
 	cfg.ProxyCfgs = append(cfg.ProxyCfgs, httpConfig)
 
 	service, err := client.NewService(cfg)
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -129,7 +130,7 @@ func (g *GitnessClient) GetUser() (*UserResponse, error) {
 	return &apiUser, nil
 }
 
-func (g *GitnessClient) GetRepositories(namespace string) ([]Repository, error) {
+func (g *GitnessClient) GetRepositories(namespace string, page, limit int) ([]Repository, error) {
 	space := ""
 	if namespace == personalNamespaceId {
 		user, err := g.GetUser()
@@ -146,6 +147,11 @@ func (g *GitnessClient) GetRepositories(namespace string) ([]Repository, error)
 		return nil, err
 	}
 
+	query := reposURL.Query()
+	query.Set("page", strconv.Itoa(page))
+	query.Set("limit", strconv.Itoa(limit))
+	reposURL.RawQuery = query.Encode()
+
 	body, err := g.performRequest("GET", reposURL.String())
 	if err != nil {
 		return nil, err
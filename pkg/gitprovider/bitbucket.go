@@ -50,8 +50,9 @@ func (g *BitbucketGitProvider) GetNamespaces() ([]*GitNamespace, error) {
 	return namespaces, nil
 }
 
-func (g *BitbucketGitProvider) GetRepositories(namespace string) ([]*GitRepository, error) {
+func (g *BitbucketGitProvider) GetRepositories(namespace string, options ListOptions) ([]*GitRepository, error) {
 	client := g.getApiClient()
+	client.Pagelen = options.PerPage
 	var response []*GitRepository
 
 	if namespace == personalNamespaceId {
@@ -64,7 +65,7 @@ func (g *BitbucketGitProvider) GetRepositories(namespace string) ([]*GitReposito
 
 	repoList, err := client.Repositories.ListForAccount(&bitbucket.RepositoriesOptions{
 		Owner:   namespace,
-		Page:    &[]int{1}[0],
+		Page:    &options.Page,
 		Keyword: nil,
 	})
 	if err != nil {
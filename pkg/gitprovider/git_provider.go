@@ -25,9 +25,15 @@ type StaticGitContext struct {
 	Path     *string `json:"path,omitempty"`
 } // @name StaticGitContext
 
+// ListOptions selects a page of a list returned by a Git provider. Pages start at 1.
+type ListOptions struct {
+	Page    int
+	PerPage int
+}
+
 type GitProvider interface {
 	GetNamespaces() ([]*GitNamespace, error)
-	GetRepositories(namespace string) ([]*GitRepository, error)
+	GetRepositories(namespace string, options ListOptions) ([]*GitRepository, error)
 	GetUser() (*GitUser, error)
 	GetRepoBranches(repositoryId string, namespaceId string) ([]*GitBranch, error)
 	GetRepoPRs(repositoryId string, namespaceId string) ([]*GitPullRequest, error)
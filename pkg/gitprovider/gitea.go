@@ -61,7 +61,7 @@ func (g *GiteaGitProvider) GetNamespaces() ([]*GitNamespace, error) {
 	return namespaces, nil
 }
 
-func (g *GiteaGitProvider) GetRepositories(namespace string) ([]*GitRepository, error) {
+func (g *GiteaGitProvider) GetRepositories(namespace string, options ListOptions) ([]*GitRepository, error) {
 	client, err := g.getApiClient()
 	if err != nil {
 		return nil, err
@@ -77,8 +77,8 @@ func (g *GiteaGitProvider) GetRepositories(namespace string) ([]*GitRepository,
 
 		repoList, _, err = client.ListUserRepos(user.Username, gitea.ListReposOptions{
 			ListOptions: gitea.ListOptions{
-				Page:     1,
-				PageSize: 100,
+				Page:     options.Page,
+				PageSize: options.PerPage,
 			},
 		})
 		if err != nil {
@@ -87,8 +87,8 @@ func (g *GiteaGitProvider) GetRepositories(namespace string) ([]*GitRepository,
 	} else {
 		repoList, _, err = client.ListOrgRepos(namespace, gitea.ListOrgReposOptions{
 			ListOptions: gitea.ListOptions{
-				Page:     1,
-				PageSize: 100,
+				Page:     options.Page,
+				PageSize: options.PerPage,
 			},
 		})
 		if err != nil {
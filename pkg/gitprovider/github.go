@@ -64,7 +64,7 @@ func (g *GitHubGitProvider) GetNamespaces() ([]*GitNamespace, error) {
 	return namespaces, nil
 }
 
-func (g *GitHubGitProvider) GetRepositories(namespace string) ([]*GitRepository, error) {
+func (g *GitHubGitProvider) GetRepositories(namespace string, options ListOptions) ([]*GitRepository, error) {
 	client := g.getApiClient()
 	var response []*GitRepository
 	query := "fork:true "
@@ -81,8 +81,8 @@ func (g *GitHubGitProvider) GetRepositories(namespace string) ([]*GitRepository,
 
 	repoList, _, err := client.Search.Repositories(context.Background(), query, &github.SearchOptions{
 		ListOptions: github.ListOptions{
-			PerPage: 100,
-			Page:    1,
+			PerPage: options.PerPage,
+			Page:    options.Page,
 		},
 	})
 
@@ -57,7 +57,7 @@ func (g *GitLabGitProvider) GetNamespaces() ([]*GitNamespace, error) {
 	return namespaces, nil
 }
 
-func (g *GitLabGitProvider) GetRepositories(namespace string) ([]*GitRepository, error) {
+func (g *GitLabGitProvider) GetRepositories(namespace string, options ListOptions) ([]*GitRepository, error) {
 	client := g.getApiClient()
 	var response []*GitRepository
 	var repoList []*gitlab.Project
@@ -71,8 +71,8 @@ func (g *GitLabGitProvider) GetRepositories(namespace string) ([]*GitRepository,
 
 		repoList, _, err = client.Projects.ListUserProjects(user.Id, &gitlab.ListProjectsOptions{
 			ListOptions: gitlab.ListOptions{
-				PerPage: 100,
-				Page:    1,
+				PerPage: options.PerPage,
+				Page:    options.Page,
 			},
 		})
 		if err != nil {
@@ -81,8 +81,8 @@ func (g *GitLabGitProvider) GetRepositories(namespace string) ([]*GitRepository,
 	} else {
 		repoList, _, err = client.Groups.ListGroupProjects(namespace, &gitlab.ListGroupProjectsOptions{
 			ListOptions: gitlab.ListOptions{
-				PerPage: 100,
-				Page:    1,
+				PerPage: options.PerPage,
+				Page:    options.Page,
 			},
 		})
 		if err != nil {
@@ -51,9 +51,9 @@ func (g *GitnessGitProvider) getApiClient() *gitnessclient.GitnessClient {
 	return gitnessclient.NewGitnessClient(g.token, url)
 }
 
-func (g *GitnessGitProvider) GetRepositories(namespace string) ([]*GitRepository, error) {
+func (g *GitnessGitProvider) GetRepositories(namespace string, options ListOptions) ([]*GitRepository, error) {
 	client := g.getApiClient()
-	response, err := client.GetRepositories(namespace)
+	response, err := client.GetRepositories(namespace, options.Page, options.PerPage)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch Repositories : %w", err)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/cmd/tailscale"
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/ports"
 	"github.com/daytonaio/daytona/pkg/views"
 
@@ -22,7 +23,7 @@ import (
 
 const startVSCodeServerCommand = "$HOME/vscode-server/bin/openvscode-server --start-server --port=63000 --host=0.0.0.0 --without-connection-token --disable-workspace-trust --default-folder=$HOME/$DAYTONA_WS_PROJECT_NAME"
 
-func OpenBrowserIDE(activeProfile config.Profile, workspaceId string, projectName string) error {
+func OpenBrowserIDE(activeProfile config.Profile, workspaceId string, projectName string, customizations *apiclient.ProjectCustomizations) error {
 	// Download and start IDE
 	err := config.EnsureSshConfigEntryAdded(activeProfile.Id, workspaceId, projectName)
 	if err != nil {
@@ -41,6 +42,12 @@ func OpenBrowserIDE(activeProfile config.Profile, workspaceId string, projectNam
 		return err
 	}
 
+	// Customizations are applied before the server starts so it loads the extensions and settings
+	err = setupVSCodeCustomizations(projectHostname, openVSCodeServer, customizations)
+	if err != nil {
+		log.Error(fmt.Sprintf("Failed to apply VS Code customizations: %s", err))
+	}
+
 	views.RenderInfoMessageBold("Starting OpenVSCode Server...")
 
 	go func() {
@@ -0,0 +1,152 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ide
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/tailscale/hujson"
+)
+
+const vscodeServerWaitTimeout = 3 * time.Minute
+
+// extensionIdPattern matches VS Code extension IDs of the form publisher.name with an optional @version
+var extensionIdPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]*\.[a-zA-Z0-9][a-zA-Z0-9-]*(@[a-zA-Z0-9][a-zA-Z0-9.+-]*)?$`)
+
+// vscodeServer describes where a VS Code server is installed in the project
+type vscodeServer struct {
+	// findCliCommand prints the path of the server CLI if the server is installed
+	findCliCommand string
+	settingsPath   string
+	// lockFilePath records that the customizations were applied
+	lockFilePath string
+}
+
+// VS Code installs its server in the project on the first connect
+var vscodeDesktopServer = vscodeServer{
+	findCliCommand: "ls -d $HOME/.vscode-server/bin/*/bin/code-server $HOME/.vscode-server/cli/servers/*/server/bin/code-server 2>/dev/null | head -n 1",
+	settingsPath:   "$HOME/.vscode-server/data/Machine/settings.json",
+	lockFilePath:   "$HOME/.daytona-vscode-customizations.lock",
+}
+
+var openVSCodeServer = vscodeServer{
+	findCliCommand: "ls $HOME/vscode-server/bin/openvscode-server 2>/dev/null",
+	settingsPath:   "$HOME/.openvscode-server/data/Machine/settings.json",
+	lockFilePath:   "$HOME/.daytona-openvscode-customizations.lock",
+}
+
+// setupVSCodeCustomizations installs the extensions and applies the settings of the customizations on the VS Code server of the project.
+// Customizations are only applied once, on the first connect.
+func setupVSCodeCustomizations(projectHostname string, server vscodeServer, customizations *apiclient.ProjectCustomizations) error {
+	if customizations == nil || customizations.Vscode == nil {
+		return nil
+	}
+
+	// Extension IDs are passed to a shell in the project
+	for _, extension := range customizations.Vscode.GetExtensions() {
+		if !extensionIdPattern.MatchString(extension) {
+			return fmt.Errorf("invalid extension ID %q, expected publisher.name[@version]", extension)
+		}
+	}
+
+	_, err := runSshCommand(projectHostname, fmt.Sprintf("test -f %s", server.lockFilePath), nil)
+	if err == nil {
+		return nil
+	}
+
+	views.RenderInfoMessageBold("Applying VS Code customizations...")
+
+	cliPath, err := waitForVSCodeServer(projectHostname, server)
+	if err != nil {
+		return err
+	}
+
+	for _, extension := range customizations.Vscode.GetExtensions() {
+		_, err := runSshCommand(projectHostname, fmt.Sprintf("%s --install-extension '%s'", cliPath, extension), nil)
+		if err != nil {
+			return fmt.Errorf("failed to install extension %s: %w", extension, err)
+		}
+	}
+
+	if len(customizations.Vscode.GetSettings()) > 0 {
+		err = applyVSCodeSettings(projectHostname, server.settingsPath, customizations.Vscode.GetSettings())
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = runSshCommand(projectHostname, fmt.Sprintf("touch %s", server.lockFilePath), nil)
+	return err
+}
+
+func waitForVSCodeServer(projectHostname string, server vscodeServer) (string, error) {
+	timeout := time.After(vscodeServerWaitTimeout)
+
+	for {
+		output, err := runSshCommand(projectHostname, server.findCliCommand, nil)
+		if err == nil {
+			cliPath := strings.TrimSpace(string(output))
+			if cliPath != "" {
+				return cliPath, nil
+			}
+		}
+
+		select {
+		case <-timeout:
+			return "", errors.New("timed out waiting for the VS Code server to be installed")
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// applyVSCodeSettings merges the settings into the machine settings of the VS Code server
+func applyVSCodeSettings(projectHostname, settingsPath string, settings map[string]interface{}) error {
+	existingSettings := map[string]interface{}{}
+
+	output, err := runSshCommand(projectHostname, fmt.Sprintf("cat %s", settingsPath), nil)
+	if err == nil && len(bytes.TrimSpace(output)) > 0 {
+		// settings.json allows comments and trailing commas
+		standardized, err := hujson.Standardize(output)
+		if err != nil {
+			return fmt.Errorf("failed to parse VS Code settings: %w", err)
+		}
+
+		err = json.Unmarshal(standardized, &existingSettings)
+		if err != nil {
+			return fmt.Errorf("failed to parse VS Code settings: %w", err)
+		}
+	}
+
+	for key, value := range settings {
+		existingSettings[key] = value
+	}
+
+	content, err := json.MarshalIndent(existingSettings, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = runSshCommand(projectHostname, fmt.Sprintf("mkdir -p %s && cat > %s", path.Dir(settingsPath), settingsPath), bytes.NewReader(content))
+	return err
+}
+
+func runSshCommand(projectHostname, command string, stdin io.Reader) ([]byte, error) {
+	sshCommand := exec.Command("ssh", projectHostname, command)
+	sshCommand.Stdin = stdin
+	sshCommand.Stderr = io.Writer(&util.DebugLogWriter{})
+
+	return sshCommand.Output()
+}
@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtensionIdPattern(t *testing.T) {
+	for _, extension := range []string{"ms-python.python", "golang.Go", "esbenp.prettier-vscode@10.4.0", "ms-vscode.cpptools@1.20.5-insiders"} {
+		require.True(t, extensionIdPattern.MatchString(extension), extension)
+	}
+
+	for _, extension := range []string{"python", "ms-python.python'; rm -rf ~; '", "ms-python.python --force", "$(id).ext", "ms-python.python@", "-x.y"} {
+		require.False(t, extensionIdPattern.MatchString(extension), extension)
+	}
+}
@@ -9,11 +9,12 @@ import (
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 
 	log "github.com/sirupsen/logrus"
 )
 
-func OpenVSCode(activeProfile config.Profile, workspaceId string, projectName string) error {
+func OpenVSCode(activeProfile config.Profile, workspaceId string, projectName string, customizations *apiclient.ProjectCustomizations) error {
 	checkAndAlertVSCodeInstalled()
 
 	projectHostname := config.GetProjectHostname(activeProfile.Id, workspaceId, projectName)
@@ -27,7 +28,17 @@ func OpenVSCode(activeProfile config.Profile, workspaceId string, projectName st
 
 	var vscCommand *exec.Cmd = exec.Command("code", "--folder-uri", commandArgument)
 
-	return vscCommand.Run()
+	err = vscCommand.Run()
+	if err != nil {
+		return err
+	}
+
+	err = setupVSCodeCustomizations(projectHostname, vscodeDesktopServer, customizations)
+	if err != nil {
+		log.Error(fmt.Sprintf("Failed to apply VS Code customizations: %s", err))
+	}
+
+	return nil
 }
 
 func checkAndAlertVSCodeInstalled() {
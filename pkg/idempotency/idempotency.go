@@ -0,0 +1,33 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"errors"
+	"time"
+)
+
+// IdempotencyKey maps the Idempotency-Key of a request to the resource the request created
+type IdempotencyKey struct {
+	// Key is the hash of the client and the Idempotency-Key header
+	Key string
+	// RequestHash is the hash of the request the key was first used for
+	RequestHash string
+	ResourceId  string
+	ExpiresAt   time.Time
+}
+
+// Store keeps idempotency keys until they expire. Expired keys are never found.
+type Store interface {
+	Find(key string) (*IdempotencyKey, error)
+	Save(idempotencyKey *IdempotencyKey) error
+}
+
+var (
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+)
+
+func IsIdempotencyKeyNotFound(err error) bool {
+	return err.Error() == ErrIdempotencyKeyNotFound.Error()
+}
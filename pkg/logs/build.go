@@ -0,0 +1,96 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+type buildLogger struct {
+	logsDir     string
+	buildId     string
+	workspaceId string
+	projectName string
+	logFile     *os.File
+}
+
+func (bl *buildLogger) Write(p []byte) (n int, err error) {
+	if bl.logFile == nil {
+		filePath := getBuildLogFilePath(bl.logsDir, bl.buildId)
+		err = os.MkdirAll(filepath.Dir(filePath), 0755)
+		if err != nil {
+			return len(p), err
+		}
+
+		logFile, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return len(p), err
+		}
+		bl.logFile = logFile
+	}
+
+	var entry LogEntry
+	entry.Msg = string(p)
+	entry.Source = string(LogSourceBuilder)
+	entry.WorkspaceId = bl.workspaceId
+	entry.ProjectName = bl.projectName
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return len(p), err
+	}
+
+	b = append(b, []byte(LogDelimiter)...)
+
+	_, err = bl.logFile.Write(b)
+	if err != nil {
+		return len(p), err
+	}
+
+	return len(p), nil
+}
+
+func (bl *buildLogger) Close() error {
+	if bl.logFile != nil {
+		err := bl.logFile.Close()
+		bl.logFile = nil
+		return err
+	}
+	return nil
+}
+
+func (bl *buildLogger) Cleanup() error {
+	buildLogsDir := filepath.Dir(getBuildLogFilePath(bl.logsDir, bl.buildId))
+
+	_, err := os.Stat(buildLogsDir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(buildLogsDir)
+}
+
+// CreateBuildLogger creates a logger for the build with the given ID.
+// Build logs are kept separately from project logs so a build can be followed on its own.
+func (l *loggerFactoryImpl) CreateBuildLogger(buildId, workspaceId, projectName string) Logger {
+	return &buildLogger{
+		logsDir:     l.logsDir,
+		buildId:     buildId,
+		workspaceId: workspaceId,
+		projectName: projectName,
+	}
+}
+
+func (l *loggerFactoryImpl) CreateBuildLogReader(buildId string) (io.Reader, error) {
+	return os.Open(getBuildLogFilePath(l.logsDir, buildId))
+}
+
+func getBuildLogFilePath(logsDir, buildId string) string {
+	return filepath.Join(logsDir, "builds", buildId, "log")
+}
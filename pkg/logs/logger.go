@@ -36,6 +36,8 @@ type LoggerFactory interface {
 	CreateProjectLogger(workspaceId, projectName string, source LogSource) Logger
 	CreateWorkspaceLogReader(workspaceId string) (io.Reader, error)
 	CreateProjectLogReader(workspaceId, projectName string) (io.Reader, error)
+	CreateBuildLogger(buildId, workspaceId, projectName string) Logger
+	CreateBuildLogReader(buildId string) (io.Reader, error)
 }
 
 type loggerFactoryImpl struct {
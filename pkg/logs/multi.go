@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import "errors"
+
+type multiLogger struct {
+	loggers []Logger
+}
+
+// NewMultiLogger returns a logger that duplicates its writes to all the given loggers
+func NewMultiLogger(loggers ...Logger) Logger {
+	return &multiLogger{loggers: loggers}
+}
+
+func (ml *multiLogger) Write(p []byte) (n int, err error) {
+	for _, logger := range ml.loggers {
+		_, writeErr := logger.Write(p)
+		err = errors.Join(err, writeErr)
+	}
+	return len(p), err
+}
+
+func (ml *multiLogger) Close() error {
+	var err error
+	for _, logger := range ml.loggers {
+		err = errors.Join(err, logger.Close())
+	}
+	return err
+}
+
+func (ml *multiLogger) Cleanup() error {
+	var err error
+	for _, logger := range ml.loggers {
+		err = errors.Join(err, logger.Cleanup())
+	}
+	return err
+}
@@ -4,18 +4,22 @@
 package os
 
 import (
+	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 )
 
 func DownloadFile(url string, filename string) error {
-	resp, err := http.Get(url)
+	body, err := OpenUrl(url)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
+	defer body.Close()
 
 	err = os.MkdirAll(filepath.Dir(filename), os.ModePerm)
 	if err != nil {
@@ -28,6 +32,46 @@ func DownloadFile(url string, filename string) error {
 	}
 	defer out.Close()
 
-	_, err = io.Copy(out, resp.Body)
+	_, err = io.Copy(out, body)
 	return err
 }
+
+// OpenUrl opens an http(s) or file:// URL for reading
+func OpenUrl(rawUrl string) (io.ReadCloser, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme == "file" {
+		return os.Open(FileUrlToPath(u))
+	}
+
+	resp, err := http.Get(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to download %s: %s", rawUrl, resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
+// FileUrlToPath returns the local path of a file:// URL
+func FileUrlToPath(u *url.URL) string {
+	path := u.Path
+	if u.Host != "" && u.Host != "localhost" {
+		// file://./relative/path
+		path = u.Host + path
+	}
+
+	// file:///C:/path on Windows
+	if runtime.GOOS == "windows" && len(path) > 2 && path[0] == '/' && path[2] == ':' {
+		path = strings.TrimPrefix(path, "/")
+	}
+
+	return filepath.FromSlash(path)
+}
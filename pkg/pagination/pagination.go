@@ -0,0 +1,393 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const NEXT_CURSOR_HEADER = "X-Next-Cursor"
+const TOTAL_COUNT_HEADER = "X-Total-Count"
+
+var (
+	ErrInvalidCursor  = errors.New("invalid cursor")
+	ErrInvalidLimit   = errors.New("invalid limit")
+	ErrInvalidSortKey = errors.New("invalid sort key")
+)
+
+// ListParams holds the pagination, sorting and field selection options of a list request.
+// A zero Limit returns all remaining items.
+type ListParams struct {
+	Limit  int
+	Cursor string
+	// Sort is a JSON field name, prefixed with "-" for descending order
+	Sort   string
+	Fields []string
+}
+
+// Result is a page of items. Total is negative when the number of items is unknown.
+type Result[T any] struct {
+	Items      []T
+	NextCursor string
+	Total      int
+}
+
+// IsInvalidParams returns true if the error is caused by invalid list params of a request
+func IsInvalidParams(err error) bool {
+	return errors.Is(err, ErrInvalidCursor) || errors.Is(err, ErrInvalidLimit) || errors.Is(err, ErrInvalidSortKey)
+}
+
+// SetHeaders sets the pagination headers of a list response
+func SetHeaders[T any](header http.Header, result *Result[T]) {
+	if result.NextCursor != "" {
+		header.Set(NEXT_CURSOR_HEADER, result.NextCursor)
+	}
+
+	if result.Total >= 0 {
+		header.Set(TOTAL_COUNT_HEADER, strconv.Itoa(result.Total))
+	}
+}
+
+func ParseListParams(query url.Values) (ListParams, error) {
+	params := ListParams{
+		Cursor: query.Get("cursor"),
+		Sort:   query.Get("sort"),
+	}
+
+	if limitQuery := query.Get("limit"); limitQuery != "" {
+		limit, err := strconv.Atoi(limitQuery)
+		if err != nil || limit < 0 {
+			return params, ErrInvalidLimit
+		}
+		params.Limit = limit
+	}
+
+	// The content of the cursor is checked by the list that issued it
+	if params.Cursor != "" {
+		_, err := base64.RawURLEncoding.DecodeString(params.Cursor)
+		if err != nil {
+			return params, ErrInvalidCursor
+		}
+	}
+
+	if fieldsQuery := query.Get("fields"); fieldsQuery != "" {
+		for _, field := range strings.Split(fieldsQuery, ",") {
+			field = strings.TrimSpace(field)
+			if field != "" {
+				params.Fields = append(params.Fields, field)
+			}
+		}
+	}
+
+	return params, nil
+}
+
+// itemKey is the position of an item in a sorted list: the value of its sort key and its ID
+type itemKey struct {
+	Value interface{} `json:"value,omitempty"`
+	Id    interface{} `json:"id"`
+}
+
+// EncodeCursor returns an opaque cursor that points after the item with the given sort key value and ID
+func EncodeCursor(value, id interface{}) string {
+	data, _ := json.Marshal(itemKey{Value: value, Id: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string) (*itemKey, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var key itemKey
+	err = json.Unmarshal(decoded, &key)
+	if err != nil || key.Id == nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &key, nil
+}
+
+// EncodePageCursor returns an opaque cursor for a page number of an external list
+func EncodePageCursor(page int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(page)))
+}
+
+func DecodePageCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+
+	page, err := strconv.Atoi(string(decoded))
+	if err != nil || page < 0 {
+		return 0, ErrInvalidCursor
+	}
+
+	return page, nil
+}
+
+// Paginate sorts the items and returns the page described by the params. Items are ordered by the sort key
+// and then by the idField JSON field, which must be unique. The cursor of the next page holds the sort key value
+// and the ID of the last item, so items added or removed in the meantime don't shift the next page.
+// Field selection is not applied; use Project on the returned items.
+func Paginate[T any](items []T, params ListParams, idField string) (*Result[T], error) {
+	keys, err := sortItems(items, params.Sort, idField)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result[T]{
+		Items: []T{},
+		Total: len(items),
+	}
+
+	start := 0
+	if params.Cursor != "" {
+		after, err := decodeCursor(params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		_, descending := parseSortKey(params.Sort)
+		start = sort.Search(len(keys), func(i int) bool {
+			return compareKeys(keys[i], *after, descending) > 0
+		})
+	}
+
+	if start >= len(items) {
+		return result, nil
+	}
+
+	end := len(items)
+	if params.Limit > 0 && start+params.Limit < end {
+		end = start + params.Limit
+		result.NextCursor = EncodeCursor(keys[end-1].Value, keys[end-1].Id)
+	}
+
+	result.Items = items[start:end]
+
+	return result, nil
+}
+
+// Sort sorts the items in place by the value of a top-level JSON field.
+// Items missing the field are placed last. Fields that items of the type don't have are rejected with ErrInvalidSortKey.
+func Sort[T any](items []T, sortKey string) error {
+	if sortKey == "" {
+		return nil
+	}
+
+	_, err := sortItems(items, sortKey, "")
+	return err
+}
+
+// sortItems sorts the items in place by the sort key and then by the ID field and returns their keys in order
+func sortItems[T any](items []T, sortKey string, idField string) ([]itemKey, error) {
+	field, descending := parseSortKey(sortKey)
+	if field != "" {
+		err := validateSortKey[T](field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	keys := make([]itemKey, len(items))
+	for i, item := range items {
+		fields, err := toMap(item)
+		if err != nil {
+			return nil, err
+		}
+		if field != "" {
+			keys[i].Value = fields[field]
+		}
+		if idField != "" {
+			keys[i].Id = fields[idField]
+		}
+	}
+
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return compareKeys(keys[indexes[i]], keys[indexes[j]], descending) < 0
+	})
+
+	sortedItems := make([]T, len(items))
+	sortedKeys := make([]itemKey, len(items))
+	for i, index := range indexes {
+		sortedItems[i] = items[index]
+		sortedKeys[i] = keys[index]
+	}
+	copy(items, sortedItems)
+
+	return sortedKeys, nil
+}
+
+func parseSortKey(sortKey string) (string, bool) {
+	field, descending := strings.CutPrefix(sortKey, "-")
+	return field, descending
+}
+
+// validateSortKey returns ErrInvalidSortKey if the field is not a top-level JSON field of T
+func validateSortKey[T any](field string) error {
+	fields := jsonFields(reflect.TypeOf((*T)(nil)).Elem())
+	// The fields of types other than structs are not known
+	if fields == nil {
+		return nil
+	}
+
+	if !fields[field] {
+		return fmt.Errorf("%w: %s", ErrInvalidSortKey, field)
+	}
+
+	return nil
+}
+
+// jsonFields returns the names of the top-level JSON fields of a struct type or nil for other types
+func jsonFields(t reflect.Type) map[string]bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		// Fields of embedded structs are encoded at the top level
+		if field.Anonymous && name == "" {
+			for embeddedField := range jsonFields(field.Type) {
+				fields[embeddedField] = true
+			}
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = true
+	}
+
+	return fields
+}
+
+// compareKeys orders keys by value and then by ID. Missing values are placed last in both directions.
+func compareKeys(a, b itemKey, descending bool) int {
+	result := compareValues(a.Value, b.Value)
+	if result != 0 {
+		if descending && a.Value != nil && b.Value != nil {
+			return -result
+		}
+		return result
+	}
+
+	return compareValues(a.Id, b.Id)
+}
+
+func compareValues(a, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	case less(a, b):
+		return -1
+	case less(b, a):
+		return 1
+	}
+
+	return 0
+}
+
+// Project returns the items as JSON objects that only contain the given top-level fields.
+// If no fields are given, the items are returned unchanged.
+func Project[T any](items []T, fields []string) (interface{}, error) {
+	if len(fields) == 0 {
+		return items, nil
+	}
+
+	projected := []map[string]interface{}{}
+
+	for _, item := range items {
+		itemFields, err := toMap(item)
+		if err != nil {
+			return nil, err
+		}
+
+		projectedItem := map[string]interface{}{}
+		for _, field := range fields {
+			if value, ok := itemFields[field]; ok {
+				projectedItem[field] = value
+			}
+		}
+
+		projected = append(projected, projectedItem)
+	}
+
+	return projected, nil
+}
+
+func toMap(item interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, fmt.Errorf("item can not be sorted or projected: %w", err)
+	}
+
+	return fields, nil
+}
+
+func less(a, b interface{}) bool {
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.ToLower(a) < strings.ToLower(b)
+		}
+	case float64:
+		if b, ok := b.(float64); ok {
+			return a < b
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			return !a && b
+		}
+	}
+
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
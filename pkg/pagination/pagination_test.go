@@ -0,0 +1,203 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type testItem struct {
+	Name  string `json:"name"`
+	Size  int    `json:"size"`
+	Owner string `json:"owner,omitempty"`
+}
+
+type PaginationTestSuite struct {
+	suite.Suite
+	items []testItem
+}
+
+func NewPaginationTestSuite() *PaginationTestSuite {
+	return &PaginationTestSuite{}
+}
+
+func (s *PaginationTestSuite) SetupTest() {
+	s.items = []testItem{
+		{Name: "charlie", Size: 1, Owner: "daytona"},
+		{Name: "alpha", Size: 3},
+		{Name: "Bravo", Size: 2, Owner: "daytona"},
+	}
+}
+
+func TestPagination(t *testing.T) {
+	suite.Run(t, NewPaginationTestSuite())
+}
+
+func (s *PaginationTestSuite) TestParseListParams() {
+	require := s.Require()
+
+	params, err := ParseListParams(url.Values{
+		"limit":  []string{"10"},
+		"cursor": []string{EncodeCursor("bravo", "2")},
+		"sort":   []string{"-name"},
+		"fields": []string{"name, size,"},
+	})
+
+	require.Nil(err)
+	require.Equal(10, params.Limit)
+	require.Equal("-name", params.Sort)
+	require.Equal([]string{"name", "size"}, params.Fields)
+
+	_, err = ParseListParams(url.Values{"limit": []string{"-1"}})
+	require.Equal(ErrInvalidLimit, err)
+
+	_, err = ParseListParams(url.Values{"cursor": []string{"not a cursor"}})
+	require.Equal(ErrInvalidCursor, err)
+}
+
+func (s *PaginationTestSuite) TestPaginate() {
+	require := s.Require()
+
+	result, err := Paginate(s.items, ListParams{Limit: 2, Sort: "name"}, "name")
+	require.Nil(err)
+	require.Equal(3, result.Total)
+	require.Equal([]testItem{s.items[0], s.items[1]}, result.Items)
+	require.Equal("alpha", result.Items[0].Name)
+	require.Equal("Bravo", result.Items[1].Name)
+	require.NotEmpty(result.NextCursor)
+
+	result, err = Paginate(s.items, ListParams{Limit: 2, Sort: "name", Cursor: result.NextCursor}, "name")
+	require.Nil(err)
+	require.Len(result.Items, 1)
+	require.Equal("charlie", result.Items[0].Name)
+	require.Empty(result.NextCursor)
+}
+
+func (s *PaginationTestSuite) TestPaginateKeepsPositionWhenItemsAreRemoved() {
+	require := s.Require()
+
+	result, err := Paginate(s.items, ListParams{Limit: 1, Sort: "-size"}, "name")
+	require.Nil(err)
+	require.Equal("alpha", result.Items[0].Name)
+
+	// The last item of the page is removed before the next page is requested
+	remaining := []testItem{}
+	for _, item := range s.items {
+		if item.Name != "alpha" {
+			remaining = append(remaining, item)
+		}
+	}
+
+	result, err = Paginate(remaining, ListParams{Limit: 1, Sort: "-size", Cursor: result.NextCursor}, "name")
+	require.Nil(err)
+	require.Equal("Bravo", result.Items[0].Name)
+}
+
+func (s *PaginationTestSuite) TestPaginateOrdersEqualValuesById() {
+	require := s.Require()
+
+	items := []testItem{
+		{Name: "charlie", Owner: "daytona"},
+		{Name: "alpha", Owner: "daytona"},
+		{Name: "bravo", Owner: "daytona"},
+	}
+
+	names := []string{}
+	cursor := ""
+	for {
+		result, err := Paginate(items, ListParams{Limit: 1, Sort: "owner", Cursor: cursor}, "name")
+		require.Nil(err)
+		for _, item := range result.Items {
+			names = append(names, item.Name)
+		}
+		if result.NextCursor == "" {
+			break
+		}
+		cursor = result.NextCursor
+	}
+
+	require.Equal([]string{"alpha", "bravo", "charlie"}, names)
+}
+
+func (s *PaginationTestSuite) TestPaginateWithoutLimit() {
+	require := s.Require()
+
+	result, err := Paginate(s.items, ListParams{}, "name")
+	require.Nil(err)
+	require.Len(result.Items, 3)
+	require.Empty(result.NextCursor)
+
+	result, err = Paginate(s.items, ListParams{Cursor: EncodeCursor(nil, "delta")}, "name")
+	require.Nil(err)
+	require.Empty(result.Items)
+}
+
+func (s *PaginationTestSuite) TestPaginateInvalidParams() {
+	require := s.Require()
+
+	_, err := Paginate(s.items, ListParams{Sort: "color"}, "name")
+	require.ErrorIs(err, ErrInvalidSortKey)
+	require.True(IsInvalidParams(err))
+
+	_, err = Paginate(s.items, ListParams{Cursor: EncodePageCursor(2)}, "name")
+	require.ErrorIs(err, ErrInvalidCursor)
+	require.True(IsInvalidParams(err))
+}
+
+func (s *PaginationTestSuite) TestPageCursor() {
+	require := s.Require()
+
+	page, err := DecodePageCursor(EncodePageCursor(3))
+	require.Nil(err)
+	require.Equal(3, page)
+
+	_, err = DecodePageCursor(EncodeCursor(nil, "alpha"))
+	require.Equal(ErrInvalidCursor, err)
+}
+
+func (s *PaginationTestSuite) TestSortDescending() {
+	require := s.Require()
+
+	err := Sort(s.items, "-size")
+	require.Nil(err)
+	require.Equal([]int{3, 2, 1}, []int{s.items[0].Size, s.items[1].Size, s.items[2].Size})
+}
+
+func (s *PaginationTestSuite) TestSortMissingFieldLast() {
+	require := s.Require()
+
+	err := Sort(s.items, "owner")
+	require.Nil(err)
+	require.Equal("alpha", s.items[2].Name)
+}
+
+func (s *PaginationTestSuite) TestSortInvalidKey() {
+	require := s.Require()
+
+	err := Sort(s.items, "-color")
+	require.ErrorIs(err, ErrInvalidSortKey)
+
+	// Fields that are omitted from every item are still valid
+	err = Sort([]testItem{{Name: "alpha"}}, "owner")
+	require.Nil(err)
+}
+
+func (s *PaginationTestSuite) TestProject() {
+	require := s.Require()
+
+	projected, err := Project(s.items, []string{"name"})
+	require.Nil(err)
+	require.Equal([]map[string]interface{}{
+		{"name": "charlie"},
+		{"name": "alpha"},
+		{"name": "Bravo"},
+	}, projected)
+
+	unchanged, err := Project(s.items, nil)
+	require.Nil(err)
+	require.Equal(s.items, unchanged)
+}
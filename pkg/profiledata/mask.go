@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profiledata
+
+// MaskedBuildSecretValue replaces the values of build secrets in API responses
+const MaskedBuildSecretValue = "********"
+
+// MaskBuildSecrets returns a copy of the profile data with the build secret values replaced by a placeholder
+func (p *ProfileData) MaskBuildSecrets() *ProfileData {
+	masked := *p
+	if p.BuildSecrets == nil {
+		return &masked
+	}
+
+	masked.BuildSecrets = map[string]BuildSecret{}
+	for name, secret := range p.BuildSecrets {
+		if secret.Value != "" {
+			secret.Value = MaskedBuildSecretValue
+		}
+		masked.BuildSecrets[name] = secret
+	}
+
+	return &masked
+}
+
+// UnmaskBuildSecrets restores masked build secret values sent back in the profile data from the existing profile data.
+// Masked secrets without an existing value are removed.
+func (p *ProfileData) UnmaskBuildSecrets(existing *ProfileData) {
+	for name, secret := range p.BuildSecrets {
+		if secret.Value != MaskedBuildSecretValue {
+			continue
+		}
+
+		existingSecret, ok := BuildSecret{}, false
+		if existing != nil {
+			existingSecret, ok = existing.BuildSecrets[name]
+		}
+
+		if !ok {
+			delete(p.BuildSecrets, name)
+			continue
+		}
+
+		secret.Value = existingSecret.Value
+		p.BuildSecrets[name] = secret
+	}
+}
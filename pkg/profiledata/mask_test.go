@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profiledata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskBuildSecrets(t *testing.T) {
+	profileData := &ProfileData{
+		EnvVars: map[string]string{"FOO": "bar"},
+		BuildSecrets: map[string]BuildSecret{
+			"NPM_TOKEN": {Value: "secret", RepositoryPatterns: []string{"github.com/daytonaio/*"}},
+		},
+	}
+
+	masked := profileData.MaskBuildSecrets()
+	require.Equal(t, MaskedBuildSecretValue, masked.BuildSecrets["NPM_TOKEN"].Value)
+	require.Equal(t, []string{"github.com/daytonaio/*"}, masked.BuildSecrets["NPM_TOKEN"].RepositoryPatterns)
+	require.Equal(t, "bar", masked.EnvVars["FOO"])
+	require.Equal(t, "secret", profileData.BuildSecrets["NPM_TOKEN"].Value)
+
+	t.Run("Unmask keeps existing secrets", func(t *testing.T) {
+		req := &ProfileData{
+			BuildSecrets: map[string]BuildSecret{
+				"NPM_TOKEN": {Value: MaskedBuildSecretValue, RepositoryPatterns: []string{"github.com/*"}},
+				"PIP_TOKEN": {Value: "new-secret"},
+				"UNKNOWN":   {Value: MaskedBuildSecretValue},
+			},
+		}
+
+		req.UnmaskBuildSecrets(profileData)
+		require.Equal(t, map[string]BuildSecret{
+			"NPM_TOKEN": {Value: "secret", RepositoryPatterns: []string{"github.com/*"}},
+			"PIP_TOKEN": {Value: "new-secret"},
+		}, req.BuildSecrets)
+	})
+}
@@ -3,6 +3,21 @@
 
 package profiledata
 
+import "regexp"
+
 type ProfileData struct {
 	EnvVars map[string]string `json:"envVars"`
+	// BuildSecrets are keyed by the secret name
+	BuildSecrets map[string]BuildSecret `json:"buildSecrets,omitempty"`
 } // @name ProfileData
+
+// BuildSecret is mounted as a file into project builds and never persisted in the built image
+type BuildSecret struct {
+	Value string `json:"value"`
+	// RepositoryPatterns are glob patterns of repository URLs without the scheme, e.g. github.com/daytonaio/*.
+	// The secret is mounted into builds of matching repositories. Other projects have to reference it by name.
+	RepositoryPatterns []string `json:"repositoryPatterns,omitempty"`
+} // @name BuildSecret
+
+// IsValidBuildSecretName checks if the name can be used as the file name of the mounted secret
+var IsValidBuildSecretName = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`).MatchString
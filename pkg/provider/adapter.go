@@ -0,0 +1,181 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// FromV1 adapts a protocol v1 provider to ProviderV2.
+// v1 providers can't be cancelled, so a call whose context is done returns the context error
+// while the provider finishes the call in the background. Logs are not streamed and
+// DefaultProviderCapabilities are reported as the provider's capabilities.
+func FromV1(p Provider) ProviderV2 {
+	if adapter, ok := p.(*v2Adapter); ok {
+		return adapter.impl
+	}
+	return &v1Adapter{impl: p}
+}
+
+// ToV1 adapts a ProviderV2 to the protocol v1 interface. Calls run without a deadline.
+func ToV1(p ProviderV2) Provider {
+	if adapter, ok := p.(*v1Adapter); ok {
+		return adapter.impl
+	}
+	return &v2Adapter{impl: p}
+}
+
+type v1Adapter struct {
+	impl Provider
+}
+
+func (a *v1Adapter) Initialize(ctx context.Context, req InitializeProviderRequest) (*util.Empty, error) {
+	return withContext(ctx, func() (*util.Empty, error) { return a.impl.Initialize(req) })
+}
+
+func (a *v1Adapter) GetInfo(ctx context.Context) (ProviderInfo, error) {
+	return withContext(ctx, a.impl.GetInfo)
+}
+
+func (a *v1Adapter) GetCapabilities(ctx context.Context) (*ProviderCapabilities, error) {
+	capabilities := DefaultProviderCapabilities
+	return &capabilities, nil
+}
+
+func (a *v1Adapter) GetTargetManifest(ctx context.Context) (*ProviderTargetManifest, error) {
+	return withContext(ctx, a.impl.GetTargetManifest)
+}
+
+func (a *v1Adapter) GetDefaultTargets(ctx context.Context) (*[]ProviderTarget, error) {
+	return withContext(ctx, a.impl.GetDefaultTargets)
+}
+
+func (a *v1Adapter) CreateWorkspace(ctx context.Context, req *WorkspaceRequest) (*util.Empty, error) {
+	return withContext(ctx, func() (*util.Empty, error) { return a.impl.CreateWorkspace(req) })
+}
+
+func (a *v1Adapter) StartWorkspace(ctx context.Context, req *WorkspaceRequest) (*util.Empty, error) {
+	return withContext(ctx, func() (*util.Empty, error) { return a.impl.StartWorkspace(req) })
+}
+
+func (a *v1Adapter) StopWorkspace(ctx context.Context, req *WorkspaceRequest) (*util.Empty, error) {
+	return withContext(ctx, func() (*util.Empty, error) { return a.impl.StopWorkspace(req) })
+}
+
+func (a *v1Adapter) DestroyWorkspace(ctx context.Context, req *WorkspaceRequest) (*util.Empty, error) {
+	return withContext(ctx, func() (*util.Empty, error) { return a.impl.DestroyWorkspace(req) })
+}
+
+func (a *v1Adapter) GetWorkspaceInfo(ctx context.Context, req *WorkspaceRequest) (*workspace.WorkspaceInfo, error) {
+	return withContext(ctx, func() (*workspace.WorkspaceInfo, error) { return a.impl.GetWorkspaceInfo(req) })
+}
+
+func (a *v1Adapter) CreateProject(ctx context.Context, req *ProjectRequest) (*util.Empty, error) {
+	return withContext(ctx, func() (*util.Empty, error) { return a.impl.CreateProject(req) })
+}
+
+func (a *v1Adapter) StartProject(ctx context.Context, req *ProjectRequest) (*util.Empty, error) {
+	return withContext(ctx, func() (*util.Empty, error) { return a.impl.StartProject(req) })
+}
+
+func (a *v1Adapter) StopProject(ctx context.Context, req *ProjectRequest) (*util.Empty, error) {
+	return withContext(ctx, func() (*util.Empty, error) { return a.impl.StopProject(req) })
+}
+
+func (a *v1Adapter) DestroyProject(ctx context.Context, req *ProjectRequest) (*util.Empty, error) {
+	return withContext(ctx, func() (*util.Empty, error) { return a.impl.DestroyProject(req) })
+}
+
+func (a *v1Adapter) GetProjectInfo(ctx context.Context, req *ProjectRequest) (*workspace.ProjectInfo, error) {
+	return withContext(ctx, func() (*workspace.ProjectInfo, error) { return a.impl.GetProjectInfo(req) })
+}
+
+type result[T any] struct {
+	value T
+	err   error
+}
+
+func withContext[T any](ctx context.Context, call func() (T, error)) (T, error) {
+	if err := ctx.Err(); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	done := make(chan result[T], 1)
+	go func() {
+		value, err := call()
+		done <- result[T]{value, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+type v2Adapter struct {
+	impl ProviderV2
+}
+
+func (a *v2Adapter) Initialize(req InitializeProviderRequest) (*util.Empty, error) {
+	return a.impl.Initialize(context.Background(), req)
+}
+
+func (a *v2Adapter) GetInfo() (ProviderInfo, error) {
+	return a.impl.GetInfo(context.Background())
+}
+
+func (a *v2Adapter) GetTargetManifest() (*ProviderTargetManifest, error) {
+	return a.impl.GetTargetManifest(context.Background())
+}
+
+func (a *v2Adapter) GetDefaultTargets() (*[]ProviderTarget, error) {
+	return a.impl.GetDefaultTargets(context.Background())
+}
+
+func (a *v2Adapter) CreateWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	return a.impl.CreateWorkspace(context.Background(), req)
+}
+
+func (a *v2Adapter) StartWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	return a.impl.StartWorkspace(context.Background(), req)
+}
+
+func (a *v2Adapter) StopWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	return a.impl.StopWorkspace(context.Background(), req)
+}
+
+func (a *v2Adapter) DestroyWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	return a.impl.DestroyWorkspace(context.Background(), req)
+}
+
+func (a *v2Adapter) GetWorkspaceInfo(req *WorkspaceRequest) (*workspace.WorkspaceInfo, error) {
+	return a.impl.GetWorkspaceInfo(context.Background(), req)
+}
+
+func (a *v2Adapter) CreateProject(req *ProjectRequest) (*util.Empty, error) {
+	return a.impl.CreateProject(context.Background(), req)
+}
+
+func (a *v2Adapter) StartProject(req *ProjectRequest) (*util.Empty, error) {
+	return a.impl.StartProject(context.Background(), req)
+}
+
+func (a *v2Adapter) StopProject(req *ProjectRequest) (*util.Empty, error) {
+	return a.impl.StopProject(context.Background(), req)
+}
+
+func (a *v2Adapter) DestroyProject(req *ProjectRequest) (*util.Empty, error) {
+	return a.impl.DestroyProject(context.Background(), req)
+}
+
+func (a *v2Adapter) GetProjectInfo(req *ProjectRequest) (*workspace.ProjectInfo, error) {
+	return a.impl.GetProjectInfo(context.Background(), req)
+}
@@ -0,0 +1,373 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformance checks that a provider honors the contract the server's provisioner relies on.
+//
+// Provider authors run the suite from a test in their plugin's repository:
+//
+//	func TestConformance(t *testing.T) {
+//		conformance.Run(t, &MyProvider{}, conformance.Config{
+//			TargetOptions: `{"Region": "eu"}`,
+//		})
+//	}
+//
+// The provider is served over go-plugin RPC the same way the server talks to installed providers,
+// so requests and responses also have to survive serialization.
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/docker/docker/pkg/stringid"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+)
+
+const pluginName = "provider"
+
+const defaultTimeout = 5 * time.Minute
+
+type Config struct {
+	// JSON encoded target options sent with every request
+	TargetOptions string
+	// TargetName is the name of the target the options belong to
+	TargetName string
+	// Initialize is sent to the provider before the suite runs. The provider isn't initialized if nil.
+	Initialize *provider.InitializeProviderRequest
+	// Project is the template of the projects created by the suite.
+	// Name, WorkspaceId and Target are set by the suite. Defaults to the daytona repository in the default project image.
+	Project *workspace.Project
+	// Timeout bounds every provider call. Defaults to 5 minutes.
+	Timeout time.Duration
+}
+
+// Run serves the provider over plugin protocol v2 and runs the conformance suite against it
+func Run(t *testing.T, impl provider.ProviderV2, config Config) {
+	raw := dispense(t, &provider.ProviderPluginV2{Impl: impl})
+	runSuite(t, raw.(provider.ProviderV2), config)
+}
+
+// RunV1 serves the provider over plugin protocol v1 and runs the conformance suite against it
+func RunV1(t *testing.T, impl provider.Provider, config Config) {
+	raw := dispense(t, &provider.ProviderPlugin{Impl: impl})
+	runSuite(t, provider.FromV1(raw.(provider.Provider)), config)
+}
+
+func dispense(t *testing.T, p plugin.Plugin) interface{} {
+	client, _ := plugin.TestPluginRPCConn(t, map[string]plugin.Plugin{pluginName: p}, nil)
+	t.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense(pluginName)
+	require.NoError(t, err)
+
+	return raw
+}
+
+type suite struct {
+	provider     provider.ProviderV2
+	config       Config
+	capabilities provider.ProviderCapabilities
+}
+
+func runSuite(t *testing.T, p provider.ProviderV2, config Config) {
+	if config.Timeout == 0 {
+		config.Timeout = defaultTimeout
+	}
+
+	if config.TargetOptions == "" {
+		config.TargetOptions = "{}"
+	}
+
+	if config.TargetName == "" {
+		config.TargetName = "conformance"
+	}
+
+	if config.Project == nil {
+		config.Project = &workspace.Project{
+			Image: "daytonaio/workspace-project:latest",
+			User:  "daytona",
+			Repository: &gitprovider.GitRepository{
+				Id:   "daytona",
+				Url:  "https://github.com/daytonaio/daytona",
+				Name: "daytona",
+			},
+		}
+	}
+
+	s := &suite{provider: p, config: config}
+
+	if config.Initialize != nil {
+		ctx, cancel := s.context()
+		_, err := p.Initialize(ctx, *config.Initialize)
+		cancel()
+		require.NoError(t, err, "Initialize")
+	}
+
+	// Stop sequences are only checked if the provider supports them
+	ctx, cancel := s.context()
+	capabilities, err := p.GetCapabilities(ctx)
+	cancel()
+	require.NoError(t, err, "GetCapabilities")
+	require.NotNil(t, capabilities, "GetCapabilities")
+	s.capabilities = *capabilities
+
+	t.Run("GetInfo", s.testGetInfo)
+	t.Run("GetTargetManifest", s.testGetTargetManifest)
+	t.Run("GetDefaultTargets", s.testGetDefaultTargets)
+	t.Run("Lifecycle", s.testLifecycle)
+	t.Run("Idempotency", s.testIdempotency)
+	t.Run("Errors", s.testErrors)
+}
+
+func (s *suite) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), s.config.Timeout)
+}
+
+func (s *suite) testGetInfo(t *testing.T) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	info, err := s.provider.GetInfo(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, info.Name, "provider name")
+	require.NotEmpty(t, info.Version, "provider version")
+}
+
+func (s *suite) testGetTargetManifest(t *testing.T) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	manifest, err := s.provider.GetTargetManifest(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, manifest)
+
+	for name, property := range *manifest {
+		if property.Type == provider.ProviderTargetPropertyTypeOption {
+			require.NotEmpty(t, property.Options, "options of property %s", name)
+		}
+	}
+
+	require.NoError(t, manifest.ValidateOptions(s.config.TargetName, s.config.TargetOptions), "configured target options")
+}
+
+func (s *suite) testGetDefaultTargets(t *testing.T) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	info, err := s.provider.GetInfo(ctx)
+	require.NoError(t, err)
+
+	manifest, err := s.provider.GetTargetManifest(ctx)
+	require.NoError(t, err)
+
+	targets, err := s.provider.GetDefaultTargets(ctx)
+	require.NoError(t, err)
+	if targets == nil {
+		return
+	}
+
+	for _, target := range *targets {
+		require.NotEmpty(t, target.Name)
+		require.Equal(t, info.Name, target.ProviderInfo.Name, "provider of default target %s", target.Name)
+		require.NoError(t, manifest.ValidateOptions(target.Name, target.Options), "options of default target %s", target.Name)
+	}
+}
+
+func (s *suite) testLifecycle(t *testing.T) {
+	w := s.newWorkspace()
+	project := w.Projects[0]
+	defer s.cleanup(w)
+
+	s.call(t, "CreateWorkspace", func(ctx context.Context) error { return s.createWorkspace(ctx, w) })
+	s.call(t, "CreateProject", func(ctx context.Context) error { return s.createProject(ctx, project) })
+	s.call(t, "StartWorkspace", func(ctx context.Context) error { return s.startWorkspace(ctx, w) })
+	s.call(t, "StartProject", func(ctx context.Context) error { return s.startProject(ctx, project) })
+
+	s.requireRunning(t, w, true)
+
+	if s.capabilities.StopWithoutDestroy {
+		s.call(t, "StopProject", func(ctx context.Context) error { return s.stopProject(ctx, project) })
+		s.call(t, "StopWorkspace", func(ctx context.Context) error { return s.stopWorkspace(ctx, w) })
+
+		s.requireRunning(t, w, false)
+
+		s.call(t, "StartWorkspace", func(ctx context.Context) error { return s.startWorkspace(ctx, w) })
+		s.call(t, "StartProject", func(ctx context.Context) error { return s.startProject(ctx, project) })
+
+		s.requireRunning(t, w, true)
+	}
+
+	s.call(t, "DestroyProject", func(ctx context.Context) error { return s.destroyProject(ctx, project) })
+	s.call(t, "DestroyWorkspace", func(ctx context.Context) error { return s.destroyWorkspace(ctx, w) })
+}
+
+func (s *suite) testIdempotency(t *testing.T) {
+	w := s.newWorkspace()
+	project := w.Projects[0]
+	defer s.cleanup(w)
+
+	s.call(t, "CreateWorkspace", func(ctx context.Context) error { return s.createWorkspace(ctx, w) })
+	s.call(t, "CreateProject", func(ctx context.Context) error { return s.createProject(ctx, project) })
+
+	for i := 0; i < 2; i++ {
+		s.call(t, "StartWorkspace", func(ctx context.Context) error { return s.startWorkspace(ctx, w) })
+		s.call(t, "StartProject", func(ctx context.Context) error { return s.startProject(ctx, project) })
+	}
+	s.requireRunning(t, w, true)
+
+	if s.capabilities.StopWithoutDestroy {
+		for i := 0; i < 2; i++ {
+			s.call(t, "StopProject", func(ctx context.Context) error { return s.stopProject(ctx, project) })
+			s.call(t, "StopWorkspace", func(ctx context.Context) error { return s.stopWorkspace(ctx, w) })
+		}
+		s.requireRunning(t, w, false)
+	}
+
+	for i := 0; i < 2; i++ {
+		s.call(t, "DestroyProject", func(ctx context.Context) error { return s.destroyProject(ctx, project) })
+		s.call(t, "DestroyWorkspace", func(ctx context.Context) error { return s.destroyWorkspace(ctx, w) })
+	}
+}
+
+func (s *suite) testErrors(t *testing.T) {
+	w := s.newWorkspace()
+	project := w.Projects[0]
+	defer s.cleanup(w)
+
+	s.call(t, "CreateWorkspace", func(ctx context.Context) error { return s.createWorkspace(ctx, w) })
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	require.Error(t, s.startProject(ctx, project), "StartProject of a project that wasn't created")
+
+	_, err := s.provider.GetProjectInfo(ctx, s.projectRequest(project))
+	require.Error(t, err, "GetProjectInfo of a project that wasn't created")
+}
+
+// requireRunning checks that the project infos returned by GetProjectInfo and GetWorkspaceInfo agree
+func (s *suite) requireRunning(t *testing.T, w *workspace.Workspace, running bool) {
+	t.Helper()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	workspaceInfo, err := s.provider.GetWorkspaceInfo(ctx, s.workspaceRequest(w))
+	require.NoError(t, err, "GetWorkspaceInfo")
+	require.NotNil(t, workspaceInfo)
+	require.Equal(t, w.Name, workspaceInfo.Name, "workspace info name")
+
+	for _, project := range w.Projects {
+		projectInfo, err := s.provider.GetProjectInfo(ctx, s.projectRequest(project))
+		require.NoError(t, err, "GetProjectInfo")
+		require.NotNil(t, projectInfo)
+		require.Equal(t, project.Name, projectInfo.Name, "project info name")
+		require.Equal(t, w.Id, projectInfo.WorkspaceId, "project info workspace id")
+		require.Equal(t, running, projectInfo.IsRunning, "project info running state")
+
+		var found *workspace.ProjectInfo
+		for _, info := range workspaceInfo.Projects {
+			if info != nil && info.Name == project.Name {
+				found = info
+			}
+		}
+		require.NotNil(t, found, "workspace info is missing project %s", project.Name)
+		require.Equal(t, projectInfo.IsRunning, found.IsRunning, "running state of project %s in workspace info", project.Name)
+		require.Equal(t, projectInfo.WorkspaceId, found.WorkspaceId, "workspace id of project %s in workspace info", project.Name)
+	}
+}
+
+func (s *suite) call(t *testing.T, method string, fn func(ctx context.Context) error) {
+	t.Helper()
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	require.NoError(t, fn(ctx), method)
+}
+
+// cleanup destroys what a failed test left behind
+func (s *suite) cleanup(w *workspace.Workspace) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	for _, project := range w.Projects {
+		_ = s.destroyProject(ctx, project)
+	}
+	_ = s.destroyWorkspace(ctx, w)
+}
+
+func (s *suite) newWorkspace() *workspace.Workspace {
+	id := "conformance-" + stringid.TruncateID(stringid.GenerateRandomID())
+
+	project := *s.config.Project
+	project.Name = "project"
+	project.WorkspaceId = id
+	project.Target = s.config.TargetName
+
+	return &workspace.Workspace{
+		Id:       id,
+		Name:     id,
+		Target:   s.config.TargetName,
+		Projects: []*workspace.Project{&project},
+	}
+}
+
+func (s *suite) workspaceRequest(w *workspace.Workspace) *provider.WorkspaceRequest {
+	return &provider.WorkspaceRequest{
+		TargetOptions: s.config.TargetOptions,
+		Workspace:     w,
+	}
+}
+
+func (s *suite) projectRequest(project *workspace.Project) *provider.ProjectRequest {
+	return &provider.ProjectRequest{
+		TargetOptions: s.config.TargetOptions,
+		Project:       project,
+	}
+}
+
+func (s *suite) createWorkspace(ctx context.Context, w *workspace.Workspace) error {
+	_, err := s.provider.CreateWorkspace(ctx, s.workspaceRequest(w))
+	return err
+}
+
+func (s *suite) startWorkspace(ctx context.Context, w *workspace.Workspace) error {
+	_, err := s.provider.StartWorkspace(ctx, s.workspaceRequest(w))
+	return err
+}
+
+func (s *suite) stopWorkspace(ctx context.Context, w *workspace.Workspace) error {
+	_, err := s.provider.StopWorkspace(ctx, s.workspaceRequest(w))
+	return err
+}
+
+func (s *suite) destroyWorkspace(ctx context.Context, w *workspace.Workspace) error {
+	_, err := s.provider.DestroyWorkspace(ctx, s.workspaceRequest(w))
+	return err
+}
+
+func (s *suite) createProject(ctx context.Context, project *workspace.Project) error {
+	_, err := s.provider.CreateProject(ctx, s.projectRequest(project))
+	return err
+}
+
+func (s *suite) startProject(ctx context.Context, project *workspace.Project) error {
+	_, err := s.provider.StartProject(ctx, s.projectRequest(project))
+	return err
+}
+
+func (s *suite) stopProject(ctx context.Context, project *workspace.Project) error {
+	_, err := s.provider.StopProject(ctx, s.projectRequest(project))
+	return err
+}
+
+func (s *suite) destroyProject(ctx context.Context, project *workspace.Project) error {
+	_, err := s.provider.DestroyProject(ctx, s.projectRequest(project))
+	return err
+}
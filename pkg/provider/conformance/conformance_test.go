@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/internal/testing/provider/fake"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provider/conformance"
+)
+
+func TestFakeProvider(t *testing.T) {
+	config := conformance.Config{
+		TargetName:    "fake",
+		TargetOptions: `{"Region": "us", "Token": "secret"}`,
+	}
+
+	t.Run("Protocol v2", func(t *testing.T) {
+		conformance.Run(t, fake.NewFakeProvider(), config)
+	})
+
+	t.Run("Protocol v1", func(t *testing.T) {
+		conformance.RunV1(t, provider.ToV1(fake.NewFakeProvider()), config)
+	})
+
+	t.Run("Without stop support", func(t *testing.T) {
+		p := fake.NewFakeProvider()
+		p.SetCapabilities(provider.ProviderCapabilities{})
+
+		conformance.Run(t, p, config)
+	})
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+)
+
+type logWriterKey struct{}
+
+// WithLogWriter returns a context whose provider calls stream their log output to w
+func WithLogWriter(ctx context.Context, w io.Writer) context.Context {
+	return context.WithValue(ctx, logWriterKey{}, w)
+}
+
+// LogWriter returns the writer providers should write progress and log lines of a call to.
+// Output is discarded if the caller doesn't stream logs.
+func LogWriter(ctx context.Context) io.Writer {
+	if w, ok := getLogWriter(ctx); ok {
+		return w
+	}
+	return io.Discard
+}
+
+func getLogWriter(ctx context.Context) (io.Writer, bool) {
+	w, ok := ctx.Value(logWriterKey{}).(io.Writer)
+	return w, ok && w != nil
+}
@@ -7,26 +7,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
+	"net/url"
 	goos "os"
 	"path/filepath"
 	"runtime"
 
 	"github.com/daytonaio/daytona/pkg/os"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/mod/semver"
 )
 
-func (m *ProviderManager) GetProvidersManifest() (*ProvidersManifest, error) {
-	manifestUrl := fmt.Sprintf("%s/providers/manifest.json", m.registryUrl)
+// STAGED_PROVIDER_SUFFIX is appended to provider binaries that are being downloaded or verified
+const STAGED_PROVIDER_SUFFIX = ".download"
 
-	resp, err := http.Get(manifestUrl)
+func (m *ProviderManager) GetProvidersManifest() (*ProvidersManifest, error) {
+	body, err := os.OpenUrl(m.getManifestUrl())
 	if err != nil {
 		return nil, err
 	}
 
-	defer resp.Body.Close()
+	defer body.Close()
 
-	manifestJson, err := io.ReadAll(resp.Body)
+	manifestJson, err := io.ReadAll(body)
 	if err != nil {
 		return nil, err
 	}
@@ -40,11 +42,10 @@ func (m *ProviderManager) GetProvidersManifest() (*ProvidersManifest, error) {
 	return &manifest, nil
 }
 
-func (m *ProviderManager) DownloadProvider(downloadUrls map[os.OperatingSystem]string, providerName string, throwIfPresent bool) (string, error) {
-	downloadPath := filepath.Join(m.baseDir, providerName, providerName)
-	if runtime.GOOS == "windows" {
-		downloadPath += ".exe"
-	}
+// DownloadProvider downloads the provider binary for the current OS and verifies it against
+// the checksum and signature from the manifest before moving it into the providers directory
+func (m *ProviderManager) DownloadProvider(version Version, providerName string, throwIfPresent bool, allowUnsigned bool) (string, error) {
+	downloadPath := m.getProviderPath(providerName)
 
 	if _, err := goos.Stat(downloadPath); err == nil {
 		if throwIfPresent {
@@ -53,17 +54,156 @@ func (m *ProviderManager) DownloadProvider(downloadUrls map[os.OperatingSystem]s
 		return "", nil
 	}
 
+	stagedPath, err := m.stageProvider(providerName, func(stagedPath string) error {
+		return m.downloadVerified(providerName, version, stagedPath, allowUnsigned)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to install provider %s: %w", providerName, err)
+	}
+
+	err = goos.Rename(stagedPath, downloadPath)
+	if err != nil {
+		goos.Remove(stagedPath)
+		return "", err
+	}
+
+	return downloadPath, nil
+}
+
+// InstallProvider installs a version of a provider and registers it, replacing the running version.
+// Versions that were installed before are reused from the providers directory, others are downloaded
+// from the manifest and verified.
+func (m *ProviderManager) InstallProvider(providerName string, versionName string, version Version, allowUnsigned bool) error {
+	stagedPath, err := m.stageProvider(providerName, func(stagedPath string) error {
+		if semver.IsValid(versionName) {
+			versionPath := m.getVersionPath(providerName, versionName)
+			if _, err := goos.Stat(versionPath); err == nil {
+				log.Infof("Installing %s %s from installed versions", providerName, versionName)
+				return copyFile(versionPath, stagedPath)
+			}
+		}
+
+		return m.downloadVerified(providerName, version, stagedPath, allowUnsigned)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to install provider %s: %w", providerName, err)
+	}
+
+	return m.activateProvider(providerName, stagedPath)
+}
+
+// InstallProviderBinary installs and registers a provider binary that was uploaded to the server.
+// The binary is verified against the checksum and signature like downloaded providers, unless allowUnsigned is set.
+func (m *ProviderManager) InstallProviderBinary(binary io.Reader, providerName string, checksum string, signature string, allowUnsigned bool) error {
+	stagedPath, err := m.stageProvider(providerName, func(stagedPath string) error {
+		out, err := goos.Create(stagedPath)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(out, binary)
+		out.Close()
+		if err != nil {
+			return err
+		}
+
+		return verifyProvider(stagedPath, checksum, signature, m.publicKey, allowUnsigned)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save provider %s: %w", providerName, err)
+	}
+
+	return m.activateProvider(providerName, stagedPath)
+}
+
+func (m *ProviderManager) downloadVerified(providerName string, version Version, path string, allowUnsigned bool) error {
 	log.Info("Downloading " + providerName)
 
 	operatingSystem, err := os.GetOperatingSystem()
 	if err != nil {
+		return err
+	}
+
+	downloadUrl, ok := version.DownloadUrls[*operatingSystem]
+	if !ok {
+		return fmt.Errorf("provider %s is not available for %s", providerName, *operatingSystem)
+	}
+
+	downloadUrl, err = m.resolveDownloadUrl(downloadUrl)
+	if err != nil {
+		return err
+	}
+
+	err = os.DownloadFile(downloadUrl, path)
+	if err != nil {
+		return err
+	}
+
+	return verifyProvider(path, version.Checksums[*operatingSystem], version.Signatures[*operatingSystem], m.publicKey, allowUnsigned)
+}
+
+// stageProvider lets write create and verify a provider binary at a staging path next to the provider.
+// The staged binary is removed if write fails, so failed or unverified binaries are never registered.
+func (m *ProviderManager) stageProvider(providerName string, write func(stagedPath string) error) (string, error) {
+	providerPath := m.getProviderPath(providerName)
+	stagedPath := providerPath + STAGED_PROVIDER_SUFFIX
+
+	err := goos.MkdirAll(filepath.Dir(stagedPath), 0755)
+	if err == nil {
+		err = write(stagedPath)
+	}
+
+	if err != nil {
+		goos.Remove(stagedPath)
+		// Remove the provider directory if it was left empty so it isn't picked up on registration
+		goos.Remove(filepath.Dir(providerPath))
 		return "", err
 	}
 
-	err = os.DownloadFile(downloadUrls[*operatingSystem], downloadPath)
+	return stagedPath, nil
+}
+
+// activateProvider stops the running version of a provider, replaces its binary with the staged one and registers it
+func (m *ProviderManager) activateProvider(providerName string, stagedPath string) error {
+	m.stopProvider(providerName)
+
+	providerPath := m.getProviderPath(providerName)
+	err := goos.Rename(stagedPath, providerPath)
+	if err != nil {
+		goos.Remove(stagedPath)
+		return err
+	}
+
+	return m.RegisterProvider(providerPath)
+}
+
+func (m *ProviderManager) getProviderPath(providerName string) string {
+	providerPath := filepath.Join(m.baseDir, providerName, providerName)
+	if runtime.GOOS == "windows" {
+		providerPath += ".exe"
+	}
+	return providerPath
+}
+
+func (m *ProviderManager) getManifestUrl() string {
+	return fmt.Sprintf("%s/providers/manifest.json", m.registryUrl)
+}
+
+// resolveDownloadUrl resolves download URLs relative to the manifest, which is how mirrored registries reference their binaries
+func (m *ProviderManager) resolveDownloadUrl(downloadUrl string) (string, error) {
+	ref, err := url.Parse(downloadUrl)
 	if err != nil {
 		return "", err
 	}
 
-	return downloadPath, nil
+	if ref.IsAbs() {
+		return downloadUrl, nil
+	}
+
+	base, err := url.Parse(m.getManifestUrl())
+	if err != nil {
+		return "", err
+	}
+
+	return base.ResolveReference(ref).String(), nil
 }
@@ -4,12 +4,18 @@
 package manager
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/daytonaio/daytona/internal"
 	"github.com/daytonaio/daytona/internal/util"
@@ -23,11 +29,21 @@ import (
 )
 
 type pluginRef struct {
-	client *plugin.Client
-	path   string
-	name   string
+	client       *plugin.Client
+	path         string
+	pluginPath   string
+	name         string
+	version      string
+	capabilities ProviderCapabilities
+	state        ProviderState
+	lastError    string
+	// stop is closed when the provider is uninstalled to stop its supervisor
+	stop chan struct{}
 }
 
+// ProviderHandshakeConfig is the handshake of provider plugins. Its ProtocolVersion is the version
+// assumed for providers that don't negotiate one. Providers served with VersionedPlugins negotiate
+// the highest protocol version both sides support.
 var ProviderHandshakeConfig = plugin.HandshakeConfig{
 	ProtocolVersion:  1,
 	MagicCookieKey:   "DAYTONA_PROVIDER_PLUGIN",
@@ -35,11 +51,18 @@ var ProviderHandshakeConfig = plugin.HandshakeConfig{
 }
 
 type IProviderManager interface {
-	DownloadProvider(downloadUrls map[os_util.OperatingSystem]string, providerName string, throwIfPresent bool) (string, error)
+	DownloadProvider(version Version, providerName string, throwIfPresent bool, allowUnsigned bool) (string, error)
 	GetProvider(name string) (*Provider, error)
+	GetProviderCapabilities(name string) (*ProviderCapabilities, error)
+	GetProviderV2(name string) (ProviderV2, error)
 	GetProviders() map[string]Provider
+	GetProvidersHealth() []ProviderHealth
+	GetInstalledVersions(providerName string) ([]string, error)
 	GetProvidersManifest() (*ProvidersManifest, error)
+	InstallProvider(providerName string, versionName string, version Version, allowUnsigned bool) error
+	InstallProviderBinary(binary io.Reader, providerName string, checksum string, signature string, allowUnsigned bool) error
 	RegisterProvider(pluginPath string) error
+	RollbackProvider(providerName string) (string, error)
 	TerminateProviderProcesses(providersBasePath string) error
 	UninstallProvider(name string) error
 }
@@ -55,6 +78,8 @@ type ProviderManagerConfig struct {
 	CreateProviderNetworkKey func(providerName string) (string, error)
 	ServerPort               uint32
 	ApiPort                  uint32
+	// PublicKey is the base64 encoded Ed25519 key used to verify downloaded providers
+	PublicKey string
 }
 
 func NewProviderManager(config ProviderManagerConfig) *ProviderManager {
@@ -70,10 +95,12 @@ func NewProviderManager(config ProviderManagerConfig) *ProviderManager {
 		createProviderNetworkKey: config.CreateProviderNetworkKey,
 		serverPort:               config.ServerPort,
 		apiPort:                  config.ApiPort,
+		publicKey:                config.PublicKey,
 	}
 }
 
 type ProviderManager struct {
+	mutex                    sync.RWMutex
 	pluginRefs               map[string]*pluginRef
 	daytonaDownloadUrl       string
 	serverUrl                string
@@ -85,34 +112,112 @@ type ProviderManager struct {
 	registryUrl              string
 	baseDir                  string
 	createProviderNetworkKey func(providerName string) (string, error)
+	publicKey                string
 }
 
 func (m *ProviderManager) GetProvider(name string) (*Provider, error) {
-	pluginRef, ok := m.pluginRefs[name]
-	if !ok {
-		return nil, errors.New("provider not found")
+	p, err := m.GetProviderV2(name)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := ToV1(p)
+	return &provider, nil
+}
+
+// GetProviderV2 returns the provider through the protocol v2 interface.
+// Providers that only implement protocol v1 are adapted.
+func (m *ProviderManager) GetProviderV2(name string) (ProviderV2, error) {
+	pluginRef, err := m.getPluginRef(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var p ProviderV2
+	if pluginRef.client.Exited() {
+		err = errors.New("provider process exited")
+	} else {
+		p, err = m.dispenseProvider(pluginRef.client, name)
 	}
 
-	p, err := m.dispenseProvider(pluginRef.client, name)
 	if err != nil {
 		// Attempt to reinitialize the provider
 		pluginRef.client.Kill()
-		pluginRef, err := m.initializeProvider(filepath.Join(pluginRef.path, name))
+		newPluginRef, err := m.initializeProvider(pluginRef.pluginPath)
 		if err != nil {
+			m.setProviderState(pluginRef, ProviderStateFailed, err)
 			return nil, err
 		}
 
-		m.pluginRefs[name] = pluginRef
+		if !m.replacePluginRef(pluginRef, newPluginRef) {
+			// The supervisor restarted the provider in the meantime
+			currentPluginRef, err := m.getPluginRef(name)
+			if err != nil {
+				return nil, err
+			}
+			return m.dispenseProvider(currentPluginRef.client, name)
+		}
 
-		return m.dispenseProvider(pluginRef.client, name)
+		return m.dispenseProvider(newPluginRef.client, name)
 	}
 
 	return p, nil
 }
 
+// getPluginRef returns the plugin of the provider. While the supervisor restarts the provider,
+// it waits for the restart to finish for up to restartWaitTimeout.
+func (m *ProviderManager) getPluginRef(name string) (*pluginRef, error) {
+	timeout := time.After(restartWaitTimeout)
+
+	for {
+		m.mutex.RLock()
+		pluginRef, ok := m.pluginRefs[name]
+		var state ProviderState
+		if ok {
+			state = pluginRef.state
+		}
+		m.mutex.RUnlock()
+
+		if !ok {
+			return nil, errors.New("provider not found")
+		}
+
+		if state != ProviderStateRestarting {
+			return pluginRef, nil
+		}
+
+		select {
+		case <-timeout:
+			return nil, fmt.Errorf("provider %s is still restarting after %s", name, restartWaitTimeout)
+		case <-time.After(restartWaitInterval):
+		}
+	}
+}
+
+// GetProviderCapabilities returns the capabilities the provider reported when it was initialized
+func (m *ProviderManager) GetProviderCapabilities(name string) (*ProviderCapabilities, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	pluginRef, ok := m.pluginRefs[name]
+	if !ok {
+		return nil, errors.New("provider not found")
+	}
+
+	capabilities := pluginRef.capabilities
+	return &capabilities, nil
+}
+
 func (m *ProviderManager) GetProviders() map[string]Provider {
-	providers := make(map[string]Provider)
+	m.mutex.RLock()
+	names := []string{}
 	for name := range m.pluginRefs {
+		names = append(names, name)
+	}
+	m.mutex.RUnlock()
+
+	providers := make(map[string]Provider)
+	for _, name := range names {
 		provider, err := m.GetProvider(name)
 		if err != nil {
 			log.Printf("Error getting provider %s: %s", name, err)
@@ -125,13 +230,50 @@ func (m *ProviderManager) GetProviders() map[string]Provider {
 	return providers
 }
 
+// GetProvidersHealth returns the health of all registered providers, sorted by name
+func (m *ProviderManager) GetProvidersHealth() []ProviderHealth {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	health := []ProviderHealth{}
+	for _, pluginRef := range m.pluginRefs {
+		health = append(health, ProviderHealth{
+			Name:      pluginRef.name,
+			Version:   pluginRef.version,
+			State:     pluginRef.state,
+			LastError: pluginRef.lastError,
+		})
+	}
+
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].Name < health[j].Name
+	})
+
+	return health
+}
+
 func (m *ProviderManager) RegisterProvider(pluginPath string) error {
 	pluginRef, err := m.initializeProvider(pluginPath)
 	if err != nil {
 		return err
 	}
 
+	pluginRef.stop = make(chan struct{})
+
+	m.mutex.Lock()
+	if existingPluginRef, ok := m.pluginRefs[pluginRef.name]; ok {
+		close(existingPluginRef.stop)
+		existingPluginRef.client.Kill()
+	}
 	m.pluginRefs[pluginRef.name] = pluginRef
+	m.mutex.Unlock()
+
+	go m.superviseProvider(pluginRef.name, pluginRef.stop)
+
+	err = m.keepVersion(pluginRef)
+	if err != nil {
+		log.Errorf("Failed to keep %s %s for rollbacks: %s", pluginRef.name, pluginRef.version, err)
+	}
 
 	p, err := m.dispenseProvider(pluginRef.client, pluginRef.name)
 	if err != nil {
@@ -143,7 +285,7 @@ func (m *ProviderManager) RegisterProvider(pluginPath string) error {
 		return errors.New("failed to get targets: " + err.Error())
 	}
 
-	defaultTargets, err := (*p).GetDefaultTargets()
+	defaultTargets, err := p.GetDefaultTargets(context.Background())
 	if err != nil {
 		return errors.New("failed to get default targets: " + err.Error())
 	}
@@ -170,10 +312,14 @@ func (m *ProviderManager) RegisterProvider(pluginPath string) error {
 }
 
 func (m *ProviderManager) UninstallProvider(name string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	pluginRef, ok := m.pluginRefs[name]
 	if !ok {
 		return errors.New("provider not found")
 	}
+	close(pluginRef.stop)
 	pluginRef.client.Kill()
 
 	err := os.RemoveAll(pluginRef.path)
@@ -186,6 +332,21 @@ func (m *ProviderManager) UninstallProvider(name string) error {
 	return nil
 }
 
+// stopProvider stops a running provider without removing it from the providers directory
+func (m *ProviderManager) stopProvider(name string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	pluginRef, ok := m.pluginRefs[name]
+	if !ok {
+		return
+	}
+	close(pluginRef.stop)
+	pluginRef.client.Kill()
+
+	delete(m.pluginRefs, name)
+}
+
 func (m *ProviderManager) TerminateProviderProcesses(providersBasePath string) error {
 	process, err := process.Processes()
 
@@ -224,30 +385,32 @@ func (m *ProviderManager) initializeProvider(pluginPath string) (*pluginRef, err
 		Level:  hclog.Debug,
 	})
 
-	pluginMap := map[string]plugin.Plugin{}
-	pluginMap[pluginName] = &ProviderPlugin{}
-
 	client := plugin.NewClient(&plugin.ClientConfig{
 		HandshakeConfig: ProviderHandshakeConfig,
-		Plugins:         pluginMap,
-		Cmd:             exec.Command(pluginPath),
-		Logger:          logger,
-		Managed:         true,
+		VersionedPlugins: map[int]plugin.PluginSet{
+			1: {pluginName: &ProviderPlugin{}},
+			2: {pluginName: &ProviderPluginV2{}},
+		},
+		Cmd:     exec.Command(pluginPath),
+		Logger:  logger,
+		Managed: true,
 	})
 
 	log.Infof("Provider %s registered", pluginName)
 
 	p, err := m.dispenseProvider(client, pluginName)
 	if err != nil {
+		client.Kill()
 		return nil, errors.New("failed to initialize provider: " + err.Error())
 	}
 
 	networkKey, err := m.createProviderNetworkKey(pluginName)
 	if err != nil {
+		client.Kill()
 		return nil, errors.New("failed to create network key: " + err.Error())
 	}
 
-	_, err = (*p).Initialize(InitializeProviderRequest{
+	_, err = p.Initialize(context.Background(), InitializeProviderRequest{
 		BasePath:           pluginBasePath,
 		DaytonaDownloadUrl: m.daytonaDownloadUrl,
 		DaytonaVersion:     internal.Version,
@@ -259,17 +422,36 @@ func (m *ProviderManager) initializeProvider(pluginPath string) (*pluginRef, err
 		ApiPort:            m.apiPort,
 	})
 	if err != nil {
+		client.Kill()
 		return nil, errors.New("failed to initialize provider: " + err.Error())
 	}
 
+	info, err := p.GetInfo(context.Background())
+	if err != nil {
+		client.Kill()
+		return nil, errors.New("failed to get provider info: " + err.Error())
+	}
+
+	log.Debugf("Provider %s uses plugin protocol version %d", pluginName, client.NegotiatedVersion())
+
+	capabilities, err := p.GetCapabilities(context.Background())
+	if err != nil {
+		log.Warnf("Failed to get capabilities of provider %s, using defaults: %s", pluginName, err)
+		capabilities = &DefaultProviderCapabilities
+	}
+
 	return &pluginRef{
-		client: client,
-		path:   pluginBasePath,
-		name:   pluginName,
+		client:       client,
+		path:         pluginBasePath,
+		pluginPath:   pluginPath,
+		name:         pluginName,
+		version:      info.Version,
+		capabilities: *capabilities,
+		state:        ProviderStateUp,
 	}, nil
 }
 
-func (m *ProviderManager) dispenseProvider(client *plugin.Client, name string) (*Provider, error) {
+func (m *ProviderManager) dispenseProvider(client *plugin.Client, name string) (ProviderV2, error) {
 	rpcClient, err := client.Client()
 	if err != nil {
 		return nil, err
@@ -280,10 +462,12 @@ func (m *ProviderManager) dispenseProvider(client *plugin.Client, name string) (
 		return nil, err
 	}
 
-	provider, ok := raw.(Provider)
-	if !ok {
+	switch provider := raw.(type) {
+	case ProviderV2:
+		return provider, nil
+	case Provider:
+		return FromV1(provider), nil
+	default:
 		return nil, errors.New("unexpected type from plugin")
 	}
-
-	return &provider, nil
 }
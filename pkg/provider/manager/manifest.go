@@ -10,6 +10,10 @@ import (
 
 type Version struct {
 	DownloadUrls map[os.OperatingSystem]string `json:"downloadUrls"`
+	// Checksums holds the hex encoded SHA-256 digest of each binary
+	Checksums map[os.OperatingSystem]string `json:"checksums,omitempty"`
+	// Signatures holds the base64 encoded Ed25519 signature of each binary's SHA-256 digest
+	Signatures map[os.OperatingSystem]string `json:"signatures,omitempty"`
 }
 
 type ProvidersManifest map[string]ProviderManifest
@@ -56,12 +60,18 @@ func (p *ProvidersManifest) GetDefaultProviders() map[string]*Version {
 	return defaultProviders
 }
 
-func (p *ProvidersManifest) HasUpdateAvailable(providerName string, currentVersion string) bool {
+// HasUpdateAvailable checks if a newer version of a provider is available.
+// Providers pinned to a version are only updated up to that version.
+func (p *ProvidersManifest) HasUpdateAvailable(providerName string, currentVersion string, pinnedVersion string) bool {
 	provider, ok := (*p)[providerName]
 	if !ok {
 		return false
 	}
 
+	if pinnedVersion != "" {
+		return semver.Compare(pinnedVersion, currentVersion) > 0
+	}
+
 	var latestVersion string = "v0.0.0"
 
 	for version := range provider.Versions {
@@ -0,0 +1,95 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	goos "os"
+	"path"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/os"
+	log "github.com/sirupsen/logrus"
+)
+
+// MirrorProviders exports the providers manifest and binaries to dir so it can be used as a registry
+// for servers without internet access, e.g. with a registry URL of file:///path/to/dir.
+// Download URLs in the exported manifest are relative to the manifest.
+func (m *ProviderManager) MirrorProviders(dir string, latestOnly bool) error {
+	manifest, err := m.GetProvidersManifest()
+	if err != nil {
+		return err
+	}
+
+	if latestOnly {
+		manifest = manifest.GetLatestVersions()
+	}
+
+	providersDir := filepath.Join(dir, "providers")
+	mirroredManifest := ProvidersManifest{}
+
+	for providerName, providerManifest := range *manifest {
+		mirroredProvider := ProviderManifest{Default: providerManifest.Default, Versions: map[string]Version{}}
+
+		for versionName, version := range providerManifest.Versions {
+			mirroredVersion := Version{
+				DownloadUrls: map[os.OperatingSystem]string{},
+				Checksums:    version.Checksums,
+				Signatures:   version.Signatures,
+			}
+
+			for operatingSystem, downloadUrl := range version.DownloadUrls {
+				relativePath, err := m.mirrorBinary(providersDir, downloadUrl, version.Checksums[operatingSystem], providerName, versionName, operatingSystem)
+				if err != nil {
+					return fmt.Errorf("failed to mirror %s %s for %s: %w", providerName, versionName, operatingSystem, err)
+				}
+				mirroredVersion.DownloadUrls[operatingSystem] = relativePath
+			}
+
+			mirroredProvider.Versions[versionName] = mirroredVersion
+		}
+
+		mirroredManifest[providerName] = mirroredProvider
+	}
+
+	manifestJson, err := json.MarshalIndent(mirroredManifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return goos.WriteFile(filepath.Join(providersDir, "manifest.json"), manifestJson, 0644)
+}
+
+func (m *ProviderManager) mirrorBinary(providersDir, downloadUrl, checksum, providerName, versionName string, operatingSystem os.OperatingSystem) (string, error) {
+	downloadUrl, err := m.resolveDownloadUrl(downloadUrl)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(downloadUrl)
+	if err != nil {
+		return "", err
+	}
+
+	relativePath := path.Join(providerName, versionName, string(operatingSystem), path.Base(u.Path))
+	binaryPath := filepath.Join(providersDir, filepath.FromSlash(relativePath))
+
+	log.Infof("Mirroring %s %s for %s", providerName, versionName, operatingSystem)
+
+	err = os.DownloadFile(downloadUrl, binaryPath)
+	if err != nil {
+		return "", err
+	}
+
+	// Signatures are verified by the server on install, here we only make sure the download is intact
+	err = verifyProvider(binaryPath, checksum, "", "", true)
+	if err != nil {
+		goos.Remove(binaryPath)
+		return "", err
+	}
+
+	return relativePath, nil
+}
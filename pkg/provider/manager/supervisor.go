@@ -0,0 +1,141 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type ProviderState string
+
+const (
+	ProviderStateUp         ProviderState = "up"
+	ProviderStateRestarting ProviderState = "restarting"
+	ProviderStateFailed     ProviderState = "failed"
+)
+
+type ProviderHealth struct {
+	Name      string
+	Version   string
+	State     ProviderState
+	LastError string
+}
+
+var (
+	supervisorInterval    = 2 * time.Second
+	restartInitialBackoff = 1 * time.Second
+	restartMaxBackoff     = 1 * time.Minute
+	// restartWaitTimeout is how long getting a provider waits for the supervisor to restart it
+	restartWaitTimeout  = 30 * time.Second
+	restartWaitInterval = 100 * time.Millisecond
+)
+
+const restartMaxAttempts = 5
+
+// superviseProvider restarts the provider whenever its plugin process exits, until stop is closed.
+// Providers that failed to restart are left to GetProvider, which reinitializes them on demand.
+func (m *ProviderManager) superviseProvider(name string, stop <-chan struct{}) {
+	ticker := time.NewTicker(supervisorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		m.mutex.RLock()
+		pluginRef, ok := m.pluginRefs[name]
+		exited := ok && pluginRef.state == ProviderStateUp && pluginRef.client.Exited()
+		m.mutex.RUnlock()
+
+		if !ok {
+			return
+		}
+
+		if exited {
+			log.Warnf("Provider %s exited unexpectedly. Restarting...", name)
+			m.restartProvider(pluginRef, stop)
+		}
+	}
+}
+
+func (m *ProviderManager) restartProvider(exitedPluginRef *pluginRef, stop <-chan struct{}) {
+	name := exitedPluginRef.name
+	lastErr := errors.New("provider process exited")
+	backoff := restartInitialBackoff
+
+	for attempt := 1; attempt <= restartMaxAttempts; attempt++ {
+		// GetProvider reinitialized the provider in the meantime
+		if !m.setProviderState(exitedPluginRef, ProviderStateRestarting, lastErr) {
+			return
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(backoff):
+		}
+
+		newPluginRef, err := m.initializeProvider(exitedPluginRef.pluginPath)
+		if err == nil {
+			newPluginRef.lastError = lastErr.Error()
+			if m.replacePluginRef(exitedPluginRef, newPluginRef) {
+				log.Infof("Provider %s restarted", name)
+			}
+			return
+		}
+
+		lastErr = err
+		log.Errorf("Failed to restart provider %s (attempt %d/%d): %s", name, attempt, restartMaxAttempts, err)
+
+		backoff *= 2
+		if backoff > restartMaxBackoff {
+			backoff = restartMaxBackoff
+		}
+	}
+
+	m.setProviderState(exitedPluginRef, ProviderStateFailed, lastErr)
+}
+
+// replacePluginRef swaps a reinitialized plugin in for the old one.
+// It returns false and kills the new plugin if the provider was uninstalled in the meantime.
+func (m *ProviderManager) replacePluginRef(oldPluginRef, newPluginRef *pluginRef) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	currentPluginRef, ok := m.pluginRefs[oldPluginRef.name]
+	if !ok || currentPluginRef != oldPluginRef {
+		newPluginRef.client.Kill()
+		return false
+	}
+
+	newPluginRef.stop = currentPluginRef.stop
+	m.pluginRefs[newPluginRef.name] = newPluginRef
+
+	return true
+}
+
+// setProviderState sets the state of the plugin. It returns false if the plugin was replaced or the provider
+// was uninstalled in the meantime.
+func (m *ProviderManager) setProviderState(pluginRef *pluginRef, state ProviderState, err error) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	currentPluginRef, ok := m.pluginRefs[pluginRef.name]
+	if !ok || currentPluginRef != pluginRef {
+		return false
+	}
+
+	pluginRef.state = state
+	if err != nil {
+		pluginRef.lastError = err.Error()
+	}
+
+	return true
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPluginRefWhileRestarting(t *testing.T) {
+	m := NewProviderManager(ProviderManagerConfig{})
+
+	exitedPluginRef := &pluginRef{name: "test-provider", state: ProviderStateUp}
+	m.pluginRefs[exitedPluginRef.name] = exitedPluginRef
+	require.True(t, m.setProviderState(exitedPluginRef, ProviderStateRestarting, errors.New("provider process exited")))
+
+	t.Run("Waits for the restart", func(t *testing.T) {
+		restartedPluginRef := &pluginRef{name: "test-provider", state: ProviderStateUp}
+		go func() {
+			time.Sleep(200 * time.Millisecond)
+			m.replacePluginRef(exitedPluginRef, restartedPluginRef)
+		}()
+
+		pluginRef, err := m.getPluginRef("test-provider")
+		require.NoError(t, err)
+		require.Same(t, restartedPluginRef, pluginRef)
+
+		// The supervisor stops restarting a plugin that was replaced
+		require.False(t, m.setProviderState(exitedPluginRef, ProviderStateRestarting, nil))
+		require.Equal(t, ProviderStateUp, pluginRef.state)
+	})
+
+	t.Run("Times out", func(t *testing.T) {
+		defaultTimeout := restartWaitTimeout
+		restartWaitTimeout = 300 * time.Millisecond
+		defer func() { restartWaitTimeout = defaultTimeout }()
+
+		m.pluginRefs["test-provider"].state = ProviderStateRestarting
+
+		_, err := m.getPluginRef("test-provider")
+		require.ErrorContains(t, err, "provider test-provider is still restarting")
+	})
+
+	t.Run("Provider not found", func(t *testing.T) {
+		_, err := m.getPluginRef("other-provider")
+		require.EqualError(t, err, "provider not found")
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrUnsignedProvider = errors.New("provider is not signed, use `daytona provider install --allow-unsigned` to install it anyway")
+
+// verifyProvider checks the downloaded binary against the SHA-256 digest from the manifest
+// and verifies the Ed25519 signature of that digest with the public key.
+// A binary that doesn't match its checksum or signature is always rejected. A missing checksum,
+// signature or public key is only tolerated if allowUnsigned is set.
+func verifyProvider(path, checksum, signature, publicKey string, allowUnsigned bool) error {
+	digest, err := fileDigest(path)
+	if err != nil {
+		return err
+	}
+
+	if checksum != "" {
+		expected, err := hex.DecodeString(strings.TrimSpace(checksum))
+		if err != nil {
+			return fmt.Errorf("invalid provider checksum: %w", err)
+		}
+
+		if !bytes.Equal(digest, expected) {
+			return fmt.Errorf("provider checksum mismatch: expected %s, got %s", checksum, hex.EncodeToString(digest))
+		}
+	}
+
+	if checksum == "" || signature == "" || publicKey == "" {
+		if allowUnsigned {
+			log.Warnf("Signature verification of provider %s was skipped since unsigned providers are allowed", strings.TrimSuffix(filepath.Base(path), STAGED_PROVIDER_SUFFIX))
+			return nil
+		}
+		return ErrUnsignedProvider
+	}
+
+	key, err := decodePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return fmt.Errorf("invalid provider signature: %w", err)
+	}
+
+	if !ed25519.Verify(key, digest, sig) {
+		return errors.New("provider signature verification failed")
+	}
+
+	return nil
+}
+
+// ValidatePublicKey checks that a providers public key is a base64 encoded Ed25519 key
+func ValidatePublicKey(publicKey string) error {
+	_, err := decodePublicKey(publicKey)
+	return err
+}
+
+func decodePublicKey(publicKey string) (ed25519.PublicKey, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid providers public key")
+	}
+
+	return ed25519.PublicKey(key), nil
+}
+
+func fileDigest(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
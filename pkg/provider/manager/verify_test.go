@@ -0,0 +1,77 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyProvider(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	encodedKey := base64.StdEncoding.EncodeToString(publicKey)
+
+	content := []byte("provider binary")
+	path := filepath.Join(t.TempDir(), "provider")
+	require.NoError(t, os.WriteFile(path, content, 0600))
+
+	digest := sha256.Sum256(content)
+	checksum := hex.EncodeToString(digest[:])
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, digest[:]))
+
+	t.Run("Accepts a signed provider", func(t *testing.T) {
+		require.NoError(t, verifyProvider(path, checksum, signature, encodedKey, false))
+	})
+
+	t.Run("Rejects a checksum mismatch", func(t *testing.T) {
+		otherDigest := sha256.Sum256([]byte("other binary"))
+		err := verifyProvider(path, hex.EncodeToString(otherDigest[:]), signature, encodedKey, true)
+		require.ErrorContains(t, err, "checksum mismatch")
+	})
+
+	t.Run("Rejects an invalid signature", func(t *testing.T) {
+		otherSignature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("other")))
+		err := verifyProvider(path, checksum, otherSignature, encodedKey, true)
+		require.ErrorContains(t, err, "signature verification failed")
+	})
+
+	t.Run("Rejects an unsigned provider", func(t *testing.T) {
+		require.ErrorIs(t, verifyProvider(path, checksum, "", encodedKey, false), ErrUnsignedProvider)
+		require.ErrorIs(t, verifyProvider(path, checksum, signature, "", false), ErrUnsignedProvider)
+	})
+
+	t.Run("Allows an unsigned provider if requested", func(t *testing.T) {
+		require.NoError(t, verifyProvider(path, "", "", "", true))
+	})
+}
+
+func TestInstallProviderBinaryRequiresSignature(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	baseDir := t.TempDir()
+	m := NewProviderManager(ProviderManagerConfig{
+		BaseDir:   baseDir,
+		PublicKey: base64.StdEncoding.EncodeToString(publicKey),
+	})
+
+	content := []byte("provider binary")
+	digest := sha256.Sum256(content)
+
+	err = m.InstallProviderBinary(bytes.NewReader(content), "test-provider", hex.EncodeToString(digest[:]), "", false)
+	require.ErrorIs(t, err, ErrUnsignedProvider)
+
+	_, err = os.Stat(filepath.Join(baseDir, "test-provider"))
+	require.True(t, os.IsNotExist(err))
+}
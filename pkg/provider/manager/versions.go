@@ -0,0 +1,157 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/mod/semver"
+)
+
+// maxInstalledVersions is the number of versions of each provider that are kept for rollbacks
+const maxInstalledVersions = 3
+
+// GetInstalledVersions returns the versions of a provider that are kept in the providers directory, newest first
+func (m *ProviderManager) GetInstalledVersions(providerName string) ([]string, error) {
+	entries, err := os.ReadDir(m.getVersionsDir(providerName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	versions := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && semver.IsValid(entry.Name()) {
+			versions = append(versions, entry.Name())
+		}
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return semver.Compare(versions[i], versions[j]) > 0
+	})
+
+	return versions, nil
+}
+
+// RollbackProvider reinstalls the newest installed version of a provider that is older than the running one.
+// If the provider isn't running, e.g. because the current version failed to start, the newest installed version is used.
+func (m *ProviderManager) RollbackProvider(providerName string) (string, error) {
+	versions, err := m.GetInstalledVersions(providerName)
+	if err != nil {
+		return "", err
+	}
+
+	m.mutex.RLock()
+	currentVersion := ""
+	if pluginRef, ok := m.pluginRefs[providerName]; ok {
+		currentVersion = pluginRef.version
+	}
+	m.mutex.RUnlock()
+
+	rollbackVersion := ""
+	for _, version := range versions {
+		if currentVersion == "" || semver.Compare(version, currentVersion) < 0 {
+			rollbackVersion = version
+			break
+		}
+	}
+
+	if rollbackVersion == "" {
+		if currentVersion == "" {
+			return "", fmt.Errorf("no versions of %s are installed", providerName)
+		}
+		return "", fmt.Errorf("no version of %s older than %s is installed", providerName, currentVersion)
+	}
+
+	log.Infof("Rolling back %s to %s", providerName, rollbackVersion)
+
+	err = m.InstallProvider(providerName, rollbackVersion, Version{}, false)
+	if err != nil {
+		return "", err
+	}
+
+	return rollbackVersion, nil
+}
+
+// keepVersion copies the binary of a registered provider to its versions directory
+// so it can be reinstalled later, and removes the oldest versions beyond maxInstalledVersions
+func (m *ProviderManager) keepVersion(pluginRef *pluginRef) error {
+	if !semver.IsValid(pluginRef.version) {
+		return nil
+	}
+
+	versionPath := m.getVersionPath(pluginRef.name, pluginRef.version)
+	if _, err := os.Stat(versionPath); errors.Is(err, os.ErrNotExist) {
+		err = copyFile(pluginRef.pluginPath, versionPath)
+		if err != nil {
+			os.RemoveAll(filepath.Dir(versionPath))
+			return err
+		}
+	}
+
+	versions, err := m.GetInstalledVersions(pluginRef.name)
+	if err != nil {
+		return err
+	}
+
+	// The registered version is always kept
+	kept := 1
+	for _, version := range versions {
+		if version == pluginRef.version {
+			continue
+		}
+		if kept < maxInstalledVersions {
+			kept++
+			continue
+		}
+
+		err := os.RemoveAll(filepath.Join(m.getVersionsDir(pluginRef.name), version))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ProviderManager) getVersionsDir(providerName string) string {
+	return filepath.Join(m.baseDir, providerName, "versions")
+}
+
+func (m *ProviderManager) getVersionPath(providerName, version string) string {
+	return filepath.Join(m.getVersionsDir(providerName), version, filepath.Base(m.getProviderPath(providerName)))
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	err = os.MkdirAll(filepath.Dir(dst), 0755)
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(out, in)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
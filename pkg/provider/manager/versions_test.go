@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeepVersion(t *testing.T) {
+	m := NewProviderManager(ProviderManagerConfig{BaseDir: t.TempDir()})
+
+	pluginPath := m.getProviderPath("test-provider")
+	require.NoError(t, os.MkdirAll(m.getVersionsDir("test-provider"), 0755))
+	require.NoError(t, os.WriteFile(pluginPath, []byte("binary"), 0755))
+
+	for _, version := range []string{"v0.1.0", "v0.3.0", "v0.2.0", "v0.4.0", "v0.1.1"} {
+		err := m.keepVersion(&pluginRef{name: "test-provider", version: version, pluginPath: pluginPath})
+		require.NoError(t, err)
+	}
+
+	versions, err := m.GetInstalledVersions("test-provider")
+	require.NoError(t, err)
+	require.Equal(t, []string{"v0.4.0", "v0.3.0", "v0.1.1"}, versions)
+
+	t.Run("Ignores invalid versions", func(t *testing.T) {
+		err := m.keepVersion(&pluginRef{name: "test-provider", version: "../latest", pluginPath: pluginPath})
+		require.NoError(t, err)
+
+		versions, err := m.GetInstalledVersions("test-provider")
+		require.NoError(t, err)
+		require.Len(t, versions, 3)
+	})
+}
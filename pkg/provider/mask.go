@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+)
+
+// MaskedOptionValue replaces the values of masked target options in API responses
+const MaskedOptionValue = "********"
+
+// MaskOptions replaces the values of properties marked InputMasked in the JSON encoded target options
+func (m ProviderTargetManifest) MaskOptions(options string) (string, error) {
+	values := map[string]interface{}{}
+	err := json.Unmarshal([]byte(options), &values)
+	if err != nil {
+		return "", err
+	}
+
+	masked := false
+	for name, value := range values {
+		if property, ok := m[name]; ok && property.InputMasked && value != nil && value != "" {
+			values[name] = MaskedOptionValue
+			masked = true
+		}
+	}
+
+	if !masked {
+		return options, nil
+	}
+
+	content, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// UnmaskOptions restores masked values sent back in the JSON encoded target options from the existing options.
+// Masked values without an existing value are removed.
+func (m ProviderTargetManifest) UnmaskOptions(options string, existingOptions string) (string, error) {
+	values := map[string]interface{}{}
+	err := json.Unmarshal([]byte(options), &values)
+	if err != nil {
+		return "", err
+	}
+
+	existingValues := map[string]interface{}{}
+	if existingOptions != "" {
+		err = json.Unmarshal([]byte(existingOptions), &existingValues)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	unmasked := false
+	for name, value := range values {
+		if property, ok := m[name]; !ok || !property.InputMasked || value != MaskedOptionValue {
+			continue
+		}
+
+		if existingValue, ok := existingValues[name]; ok {
+			values[name] = existingValue
+		} else {
+			delete(values, name)
+		}
+		unmasked = true
+	}
+
+	if !unmasked {
+		return options, nil
+	}
+
+	content, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskOptions(t *testing.T) {
+	manifest := ProviderTargetManifest{
+		"Region":   {Type: ProviderTargetPropertyTypeString},
+		"Token":    {Type: ProviderTargetPropertyTypeString, InputMasked: true},
+		"Password": {Type: ProviderTargetPropertyTypeString, InputMasked: true},
+	}
+
+	masked, err := manifest.MaskOptions(`{"Region": "eu", "Token": "secret", "Password": ""}`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"Region": "eu", "Token": "********", "Password": ""}`, masked)
+
+	unchanged := `{"Region": "eu"}`
+	masked, err = manifest.MaskOptions(unchanged)
+	require.NoError(t, err)
+	require.Equal(t, unchanged, masked)
+
+	t.Run("Unmask keeps existing secrets", func(t *testing.T) {
+		unmasked, err := manifest.UnmaskOptions(`{"Region": "us", "Token": "********", "Password": "********"}`, `{"Region": "eu", "Token": "secret"}`)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"Region": "us", "Token": "secret"}`, unmasked)
+	})
+
+	t.Run("Unmask keeps new secrets", func(t *testing.T) {
+		unmasked, err := manifest.UnmaskOptions(`{"Token": "new-secret"}`, `{"Token": "secret"}`)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"Token": "new-secret"}`, unmasked)
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/rpc"
+
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/hashicorp/go-plugin"
+)
+
+// ProviderV2 is the interface of providers that implement plugin protocol version 2.
+// Every call receives a context that carries the caller's deadline and cancellation.
+// Providers can stream progress and log lines back to the caller by writing to LogWriter(ctx).
+type ProviderV2 interface {
+	Initialize(context.Context, InitializeProviderRequest) (*util.Empty, error)
+	GetInfo(context.Context) (ProviderInfo, error)
+	GetCapabilities(context.Context) (*ProviderCapabilities, error)
+
+	GetTargetManifest(context.Context) (*ProviderTargetManifest, error)
+	GetDefaultTargets(context.Context) (*[]ProviderTarget, error)
+
+	CreateWorkspace(context.Context, *WorkspaceRequest) (*util.Empty, error)
+	StartWorkspace(context.Context, *WorkspaceRequest) (*util.Empty, error)
+	StopWorkspace(context.Context, *WorkspaceRequest) (*util.Empty, error)
+	DestroyWorkspace(context.Context, *WorkspaceRequest) (*util.Empty, error)
+	GetWorkspaceInfo(context.Context, *WorkspaceRequest) (*workspace.WorkspaceInfo, error)
+
+	CreateProject(context.Context, *ProjectRequest) (*util.Empty, error)
+	StartProject(context.Context, *ProjectRequest) (*util.Empty, error)
+	StopProject(context.Context, *ProjectRequest) (*util.Empty, error)
+	DestroyProject(context.Context, *ProjectRequest) (*util.Empty, error)
+	GetProjectInfo(context.Context, *ProjectRequest) (*workspace.ProjectInfo, error)
+}
+
+type ProviderPluginV2 struct {
+	Impl ProviderV2
+}
+
+func (p *ProviderPluginV2) Server(b *plugin.MuxBroker) (interface{}, error) {
+	return &ProviderRPCServerV2{Impl: p.Impl, broker: b}, nil
+}
+
+func (p *ProviderPluginV2) Client(b *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
+	return &ProviderRPCClientV2{client: c, broker: b}, nil
+}
+
+// VersionedPlugins returns the plugin sets a protocol v2 provider should be served with.
+// Protocol v1 is served as well so the provider keeps working with older servers.
+//
+//	plugin.Serve(&plugin.ServeConfig{
+//		HandshakeConfig:  manager.ProviderHandshakeConfig,
+//		VersionedPlugins: provider.VersionedPlugins(name, impl),
+//	})
+func VersionedPlugins(name string, impl ProviderV2) map[int]plugin.PluginSet {
+	return map[int]plugin.PluginSet{
+		1: {name: &ProviderPlugin{Impl: ToV1(impl)}},
+		2: {name: &ProviderPluginV2{Impl: impl}},
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/rpc"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/hashicorp/go-plugin"
+)
+
+// logStreamTimeout is how long a finished call waits for the rest of an accepted log stream
+const logStreamTimeout = 5 * time.Second
+
+// RPCRequestV2 carries the arguments of a protocol v2 call together with its context
+type RPCRequestV2 struct {
+	// CallId identifies the call for cancellation and is the broker stream id of its log stream
+	CallId     uint32
+	Deadline   time.Time
+	StreamLogs bool
+
+	Initialize *InitializeProviderRequest
+	Workspace  *WorkspaceRequest
+	Project    *ProjectRequest
+	TargetTest *TargetTestRequest
+}
+
+type ProviderRPCClientV2 struct {
+	client *rpc.Client
+	broker *plugin.MuxBroker
+}
+
+func (m *ProviderRPCClientV2) Initialize(ctx context.Context, req InitializeProviderRequest) (*util.Empty, error) {
+	err := m.call(ctx, "Initialize", RPCRequestV2{Initialize: &req}, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClientV2) GetInfo(ctx context.Context) (ProviderInfo, error) {
+	var resp ProviderInfo
+	err := m.call(ctx, "GetInfo", RPCRequestV2{}, &resp)
+	return resp, err
+}
+
+func (m *ProviderRPCClientV2) GetCapabilities(ctx context.Context) (*ProviderCapabilities, error) {
+	var resp ProviderCapabilities
+	err := m.call(ctx, "GetCapabilities", RPCRequestV2{}, &resp)
+	return &resp, err
+}
+
+func (m *ProviderRPCClientV2) GetTargetManifest(ctx context.Context) (*ProviderTargetManifest, error) {
+	var resp ProviderTargetManifest
+	err := m.call(ctx, "GetTargetManifest", RPCRequestV2{}, &resp)
+	return &resp, err
+}
+
+func (m *ProviderRPCClientV2) GetDefaultTargets(ctx context.Context) (*[]ProviderTarget, error) {
+	var resp []ProviderTarget
+	err := m.call(ctx, "GetDefaultTargets", RPCRequestV2{}, &resp)
+	return &resp, err
+}
+
+func (m *ProviderRPCClientV2) CreateWorkspace(ctx context.Context, workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	err := m.call(ctx, "CreateWorkspace", RPCRequestV2{Workspace: workspaceReq}, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClientV2) StartWorkspace(ctx context.Context, workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	err := m.call(ctx, "StartWorkspace", RPCRequestV2{Workspace: workspaceReq}, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClientV2) StopWorkspace(ctx context.Context, workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	err := m.call(ctx, "StopWorkspace", RPCRequestV2{Workspace: workspaceReq}, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClientV2) DestroyWorkspace(ctx context.Context, workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	err := m.call(ctx, "DestroyWorkspace", RPCRequestV2{Workspace: workspaceReq}, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClientV2) GetWorkspaceInfo(ctx context.Context, workspaceReq *WorkspaceRequest) (*workspace.WorkspaceInfo, error) {
+	var response workspace.WorkspaceInfo
+	err := m.call(ctx, "GetWorkspaceInfo", RPCRequestV2{Workspace: workspaceReq}, &response)
+	return &response, err
+}
+
+func (m *ProviderRPCClientV2) CreateProject(ctx context.Context, projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.call(ctx, "CreateProject", RPCRequestV2{Project: projectReq}, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClientV2) StartProject(ctx context.Context, projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.call(ctx, "StartProject", RPCRequestV2{Project: projectReq}, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClientV2) StopProject(ctx context.Context, projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.call(ctx, "StopProject", RPCRequestV2{Project: projectReq}, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClientV2) DestroyProject(ctx context.Context, projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.call(ctx, "DestroyProject", RPCRequestV2{Project: projectReq}, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClientV2) GetProjectInfo(ctx context.Context, projectReq *ProjectRequest) (*workspace.ProjectInfo, error) {
+	var resp workspace.ProjectInfo
+	err := m.call(ctx, "GetProjectInfo", RPCRequestV2{Project: projectReq}, &resp)
+	return &resp, err
+}
+
+// TestTarget returns ErrTargetTestNotSupported if the provider doesn't implement TargetTester
+func (m *ProviderRPCClientV2) TestTarget(ctx context.Context, req *TargetTestRequest) (*TargetTestResult, error) {
+	var resp TargetTestResult
+	err := m.call(ctx, "TestTarget", RPCRequestV2{TargetTest: req}, &resp)
+	if err != nil {
+		// Providers built before TestTarget was added don't have the method
+		if err.Error() == ErrTargetTestNotSupported.Error() || isMethodNotFound(err) {
+			return nil, ErrTargetTestNotSupported
+		}
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// call sends the deadline of ctx with the request, cancels the call in the provider when ctx is done
+// and copies the log stream of the call to the log writer of ctx. Logs are not written once call returns.
+func (m *ProviderRPCClientV2) call(ctx context.Context, method string, req RPCRequestV2, resp interface{}) error {
+	req.CallId = m.broker.NextId()
+	if deadline, ok := ctx.Deadline(); ok {
+		req.Deadline = deadline
+	}
+
+	var logs *logStream
+	if logWriter, ok := getLogWriter(ctx); ok {
+		req.StreamLogs = true
+		logs = m.acceptLogStream(req.CallId, logWriter)
+	}
+
+	call := m.client.Go("Plugin."+method, &req, resp, make(chan *rpc.Call, 1))
+
+	select {
+	case <-call.Done:
+		if logs != nil {
+			// The provider opens the log stream before it handles a call, so only calls of methods
+			// the provider doesn't have are finished without opening it
+			if isMethodNotFound(call.Error) {
+				logs.close()
+			} else {
+				logs.wait(logStreamTimeout)
+			}
+		}
+		return call.Error
+	case <-ctx.Done():
+		m.client.Go("Plugin.Cancel", req.CallId, new(util.Empty), make(chan *rpc.Call, 1))
+		if logs != nil {
+			// The provider closes the log stream once the cancelled call returns
+			logs.wait(logStreamTimeout)
+		}
+		return ctx.Err()
+	}
+}
+
+// logStream copies the log stream of a call to a log writer
+type logStream struct {
+	mutex  sync.Mutex
+	conn   net.Conn
+	closed bool
+	done   chan struct{}
+}
+
+func (m *ProviderRPCClientV2) acceptLogStream(callId uint32, logWriter io.Writer) *logStream {
+	logs := &logStream{done: make(chan struct{})}
+
+	go func() {
+		defer close(logs.done)
+
+		// Accept gives up on its own if the stream isn't opened in time
+		conn, err := m.broker.Accept(callId)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		logs.mutex.Lock()
+		if logs.closed {
+			logs.mutex.Unlock()
+			return
+		}
+		logs.conn = conn
+		logs.mutex.Unlock()
+
+		_, _ = io.Copy(logWriter, conn)
+	}()
+
+	return logs
+}
+
+// wait waits until the provider closes the log stream and closes it if that doesn't happen within the timeout
+func (l *logStream) wait(timeout time.Duration) {
+	select {
+	case <-l.done:
+	case <-time.After(timeout):
+		l.close()
+	}
+}
+
+// close stops copying the log stream and waits for the copy to end if the stream was accepted
+func (l *logStream) close() {
+	l.mutex.Lock()
+	l.closed = true
+	accepted := l.conn != nil
+	if accepted {
+		l.conn.Close()
+	}
+	l.mutex.Unlock()
+
+	if accepted {
+		<-l.done
+	}
+}
+
+func isMethodNotFound(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "rpc: can't find method")
+}
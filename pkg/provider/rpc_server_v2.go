@@ -0,0 +1,266 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/hashicorp/go-plugin"
+)
+
+// cancelledCallTTL is how long a cancellation waits for its call to start
+const cancelledCallTTL = time.Minute
+
+type ProviderRPCServerV2 struct {
+	Impl   ProviderV2
+	broker *plugin.MuxBroker
+
+	mutex   sync.Mutex
+	cancels map[uint32]context.CancelFunc
+	// cancelled holds the time of cancellations that arrived before their call started
+	cancelled map[uint32]time.Time
+}
+
+func (m *ProviderRPCServerV2) Initialize(arg *RPCRequestV2, resp *util.Empty) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	_, err := m.Impl.Initialize(ctx, *arg.Initialize)
+	return err
+}
+
+func (m *ProviderRPCServerV2) GetInfo(arg *RPCRequestV2, resp *ProviderInfo) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	info, err := m.Impl.GetInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	*resp = info
+	return nil
+}
+
+func (m *ProviderRPCServerV2) GetCapabilities(arg *RPCRequestV2, resp *ProviderCapabilities) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	capabilities, err := m.Impl.GetCapabilities(ctx)
+	if err != nil {
+		return err
+	}
+
+	*resp = *capabilities
+	return nil
+}
+
+func (m *ProviderRPCServerV2) GetTargetManifest(arg *RPCRequestV2, resp *ProviderTargetManifest) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	targetManifest, err := m.Impl.GetTargetManifest(ctx)
+	if err != nil {
+		return err
+	}
+
+	*resp = *targetManifest
+	return nil
+}
+
+func (m *ProviderRPCServerV2) GetDefaultTargets(arg *RPCRequestV2, resp *[]ProviderTarget) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	targets, err := m.Impl.GetDefaultTargets(ctx)
+	if err != nil {
+		return err
+	}
+
+	*resp = *targets
+	return nil
+}
+
+func (m *ProviderRPCServerV2) CreateWorkspace(arg *RPCRequestV2, resp *util.Empty) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	_, err := m.Impl.CreateWorkspace(ctx, arg.Workspace)
+	return err
+}
+
+func (m *ProviderRPCServerV2) StartWorkspace(arg *RPCRequestV2, resp *util.Empty) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	_, err := m.Impl.StartWorkspace(ctx, arg.Workspace)
+	return err
+}
+
+func (m *ProviderRPCServerV2) StopWorkspace(arg *RPCRequestV2, resp *util.Empty) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	_, err := m.Impl.StopWorkspace(ctx, arg.Workspace)
+	return err
+}
+
+func (m *ProviderRPCServerV2) DestroyWorkspace(arg *RPCRequestV2, resp *util.Empty) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	_, err := m.Impl.DestroyWorkspace(ctx, arg.Workspace)
+	return err
+}
+
+func (m *ProviderRPCServerV2) GetWorkspaceInfo(arg *RPCRequestV2, resp *workspace.WorkspaceInfo) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	info, err := m.Impl.GetWorkspaceInfo(ctx, arg.Workspace)
+	if err != nil {
+		return err
+	}
+
+	*resp = *info
+	return nil
+}
+
+func (m *ProviderRPCServerV2) CreateProject(arg *RPCRequestV2, resp *util.Empty) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	_, err := m.Impl.CreateProject(ctx, arg.Project)
+	return err
+}
+
+func (m *ProviderRPCServerV2) StartProject(arg *RPCRequestV2, resp *util.Empty) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	_, err := m.Impl.StartProject(ctx, arg.Project)
+	return err
+}
+
+func (m *ProviderRPCServerV2) StopProject(arg *RPCRequestV2, resp *util.Empty) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	_, err := m.Impl.StopProject(ctx, arg.Project)
+	return err
+}
+
+func (m *ProviderRPCServerV2) DestroyProject(arg *RPCRequestV2, resp *util.Empty) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	_, err := m.Impl.DestroyProject(ctx, arg.Project)
+	return err
+}
+
+func (m *ProviderRPCServerV2) GetProjectInfo(arg *RPCRequestV2, resp *workspace.ProjectInfo) error {
+	ctx, done := m.context(arg)
+	defer done()
+
+	info, err := m.Impl.GetProjectInfo(ctx, arg.Project)
+	if err != nil {
+		return err
+	}
+
+	*resp = *info
+	return nil
+}
+
+func (m *ProviderRPCServerV2) TestTarget(arg *RPCRequestV2, resp *TargetTestResult) error {
+	// The context opens the log stream the client waits for
+	ctx, done := m.context(arg)
+	defer done()
+
+	tester, ok := m.Impl.(TargetTester)
+	if !ok {
+		return ErrTargetTestNotSupported
+	}
+
+	result, err := tester.TestTarget(ctx, arg.TargetTest)
+	if err != nil {
+		return err
+	}
+
+	*resp = *result
+	return nil
+}
+
+// Cancel cancels the context of a call that is in progress. Cancellations of calls that didn't start yet
+// are kept so the calls are cancelled when they start.
+func (m *ProviderRPCServerV2) Cancel(callId uint32, resp *util.Empty) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if cancel, ok := m.cancels[callId]; ok {
+		cancel()
+		return nil
+	}
+
+	if m.cancelled == nil {
+		m.cancelled = make(map[uint32]time.Time)
+	}
+
+	// Cancellations of calls that already finished are never claimed
+	for id, cancelledAt := range m.cancelled {
+		if time.Since(cancelledAt) > cancelledCallTTL {
+			delete(m.cancelled, id)
+		}
+	}
+	m.cancelled[callId] = time.Now()
+
+	return nil
+}
+
+// context creates the context of a call from its request. The returned function must be called
+// when the call is finished to release the context and close the log stream.
+func (m *ProviderRPCServerV2) context(req *RPCRequestV2) (context.Context, func()) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if req.Deadline.IsZero() {
+		ctx, cancel = context.WithCancel(context.Background())
+	} else {
+		ctx, cancel = context.WithDeadline(context.Background(), req.Deadline)
+	}
+
+	m.mutex.Lock()
+	if m.cancels == nil {
+		m.cancels = make(map[uint32]context.CancelFunc)
+	}
+	m.cancels[req.CallId] = cancel
+	if _, ok := m.cancelled[req.CallId]; ok {
+		delete(m.cancelled, req.CallId)
+		cancel()
+	}
+	m.mutex.Unlock()
+
+	var logConn net.Conn
+	if req.StreamLogs {
+		conn, err := m.broker.Dial(req.CallId)
+		if err == nil {
+			logConn = conn
+			ctx = WithLogWriter(ctx, conn)
+		}
+	}
+
+	return ctx, func() {
+		m.mutex.Lock()
+		delete(m.cancels, req.CallId)
+		m.mutex.Unlock()
+
+		cancel()
+		if logConn != nil {
+			logConn.Close()
+		}
+	}
+}
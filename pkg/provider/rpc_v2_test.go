@@ -0,0 +1,175 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+)
+
+type testProviderV2 struct {
+	ProviderV2
+	deadline  time.Time
+	cancelled chan struct{}
+}
+
+func (p *testProviderV2) CreateWorkspace(ctx context.Context, req *WorkspaceRequest) (*util.Empty, error) {
+	p.deadline, _ = ctx.Deadline()
+	fmt.Fprintf(LogWriter(ctx), "Creating workspace %s\n", req.Workspace.Id)
+	return new(util.Empty), nil
+}
+
+func (p *testProviderV2) GetCapabilities(ctx context.Context) (*ProviderCapabilities, error) {
+	return &ProviderCapabilities{ResourceUsage: true}, nil
+}
+
+func (p *testProviderV2) DestroyWorkspace(ctx context.Context, req *WorkspaceRequest) (*util.Empty, error) {
+	<-ctx.Done()
+	close(p.cancelled)
+	return nil, ctx.Err()
+}
+
+func dispenseTestProviderV2(t *testing.T, impl ProviderV2) ProviderV2 {
+	client, _ := plugin.TestPluginRPCConn(t, map[string]plugin.Plugin{"test": &ProviderPluginV2{Impl: impl}}, nil)
+	t.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense("test")
+	require.NoError(t, err)
+
+	return raw.(ProviderV2)
+}
+
+func TestProviderRPCV2(t *testing.T) {
+	impl := &testProviderV2{cancelled: make(chan struct{})}
+	p := dispenseTestProviderV2(t, impl)
+	req := &WorkspaceRequest{Workspace: &workspace.Workspace{Id: "123"}}
+
+	t.Run("Streams logs and passes the deadline", func(t *testing.T) {
+		var logs bytes.Buffer
+		deadline := time.Now().Add(time.Minute)
+
+		ctx, cancel := context.WithDeadline(WithLogWriter(context.Background(), &logs), deadline)
+		defer cancel()
+
+		_, err := p.CreateWorkspace(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, "Creating workspace 123\n", logs.String())
+		require.True(t, impl.deadline.Equal(deadline))
+	})
+
+	t.Run("Copies all logs before returning", func(t *testing.T) {
+		for i := 0; i < 50; i++ {
+			var logs bytes.Buffer
+			_, err := p.CreateWorkspace(WithLogWriter(context.Background(), &logs), req)
+			require.NoError(t, err)
+			require.Equal(t, "Creating workspace 123\n", logs.String())
+		}
+	})
+
+	t.Run("Gets capabilities", func(t *testing.T) {
+		capabilities, err := p.GetCapabilities(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, ProviderCapabilities{ResourceUsage: true}, *capabilities)
+	})
+
+	t.Run("Target test is optional", func(t *testing.T) {
+		_, err := p.(TargetTester).TestTarget(WithLogWriter(context.Background(), io.Discard), &TargetTestRequest{})
+		require.ErrorIs(t, err, ErrTargetTestNotSupported)
+	})
+
+	t.Run("Cancels the call in the provider", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		_, err := p.DestroyWorkspace(ctx, req)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		select {
+		case <-impl.cancelled:
+		case <-time.After(5 * time.Second):
+			t.Fatal("provider call was not cancelled")
+		}
+	})
+}
+
+// oldProviderServer serves only GetCapabilities, like providers built before the other methods were added
+type oldProviderServer struct{}
+
+func (s *oldProviderServer) GetCapabilities(arg *RPCRequestV2, resp *ProviderCapabilities) error {
+	return nil
+}
+
+type oldProviderPlugin struct {
+	ProviderPluginV2
+}
+
+func (p *oldProviderPlugin) Server(b *plugin.MuxBroker) (interface{}, error) {
+	return &oldProviderServer{}, nil
+}
+
+func TestProviderRPCV2MissingMethod(t *testing.T) {
+	client, _ := plugin.TestPluginRPCConn(t, map[string]plugin.Plugin{"test": &oldProviderPlugin{}}, nil)
+	defer client.Close()
+
+	raw, err := client.Dispense("test")
+	require.NoError(t, err)
+
+	var logs bytes.Buffer
+	start := time.Now()
+
+	_, err = raw.(TargetTester).TestTarget(WithLogWriter(context.Background(), &logs), &TargetTestRequest{})
+	require.ErrorIs(t, err, ErrTargetTestNotSupported)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestProviderRPCServerV2CancelBeforeStart(t *testing.T) {
+	impl := &testProviderV2{cancelled: make(chan struct{})}
+	server := &ProviderRPCServerV2{Impl: impl}
+
+	require.NoError(t, server.Cancel(42, new(util.Empty)))
+
+	err := server.DestroyWorkspace(&RPCRequestV2{
+		CallId:    42,
+		Workspace: &WorkspaceRequest{Workspace: &workspace.Workspace{Id: "123"}},
+	}, new(util.Empty))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Empty(t, server.cancelled)
+}
+
+type testProviderV1 struct {
+	Provider
+	release chan struct{}
+}
+
+func (p *testProviderV1) GetInfo() (ProviderInfo, error) {
+	<-p.release
+	return ProviderInfo{Name: "test"}, nil
+}
+
+func TestFromV1(t *testing.T) {
+	impl := &testProviderV1{release: make(chan struct{})}
+	defer close(impl.release)
+
+	p := FromV1(impl)
+	require.Same(t, impl, ToV1(p))
+
+	capabilities, err := p.GetCapabilities(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, DefaultProviderCapabilities, *capabilities)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = p.GetInfo(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrTargetTestNotSupported = errors.New("provider does not support testing targets")
+
+// TargetTester is optionally implemented by providers that can validate the credentials and
+// reachability of a target without creating a workspace
+type TargetTester interface {
+	TestTarget(context.Context, *TargetTestRequest) (*TargetTestResult, error)
+}
+
+type TargetTestRequest struct {
+	TargetName    string
+	TargetOptions string
+}
+
+type TargetCheck struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+} // @name TargetCheck
+
+type TargetTestResult struct {
+	Checks []TargetCheck `json:"checks"`
+	// Passed is set if all checks passed
+	Passed bool `json:"passed"`
+} // @name TargetTestResult
+
+// NewTargetTestResult returns a result of the checks that is passed if all checks passed
+func NewTargetTestResult(checks ...TargetCheck) *TargetTestResult {
+	result := &TargetTestResult{
+		Checks: checks,
+		Passed: true,
+	}
+
+	for _, check := range checks {
+		if !check.Passed {
+			result.Passed = false
+		}
+	}
+
+	return result
+}
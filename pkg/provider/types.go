@@ -13,6 +13,23 @@ type ProviderInfo struct {
 	Version string `json:"version"`
 }
 
+// ProviderCapabilities describes the optional operations a provider supports
+type ProviderCapabilities struct {
+	// StopWithoutDestroy is set if stopped workspaces and projects keep their state and can be started again
+	StopWithoutDestroy bool `json:"stopWithoutDestroy"`
+	// ResourceUsage is set if the provider reports the resource usage of workspaces
+	ResourceUsage bool `json:"resourceUsage"`
+	// VolumeSnapshots is set if the provider can snapshot project volumes
+	VolumeSnapshots bool `json:"volumeSnapshots"`
+	// DirectPortExposure is set if project ports can be exposed directly, without the tunnel
+	DirectPortExposure bool `json:"directPortExposure"`
+} // @name ProviderCapabilities
+
+// DefaultProviderCapabilities are assumed for providers that don't report their capabilities
+var DefaultProviderCapabilities = ProviderCapabilities{
+	StopWithoutDestroy: true,
+}
+
 type InitializeProviderRequest struct {
 	BasePath           string
 	DaytonaDownloadUrl string
@@ -73,4 +90,6 @@ type ProviderTargetProperty struct {
 	Description string
 	// Options is only used if the Type is ProviderTargetPropertyTypeOption
 	Options []string
+	// Required properties must be set unless they are disabled for the target
+	Required bool
 }
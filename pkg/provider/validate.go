@@ -0,0 +1,148 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// TargetOptionError describes why a target option is invalid
+type TargetOptionError struct {
+	Option  string `json:"option"`
+	Message string `json:"message"`
+} // @name TargetOptionError
+
+// TargetOptionsError holds every invalid option of a target
+type TargetOptionsError []TargetOptionError
+
+func (e TargetOptionsError) Error() string {
+	lines := []string{"invalid target options:"}
+	for _, optionError := range e {
+		lines = append(lines, fmt.Sprintf("  %s: %s", optionError.Option, optionError.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// IsDisabled returns true if the property's DisabledPredicate matches the target name
+func (p ProviderTargetProperty) IsDisabled(targetName string) bool {
+	if p.DisabledPredicate == "" {
+		return false
+	}
+
+	matched, err := regexp.MatchString(p.DisabledPredicate, targetName)
+	return err == nil && matched
+}
+
+// ValidateOptions checks the JSON encoded options of a target against the manifest.
+// Options must match the type of their property, be one of the allowed options and point to existing files.
+// Required properties must be set and options not described by the manifest or disabled for the target are rejected.
+// All invalid options are returned in a TargetOptionsError.
+func (m ProviderTargetManifest) ValidateOptions(targetName string, options string) error {
+	values := map[string]interface{}{}
+	err := json.Unmarshal([]byte(options), &values)
+	if err != nil {
+		return errors.New("options must be a JSON object: " + err.Error())
+	}
+
+	optionErrors := TargetOptionsError{}
+	addError := func(option, message string) {
+		optionErrors = append(optionErrors, TargetOptionError{Option: option, Message: message})
+	}
+
+	for name, value := range values {
+		property, ok := m[name]
+		if !ok {
+			addError(name, "unknown option")
+			continue
+		}
+
+		if property.IsDisabled(targetName) {
+			addError(name, fmt.Sprintf("option is not available for target %s", targetName))
+			continue
+		}
+
+		// Unset options are only checked if they are required
+		if value == nil {
+			continue
+		}
+
+		message := property.validateValue(value)
+		if message != "" {
+			addError(name, message)
+		}
+	}
+
+	for name, property := range m {
+		if !property.Required || property.IsDisabled(targetName) {
+			continue
+		}
+
+		if value, ok := values[name]; !ok || value == nil || value == "" {
+			addError(name, "option is required")
+		}
+	}
+
+	if len(optionErrors) == 0 {
+		return nil
+	}
+
+	sort.Slice(optionErrors, func(i, j int) bool {
+		return optionErrors[i].Option < optionErrors[j].Option
+	})
+
+	return optionErrors
+}
+
+// validateValue returns why the value is invalid for the property or an empty string if it is valid
+func (p ProviderTargetProperty) validateValue(value interface{}) string {
+	switch p.Type {
+	case ProviderTargetPropertyTypeString:
+		if _, ok := value.(string); !ok {
+			return "must be a string"
+		}
+	case ProviderTargetPropertyTypeOption:
+		s, ok := value.(string)
+		if !ok {
+			return "must be a string"
+		}
+		if !slices.Contains(p.Options, s) {
+			return fmt.Sprintf("must be one of %s", strings.Join(p.Options, ", "))
+		}
+	case ProviderTargetPropertyTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return "must be a boolean"
+		}
+	case ProviderTargetPropertyTypeInt:
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) {
+			return "must be an integer"
+		}
+	case ProviderTargetPropertyTypeFloat:
+		if _, ok := value.(float64); !ok {
+			return "must be a number"
+		}
+	case ProviderTargetPropertyTypeFilePath:
+		path, ok := value.(string)
+		if !ok {
+			return "must be a file path"
+		}
+		fileInfo, err := os.Stat(path)
+		if err != nil {
+			return "file does not exist"
+		}
+		if fileInfo.IsDir() {
+			return "file is a directory"
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateOptions(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(filePath, []byte("key"), 0600))
+
+	manifest := ProviderTargetManifest{
+		"Region":     {Type: ProviderTargetPropertyTypeOption, Options: []string{"eu", "us"}},
+		"Token":      {Type: ProviderTargetPropertyTypeString, InputMasked: true, Required: true},
+		"Disk Size":  {Type: ProviderTargetPropertyTypeInt},
+		"Spot":       {Type: ProviderTargetPropertyTypeBoolean},
+		"Price":      {Type: ProviderTargetPropertyTypeFloat},
+		"Key":        {Type: ProviderTargetPropertyTypeFilePath},
+		"Remote URL": {Type: ProviderTargetPropertyTypeString, Required: true, DisabledPredicate: "^local$"},
+	}
+
+	t.Run("Valid options", func(t *testing.T) {
+		err := manifest.ValidateOptions("cloud", `{"Region": "eu", "Token": "secret", "Disk Size": 20, "Spot": true, "Price": 0.5, "Key": "`+filePath+`", "Remote URL": "https://example.com"}`)
+		require.NoError(t, err)
+	})
+
+	t.Run("Disabled properties are not required", func(t *testing.T) {
+		err := manifest.ValidateOptions("local", `{"Token": "secret", "Region": null}`)
+		require.NoError(t, err)
+	})
+
+	t.Run("Invalid options", func(t *testing.T) {
+		err := manifest.ValidateOptions("local", `{"Region": "asia", "Disk Size": 1.5, "Spot": "yes", "Price": "1", "Key": "`+filepath.Dir(filePath)+`", "Remote URL": "https://example.com", "Zone": "a"}`)
+
+		var optionErrors TargetOptionsError
+		require.ErrorAs(t, err, &optionErrors)
+		require.Equal(t, TargetOptionsError{
+			{Option: "Disk Size", Message: "must be an integer"},
+			{Option: "Key", Message: "file is a directory"},
+			{Option: "Price", Message: "must be a number"},
+			{Option: "Region", Message: "must be one of eu, us"},
+			{Option: "Remote URL", Message: "option is not available for target local"},
+			{Option: "Spot", Message: "must be a boolean"},
+			{Option: "Token", Message: "option is required"},
+			{Option: "Zone", Message: "unknown option"},
+		}, optionErrors)
+	})
+
+	t.Run("Options must be a JSON object", func(t *testing.T) {
+		err := manifest.ValidateOptions("cloud", `[]`)
+		require.Error(t, err)
+		require.False(t, errors.As(err, new(TargetOptionsError)))
+	})
+}
@@ -4,18 +4,20 @@
 package provisioner
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
-func (p *Provisioner) CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+func (p *Provisioner) CreateWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	targetProvider, err := p.providerManager.GetProviderV2(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).CreateWorkspace(&provider.WorkspaceRequest{
+	_, err = targetProvider.CreateWorkspace(ctx, &provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
 	})
@@ -23,13 +25,13 @@ func (p *Provisioner) CreateWorkspace(workspace *workspace.Workspace, target *pr
 	return err
 }
 
-func (p *Provisioner) CreateProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+func (p *Provisioner) CreateProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+	targetProvider, err := p.providerManager.GetProviderV2(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).CreateProject(&provider.ProjectRequest{
+	_, err = targetProvider.CreateProject(ctx, &provider.ProjectRequest{
 		TargetOptions:     target.Options,
 		Project:           project,
 		ContainerRegistry: cr,
@@ -4,17 +4,19 @@
 package provisioner
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
-func (p *Provisioner) DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+func (p *Provisioner) DestroyWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	targetProvider, err := p.providerManager.GetProviderV2(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).DestroyWorkspace(&provider.WorkspaceRequest{
+	_, err = targetProvider.DestroyWorkspace(ctx, &provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
 	})
@@ -22,13 +24,13 @@ func (p *Provisioner) DestroyWorkspace(workspace *workspace.Workspace, target *p
 	return err
 }
 
-func (p *Provisioner) DestroyProject(project *workspace.Project, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+func (p *Provisioner) DestroyProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget) error {
+	targetProvider, err := p.providerManager.GetProviderV2(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).DestroyProject(&provider.ProjectRequest{
+	_, err = targetProvider.DestroyProject(ctx, &provider.ProjectRequest{
 		TargetOptions: target.Options,
 		Project:       project,
 	})
@@ -0,0 +1,8 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import "errors"
+
+var ErrStopNotSupported = errors.New("the provider does not support stopping without destroying")
@@ -4,17 +4,19 @@
 package provisioner
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
-func (p *Provisioner) GetWorkspaceInfo(workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error) {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+func (p *Provisioner) GetWorkspaceInfo(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error) {
+	targetProvider, err := p.providerManager.GetProviderV2(target.ProviderInfo.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	return (*targetProvider).GetWorkspaceInfo(&provider.WorkspaceRequest{
+	return targetProvider.GetWorkspaceInfo(ctx, &provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
 	})
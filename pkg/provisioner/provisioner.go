@@ -4,6 +4,8 @@
 package provisioner
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
@@ -11,15 +13,16 @@ import (
 )
 
 type IProvisioner interface {
-	CreateProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error
-	CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
-	DestroyProject(project *workspace.Project, target *provider.ProviderTarget) error
-	DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
-	GetWorkspaceInfo(workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error)
-	StartProject(project *workspace.Project, target *provider.ProviderTarget) error
-	StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
-	StopProject(project *workspace.Project, target *provider.ProviderTarget) error
-	StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	CreateProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error
+	CreateWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	DestroyProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget) error
+	DestroyWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	GetWorkspaceInfo(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error)
+	StartProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget) error
+	StartWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	StopProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget) error
+	StopWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	TestTarget(ctx context.Context, target *provider.ProviderTarget) (*provider.TargetTestResult, error)
 }
 
 type ProvisionerConfig struct {
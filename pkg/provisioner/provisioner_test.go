@@ -0,0 +1,141 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/daytonaio/daytona/internal/testing/provider/fake"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+var target = &provider.ProviderTarget{
+	Name:         "test-target",
+	ProviderInfo: provider.ProviderInfo{Name: fake.ProviderName, Version: "v0.0.1"},
+	Options:      `{"Region": "eu"}`,
+}
+
+func newTestWorkspace() *workspace.Workspace {
+	return &workspace.Workspace{
+		Id:     "123",
+		Name:   "test",
+		Target: target.Name,
+		Projects: []*workspace.Project{
+			{Name: "project1", WorkspaceId: "123", Target: target.Name},
+		},
+	}
+}
+
+func newTestProvisioner(p *fake.FakeProvider) provisioner.IProvisioner {
+	return provisioner.NewProvisioner(provisioner.ProvisionerConfig{
+		ProviderManager: fake.NewFakeProviderManager(map[string]provider.ProviderV2{fake.ProviderName: p}),
+	})
+}
+
+func TestProvisioner(t *testing.T) {
+	ctx := context.Background()
+	p := fake.NewFakeProvider()
+	prov := newTestProvisioner(p)
+
+	w := newTestWorkspace()
+	project := w.Projects[0]
+
+	t.Run("CreateWorkspace", func(t *testing.T) {
+		require.NoError(t, prov.CreateWorkspace(ctx, w, target))
+		require.NoError(t, prov.CreateProject(ctx, project, target, nil))
+		require.True(t, p.WorkspaceExists(w.Id))
+	})
+
+	t.Run("StartWorkspace", func(t *testing.T) {
+		require.NoError(t, prov.StartWorkspace(ctx, w, target))
+		require.NoError(t, prov.StartProject(ctx, project, target))
+
+		info, err := prov.GetWorkspaceInfo(ctx, w, target)
+		require.NoError(t, err)
+		require.Len(t, info.Projects, 1)
+		require.True(t, info.Projects[0].IsRunning)
+	})
+
+	t.Run("StopWorkspace", func(t *testing.T) {
+		require.NoError(t, prov.StopProject(ctx, project, target))
+		require.NoError(t, prov.StopWorkspace(ctx, w, target))
+		require.False(t, p.ProjectRunning(w.Id, project.Name))
+	})
+
+	t.Run("StopWorkspace is rejected without provider support", func(t *testing.T) {
+		p.SetCapabilities(provider.ProviderCapabilities{})
+		defer p.SetCapabilities(provider.DefaultProviderCapabilities)
+
+		require.ErrorIs(t, prov.StopWorkspace(ctx, w, target), provisioner.ErrStopNotSupported)
+	})
+
+	t.Run("Provider errors are returned", func(t *testing.T) {
+		providerErr := errors.New("provider failure")
+		p.SetError("StartProject", providerErr)
+		defer p.SetError("StartProject", nil)
+
+		require.ErrorIs(t, prov.StartProject(ctx, project, target), providerErr)
+	})
+
+	t.Run("DestroyWorkspace", func(t *testing.T) {
+		require.NoError(t, prov.DestroyProject(ctx, project, target))
+		require.NoError(t, prov.DestroyWorkspace(ctx, w, target))
+		require.False(t, p.WorkspaceExists(w.Id))
+	})
+}
+
+func TestProvisionerTestTarget(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Dry run passes", func(t *testing.T) {
+		result, err := newTestProvisioner(fake.NewFakeProvider()).TestTarget(ctx, target)
+		require.NoError(t, err)
+		require.True(t, result.Passed)
+		require.Len(t, result.Checks, 2)
+	})
+
+	t.Run("Dry run reports failed checks", func(t *testing.T) {
+		p := fake.NewFakeProvider()
+		p.SetError("CreateWorkspace", errors.New("invalid credentials"))
+
+		result, err := newTestProvisioner(p).TestTarget(ctx, target)
+		require.NoError(t, err)
+		require.False(t, result.Passed)
+		require.Equal(t, []provider.TargetCheck{{Name: "Create workspace", Message: "invalid credentials"}}, result.Checks)
+	})
+
+	t.Run("Dry run cleans up after the test is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		p := &cancellingProvider{FakeProvider: fake.NewFakeProvider(), cancel: cancel}
+		prov := provisioner.NewProvisioner(provisioner.ProvisionerConfig{
+			ProviderManager: fake.NewFakeProviderManager(map[string]provider.ProviderV2{fake.ProviderName: p}),
+		})
+
+		result, err := prov.TestTarget(ctx, target)
+		require.NoError(t, err)
+		require.True(t, result.Passed)
+		require.False(t, p.WorkspaceExists(p.workspaceId))
+	})
+}
+
+// cancellingProvider cancels the context of the test once the workspace is created
+type cancellingProvider struct {
+	*fake.FakeProvider
+	cancel      context.CancelFunc
+	workspaceId string
+}
+
+func (p *cancellingProvider) CreateWorkspace(ctx context.Context, req *provider.WorkspaceRequest) (*util.Empty, error) {
+	defer p.cancel()
+	p.workspaceId = req.Workspace.Id
+	return p.FakeProvider.CreateWorkspace(ctx, req)
+}
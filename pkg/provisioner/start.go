@@ -4,17 +4,19 @@
 package provisioner
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
-func (p *Provisioner) StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+func (p *Provisioner) StartWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	targetProvider, err := p.providerManager.GetProviderV2(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).StartWorkspace(&provider.WorkspaceRequest{
+	_, err = targetProvider.StartWorkspace(ctx, &provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
 	})
@@ -22,13 +24,13 @@ func (p *Provisioner) StartWorkspace(workspace *workspace.Workspace, target *pro
 	return err
 }
 
-func (p *Provisioner) StartProject(project *workspace.Project, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+func (p *Provisioner) StartProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget) error {
+	targetProvider, err := p.providerManager.GetProviderV2(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).StartProject(&provider.ProjectRequest{
+	_, err = targetProvider.StartProject(ctx, &provider.ProjectRequest{
 		TargetOptions: target.Options,
 		Project:       project,
 	})
@@ -4,17 +4,24 @@
 package provisioner
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
-func (p *Provisioner) StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+func (p *Provisioner) StopWorkspace(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	err := p.checkCanStop(target)
+	if err != nil {
+		return err
+	}
+
+	targetProvider, err := p.providerManager.GetProviderV2(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).StopWorkspace(&provider.WorkspaceRequest{
+	_, err = targetProvider.StopWorkspace(ctx, &provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
 	})
@@ -22,16 +29,34 @@ func (p *Provisioner) StopWorkspace(workspace *workspace.Workspace, target *prov
 	return err
 }
 
-func (p *Provisioner) StopProject(project *workspace.Project, target *provider.ProviderTarget) error {
-	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+func (p *Provisioner) StopProject(ctx context.Context, project *workspace.Project, target *provider.ProviderTarget) error {
+	err := p.checkCanStop(target)
+	if err != nil {
+		return err
+	}
+
+	targetProvider, err := p.providerManager.GetProviderV2(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).StopProject(&provider.ProjectRequest{
+	_, err = targetProvider.StopProject(ctx, &provider.ProjectRequest{
 		TargetOptions: target.Options,
 		Project:       project,
 	})
 
 	return err
 }
+
+func (p *Provisioner) checkCanStop(target *provider.ProviderTarget) error {
+	capabilities, err := p.providerManager.GetProviderCapabilities(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	if !capabilities.StopWithoutDestroy {
+		return ErrStopNotSupported
+	}
+
+	return nil
+}
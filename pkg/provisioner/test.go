@@ -0,0 +1,90 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+// targetTestCleanupTimeout bounds how long destroying the workspace of a dry run can take
+const targetTestCleanupTimeout = 5 * time.Minute
+
+// TestTarget checks the target with the provider's TargetTester.
+// If the provider doesn't implement it, a workspace without projects is created and destroyed instead.
+func (p *Provisioner) TestTarget(ctx context.Context, target *provider.ProviderTarget) (*provider.TargetTestResult, error) {
+	targetProvider, err := p.providerManager.GetProviderV2(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if tester, ok := targetProvider.(provider.TargetTester); ok {
+		result, err := tester.TestTarget(ctx, &provider.TargetTestRequest{
+			TargetName:    target.Name,
+			TargetOptions: target.Options,
+		})
+		if !errors.Is(err, provider.ErrTargetTestNotSupported) {
+			return result, err
+		}
+	}
+
+	return p.dryRunTarget(ctx, targetProvider, target), nil
+}
+
+func (p *Provisioner) dryRunTarget(ctx context.Context, targetProvider provider.ProviderV2, target *provider.ProviderTarget) *provider.TargetTestResult {
+	id := "target-test-" + stringid.TruncateID(stringid.GenerateRandomID())
+	workspaceReq := &provider.WorkspaceRequest{
+		TargetOptions: target.Options,
+		Workspace: &workspace.Workspace{
+			Id:       id,
+			Name:     id,
+			Target:   target.Name,
+			Projects: []*workspace.Project{},
+		},
+	}
+
+	_, err := targetProvider.CreateWorkspace(ctx, workspaceReq)
+	if err != nil {
+		checks := []provider.TargetCheck{{Name: "Create workspace", Message: err.Error()}}
+
+		// Clean up anything the failed create left behind
+		err = destroyTestWorkspace(ctx, targetProvider, workspaceReq)
+		if err != nil {
+			checks = append(checks, provider.TargetCheck{Name: "Clean up workspace", Message: cleanupErrorMessage(id, err)})
+		}
+
+		return provider.NewTargetTestResult(checks...)
+	}
+
+	checks := []provider.TargetCheck{{Name: "Create workspace", Passed: true}}
+
+	err = destroyTestWorkspace(ctx, targetProvider, workspaceReq)
+	if err != nil {
+		checks = append(checks, provider.TargetCheck{Name: "Destroy workspace", Message: cleanupErrorMessage(id, err)})
+	} else {
+		checks = append(checks, provider.TargetCheck{Name: "Destroy workspace", Passed: true})
+	}
+
+	return provider.NewTargetTestResult(checks...)
+}
+
+// destroyTestWorkspace destroys the workspace of a dry run even if the test was cancelled or timed out,
+// so the workspace isn't left behind
+func destroyTestWorkspace(ctx context.Context, targetProvider provider.ProviderV2, workspaceReq *provider.WorkspaceRequest) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), targetTestCleanupTimeout)
+	defer cancel()
+
+	_, err := targetProvider.DestroyWorkspace(ctx, workspaceReq)
+	return err
+}
+
+func cleanupErrorMessage(workspaceId string, err error) string {
+	return fmt.Sprintf("%s. Workspace %s might have to be removed manually", err.Error(), workspaceId)
+}
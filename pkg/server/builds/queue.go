@@ -0,0 +1,274 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"sort"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/builder"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+const DefaultWorkers = 2
+
+// finishedJobTTL is how long finished build jobs are kept for listing
+const finishedJobTTL = 24 * time.Hour
+
+var ErrBuildJobNotFound = errors.New("build job not found")
+
+type BuildJobState string // @name BuildJobState
+
+const (
+	BuildJobStateQueued  BuildJobState = "queued"
+	BuildJobStateRunning BuildJobState = "running"
+	BuildJobStateSuccess BuildJobState = "success"
+	BuildJobStateError   BuildJobState = "error"
+)
+
+type BuildJob struct {
+	Id          string        `json:"id"`
+	Hash        string        `json:"hash"`
+	WorkspaceId string        `json:"workspaceId"`
+	ProjectName string        `json:"projectName"`
+	State       BuildJobState `json:"state"`
+	// QueuePosition is the position of a queued build starting at 1 and 0 once the build started
+	QueuePosition int    `json:"queuePosition"`
+	Error         string `json:"error,omitempty"`
+	CreatedAt     string `json:"createdAt"`
+} // @name BuildJob
+
+type job struct {
+	id        string
+	hash      string
+	project   workspace.Project
+	gpc       *gitprovider.GitProviderConfig
+	state     BuildJobState
+	result    *builder.BuildResult
+	err       error
+	createdAt time.Time
+	updatedAt time.Time
+	done      chan struct{}
+}
+
+// Build queues a build of the project and waits for it to finish.
+// If a build of the same config hash is already queued or running, Build waits for that build instead.
+// A nil result means that no builder could be detected for the project.
+func (s *BuildService) Build(project workspace.Project, gpc *gitprovider.GitProviderConfig, logWriter io.Writer) (*builder.BuildResult, error) {
+	hash, err := project.GetConfigHash()
+	if err != nil {
+		return nil, err
+	}
+
+	if logWriter == nil {
+		logWriter = io.Discard
+	}
+
+	s.mutex.Lock()
+	// Builds don't start while the previous build of the configuration is being pruned
+	for s.pruning[hash] {
+		s.pruneCond.Wait()
+	}
+
+	j, inFlight := s.inFlight[hash]
+	if inFlight {
+		logWriter.Write([]byte(fmt.Sprintf("Waiting for build %s of the same configuration\n", j.id)))
+	} else {
+		j = &job{
+			id:        stringid.TruncateID(stringid.GenerateRandomID()),
+			hash:      hash,
+			project:   project,
+			gpc:       gpc,
+			state:     BuildJobStateQueued,
+			createdAt: time.Now(),
+			done:      make(chan struct{}),
+		}
+		s.removeFinishedJobs()
+		s.jobs[j.id] = j
+		s.inFlight[hash] = j
+		s.pending = append(s.pending, j)
+
+		queuedMsg := fmt.Sprintf("Build %s queued at position %d\n", j.id, len(s.pending))
+		logWriter.Write([]byte(queuedMsg))
+
+		// Writing the queued message creates the build log so it can be followed before the build starts
+		buildLogger := s.loggerFactory.CreateBuildLogger(j.id, project.WorkspaceId, project.Name)
+		buildLogger.Write([]byte(queuedMsg))
+		buildLogger.Close()
+
+		s.cond.Signal()
+	}
+	s.mutex.Unlock()
+
+	<-j.done
+
+	return j.result, j.err
+}
+
+func (s *BuildService) ListJobs() []*BuildJob {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	jobs := []*BuildJob{}
+	for _, j := range s.jobs {
+		jobs = append(jobs, s.toBuildJob(j))
+	}
+
+	sort.Slice(jobs, func(i, k int) bool {
+		return jobs[i].CreatedAt > jobs[k].CreatedAt
+	})
+
+	return jobs
+}
+
+func (s *BuildService) GetJob(id string) (*BuildJob, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	j, ok := s.jobs[id]
+	if !ok {
+		return nil, ErrBuildJobNotFound
+	}
+
+	return s.toBuildJob(j), nil
+}
+
+func (s *BuildService) GetJobLogReader(id string) (io.Reader, error) {
+	return s.loggerFactory.CreateBuildLogReader(id)
+}
+
+func (s *BuildService) startWorkers(count int) {
+	for i := 0; i < count; i++ {
+		go s.work()
+	}
+}
+
+func (s *BuildService) work() {
+	for {
+		s.mutex.Lock()
+		for len(s.pending) == 0 {
+			s.cond.Wait()
+		}
+		j := s.pending[0]
+		s.pending = s.pending[1:]
+		j.state = BuildJobStateRunning
+		s.mutex.Unlock()
+
+		result, err := s.runJob(j)
+
+		s.mutex.Lock()
+		j.result = result
+		j.err = err
+		j.state = BuildJobStateSuccess
+		if err != nil {
+			j.state = BuildJobStateError
+		}
+		j.updatedAt = time.Now()
+		delete(s.inFlight, j.hash)
+		close(j.done)
+		s.mutex.Unlock()
+	}
+}
+
+// runJob runs the build of the job. A panic in the build is returned as an error so the waiters of the job
+// are released and the worker keeps running.
+func (s *BuildService) runJob(j *job) (result *builder.BuildResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("build %s panicked: %v\n%s", j.id, r, debug.Stack())
+			result = nil
+			err = fmt.Errorf("build %s panicked: %v", j.id, r)
+		}
+	}()
+
+	buildLogger := s.loggerFactory.CreateBuildLogger(j.id, j.project.WorkspaceId, j.project.Name)
+	defer buildLogger.Close()
+
+	buildLogger.Write([]byte(fmt.Sprintf("Starting build %s\n", j.id)))
+
+	b, err := s.builderFactory.Create(j.id, j.project, j.gpc)
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Build failed: %s\n", err.Error())))
+		return nil, err
+	}
+
+	if b == nil {
+		buildLogger.Write([]byte("No build configuration detected\n"))
+		return nil, nil
+	}
+
+	result, err = s.build(b)
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Build failed: %s\n", err.Error())))
+	} else {
+		buildLogger.Write([]byte(fmt.Sprintf("Build %s completed\n", j.id)))
+	}
+
+	cleanupErr := b.CleanUp()
+	if cleanupErr != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Error cleaning up build: %s\n", cleanupErr.Error())))
+	}
+
+	return result, err
+}
+
+func (s *BuildService) build(b builder.IBuilder) (*builder.BuildResult, error) {
+	result, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Publish()
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.SaveBuildResults(*result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// removeFinishedJobs removes jobs that finished before the TTL from the job list. Must be called with the mutex held.
+func (s *BuildService) removeFinishedJobs() {
+	for id, j := range s.jobs {
+		if j.state != BuildJobStateQueued && j.state != BuildJobStateRunning && time.Since(j.updatedAt) > finishedJobTTL {
+			delete(s.jobs, id)
+		}
+	}
+}
+
+// toBuildJob must be called with the mutex held
+func (s *BuildService) toBuildJob(j *job) *BuildJob {
+	buildJob := &BuildJob{
+		Id:          j.id,
+		Hash:        j.hash,
+		WorkspaceId: j.project.WorkspaceId,
+		ProjectName: j.project.Name,
+		State:       j.state,
+		CreatedAt:   j.createdAt.UTC().Format(time.RFC3339),
+	}
+
+	if j.err != nil {
+		buildJob.Error = j.err.Error()
+	}
+
+	for i, pending := range s.pending {
+		if pending == j {
+			buildJob.QueuePosition = i + 1
+			break
+		}
+	}
+
+	return buildJob
+}
@@ -0,0 +1,217 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds_test
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/builder"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingBuilderFactory creates builders that block until release is closed
+type blockingBuilderFactory struct {
+	builder.IBuilderFactory
+	mutex   sync.Mutex
+	created int
+	release chan struct{}
+}
+
+func (f *blockingBuilderFactory) Create(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig) (builder.IBuilder, error) {
+	f.mutex.Lock()
+	f.created++
+	f.mutex.Unlock()
+
+	return &blockingBuilder{release: f.release, imageName: p.Name}, nil
+}
+
+func (f *blockingBuilderFactory) createdCount() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.created
+}
+
+type blockingBuilder struct {
+	release   chan struct{}
+	imageName string
+}
+
+func (b *blockingBuilder) Build() (*builder.BuildResult, error) {
+	<-b.release
+	return &builder.BuildResult{ImageName: b.imageName}, nil
+}
+
+func (b *blockingBuilder) CleanUp() error {
+	return nil
+}
+
+func (b *blockingBuilder) Publish() error {
+	return nil
+}
+
+func (b *blockingBuilder) SaveBuildResults(r builder.BuildResult) error {
+	return nil
+}
+
+// panickingBuilderFactory creates builders that panic for projects named panic
+type panickingBuilderFactory struct {
+	builder.IBuilderFactory
+}
+
+func (f *panickingBuilderFactory) Create(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig) (builder.IBuilder, error) {
+	if p.Name == "panic" {
+		panic("unexpected builder state")
+	}
+
+	release := make(chan struct{})
+	close(release)
+	return &blockingBuilder{release: release, imageName: p.Name}, nil
+}
+
+// notifyWriter sends the first written message on the written channel
+type notifyWriter struct {
+	written chan string
+}
+
+func (w *notifyWriter) Write(p []byte) (int, error) {
+	select {
+	case w.written <- string(p):
+	default:
+	}
+	return len(p), nil
+}
+
+func newQueueTestProject(name, sha string) workspace.Project {
+	return workspace.Project{
+		Name:        name,
+		WorkspaceId: "123",
+		Build:       &workspace.ProjectBuild{},
+		Repository:  &gitprovider.GitRepository{Sha: sha},
+	}
+}
+
+func waitForJobs(t *testing.T, service builds.IBuildService, count int) {
+	require.Eventually(t, func() bool {
+		return len(service.ListJobs()) == count
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestBuildQueue(t *testing.T) {
+	t.Run("DeduplicatesInFlightBuilds", func(t *testing.T) {
+		builderFactory := &blockingBuilderFactory{release: make(chan struct{})}
+		service := builds.NewBuildService(builds.BuildServiceConfig{
+			BuilderFactory: builderFactory,
+			LoggerFactory:  logs.NewLoggerFactory(t.TempDir()),
+			Workers:        2,
+		})
+
+		project := newQueueTestProject("project1", "sha1")
+
+		var wg sync.WaitGroup
+		results := make([]*builder.BuildResult, 2)
+		for i := range results {
+			logWriter := &notifyWriter{written: make(chan string, 1)}
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				result, err := service.Build(project, nil, logWriter)
+				require.Nil(t, err)
+				results[i] = result
+			}(i)
+
+			msg := <-logWriter.written
+			if i > 0 {
+				require.Contains(t, msg, "Waiting for build")
+			}
+		}
+
+		close(builderFactory.release)
+		wg.Wait()
+
+		require.Equal(t, 1, builderFactory.createdCount())
+		require.Equal(t, results[0], results[1])
+
+		jobs := service.ListJobs()
+		require.Len(t, jobs, 1)
+		require.Equal(t, builds.BuildJobStateSuccess, jobs[0].State)
+	})
+
+	t.Run("LimitsRunningBuildsAndReportsQueuePosition", func(t *testing.T) {
+		builderFactory := &blockingBuilderFactory{release: make(chan struct{})}
+		service := builds.NewBuildService(builds.BuildServiceConfig{
+			BuilderFactory: builderFactory,
+			LoggerFactory:  logs.NewLoggerFactory(t.TempDir()),
+			Workers:        1,
+		})
+
+		var wg sync.WaitGroup
+		for i, sha := range []string{"sha1", "sha2", "sha3"} {
+			wg.Add(1)
+			go func(project workspace.Project) {
+				defer wg.Done()
+				_, err := service.Build(project, nil, io.Discard)
+				require.Nil(t, err)
+			}(newQueueTestProject(sha, sha))
+			waitForJobs(t, service, i+1)
+		}
+
+		require.Eventually(t, func() bool {
+			return builderFactory.createdCount() == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		positions := map[string]int{}
+		for _, job := range service.ListJobs() {
+			positions[job.ProjectName] = job.QueuePosition
+			if job.ProjectName == "sha1" {
+				require.Equal(t, builds.BuildJobStateRunning, job.State)
+			} else {
+				require.Equal(t, builds.BuildJobStateQueued, job.State)
+			}
+		}
+		require.Equal(t, map[string]int{"sha1": 0, "sha2": 1, "sha3": 2}, positions)
+
+		close(builderFactory.release)
+		wg.Wait()
+
+		require.Equal(t, 3, builderFactory.createdCount())
+	})
+
+	t.Run("RecoversFromPanickingBuilds", func(t *testing.T) {
+		service := builds.NewBuildService(builds.BuildServiceConfig{
+			BuilderFactory: &panickingBuilderFactory{},
+			LoggerFactory:  logs.NewLoggerFactory(t.TempDir()),
+			Workers:        1,
+		})
+
+		_, err := service.Build(newQueueTestProject("panic", "sha1"), nil, io.Discard)
+		require.ErrorContains(t, err, "unexpected builder state")
+
+		result, err := service.Build(newQueueTestProject("project1", "sha2"), nil, io.Discard)
+		require.Nil(t, err)
+		require.Equal(t, "project1", result.ImageName)
+
+		jobs := service.ListJobs()
+		require.Len(t, jobs, 2)
+		for _, job := range jobs {
+			if job.ProjectName == "panic" {
+				require.Equal(t, builds.BuildJobStateError, job.State)
+			}
+		}
+	})
+
+	t.Run("GetJobNotFound", func(t *testing.T) {
+		service := builds.NewBuildService(builds.BuildServiceConfig{})
+
+		_, err := service.GetJob("invalid-id")
+		require.Equal(t, builds.ErrBuildJobNotFound, err)
+	})
+}
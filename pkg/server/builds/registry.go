@@ -0,0 +1,154 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/distribution/reference"
+)
+
+var manifestMediaTypes = []string{
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+}
+
+type imageManifest struct {
+	Config struct {
+		Size int64 `json:"size"`
+	} `json:"config"`
+	Layers []struct {
+		Size int64 `json:"size"`
+	} `json:"layers"`
+}
+
+// registryImage is an image reference resolved to the registry API endpoints
+type registryImage struct {
+	manifestUrl string
+	registryUrl string
+	cr          *containerregistry.ContainerRegistry
+}
+
+func newRegistryImage(imageName string, cr *containerregistry.ContainerRegistry) (*registryImage, error) {
+	named, err := reference.ParseNormalizedNamed(imageName)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := "latest"
+	if tagged, ok := named.(reference.Tagged); ok {
+		tag = tagged.Tag()
+	}
+
+	registryUrl := getRegistryUrl(reference.Domain(named))
+
+	return &registryImage{
+		manifestUrl: fmt.Sprintf("%s/v2/%s/manifests/%s", registryUrl, reference.Path(named), tag),
+		registryUrl: registryUrl,
+		cr:          cr,
+	}, nil
+}
+
+// getSize returns the compressed size of the image layers stored in the registry
+func (i *registryImage) getSize() (int64, error) {
+	res, err := i.do(http.MethodGet, i.manifestUrl)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	var manifest imageManifest
+	err = json.NewDecoder(res.Body).Decode(&manifest)
+	if err != nil {
+		return 0, err
+	}
+
+	size := manifest.Config.Size
+	for _, layer := range manifest.Layers {
+		size += layer.Size
+	}
+
+	return size, nil
+}
+
+// delete removes the image manifest from the registry.
+// Layers are freed by the registry garbage collection.
+func (i *registryImage) delete() error {
+	res, err := i.do(http.MethodHead, i.manifestUrl)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	digest := res.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return fmt.Errorf("registry did not return the digest of %s", i.manifestUrl)
+	}
+
+	manifestUrl := i.manifestUrl[:strings.LastIndex(i.manifestUrl, "/")+1] + digest
+
+	res, err = i.do(http.MethodDelete, manifestUrl)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	return nil
+}
+
+func (i *registryImage) do(method, url string) (*http.Response, error) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	if i.cr != nil && i.cr.Username != "" {
+		req.SetBasicAuth(i.cr.Username, i.cr.Password)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		res.Body.Close()
+		return nil, ErrImageNotFound
+	}
+
+	if res.StatusCode >= 300 {
+		res.Body.Close()
+		return nil, fmt.Errorf("registry request %s %s failed with status %s", method, url, res.Status)
+	}
+
+	return res, nil
+}
+
+// getRegistryUrl returns the registry base URL. Like Docker, registries on the
+// loopback interface are expected to serve plain HTTP.
+func getRegistryUrl(domain string) string {
+	if domain == "docker.io" {
+		return "https://registry-1.docker.io"
+	}
+
+	host := domain
+	if h, _, err := net.SplitHostPort(domain); err == nil {
+		host = h
+	}
+
+	if host == "localhost" {
+		return "http://" + domain
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return "http://" + domain
+	}
+
+	return "https://" + domain
+}
@@ -0,0 +1,290 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/builder"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrImageNotFound = errors.New("image not found in the registry")
+
+type IBuildService interface {
+	List() ([]*BuildInfo, error)
+	Prune(options PruneOptions) ([]*BuildInfo, error)
+
+	Build(project workspace.Project, gpc *gitprovider.GitProviderConfig, logWriter io.Writer) (*builder.BuildResult, error)
+	ListJobs() []*BuildJob
+	GetJob(id string) (*BuildJob, error)
+	GetJobLogReader(id string) (io.Reader, error)
+}
+
+type BuildInfo struct {
+	Hash        string `json:"hash"`
+	ProjectName string `json:"projectName"`
+	ImageName   string `json:"imageName"`
+	// Size of the image in the registry in bytes
+	Size       int64  `json:"size" format:"int64"`
+	CreatedAt  string `json:"createdAt"`
+	LastUsedAt string `json:"lastUsedAt"`
+	// InUse is true if a workspace project runs the build image
+	InUse bool `json:"inUse"`
+} // @name BuildInfo
+
+type PruneOptions struct {
+	// OlderThan prunes only builds that were last used before the duration elapsed
+	OlderThan time.Duration
+	// Unused prunes only builds that no workspace project references
+	Unused bool
+}
+
+type BuildServiceConfig struct {
+	BuilderFactory           builder.IBuilderFactory
+	WorkspaceStore           workspace.Store
+	ContainerRegistryService containerregistries.IContainerRegistryService
+	LoggerFactory            logs.LoggerFactory
+	// Workers is the number of builds that run at the same time. Defaults to DefaultWorkers.
+	Workers int
+}
+
+func NewBuildService(config BuildServiceConfig) IBuildService {
+	workers := config.Workers
+	if workers < 1 {
+		workers = DefaultWorkers
+	}
+
+	service := &BuildService{
+		builderFactory:           config.BuilderFactory,
+		workspaceStore:           config.WorkspaceStore,
+		containerRegistryService: config.ContainerRegistryService,
+		loggerFactory:            config.LoggerFactory,
+		jobs:                     map[string]*job{},
+		inFlight:                 map[string]*job{},
+		pruning:                  map[string]bool{},
+	}
+	service.cond = sync.NewCond(&service.mutex)
+	service.pruneCond = sync.NewCond(&service.mutex)
+	service.startWorkers(workers)
+
+	return service
+}
+
+type BuildService struct {
+	builderFactory           builder.IBuilderFactory
+	workspaceStore           workspace.Store
+	containerRegistryService containerregistries.IContainerRegistryService
+	loggerFactory            logs.LoggerFactory
+
+	mutex sync.Mutex
+	cond  *sync.Cond
+	// pending holds the queued jobs in order
+	pending []*job
+	jobs    map[string]*job
+	// inFlight maps config hashes to their queued or running job
+	inFlight map[string]*job
+	// pruning holds the config hashes whose builds are being pruned
+	pruning map[string]bool
+	// pruneCond is signaled when pruning a build finishes
+	pruneCond *sync.Cond
+}
+
+func (s *BuildService) List() ([]*BuildInfo, error) {
+	builds, err := s.builderFactory.ListBuilds()
+	if err != nil {
+		return nil, err
+	}
+
+	usedImages, err := s.getUsedImages()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*BuildInfo{}
+	for _, build := range builds {
+		buildInfo := getBuildInfo(build, usedImages)
+
+		image, err := s.getRegistryImage(build.ImageName)
+		if err == nil {
+			buildInfo.Size, err = image.getSize()
+		}
+		if err != nil && !errors.Is(err, ErrImageNotFound) {
+			log.Warnf("failed to get the size of image %s: %s", build.ImageName, err)
+		}
+
+		result = append(result, buildInfo)
+	}
+
+	return result, nil
+}
+
+// Prune deletes the build records, cloned sources and registry images of the builds that match the options
+// and returns the pruned builds. Builds of a configuration that is queued or running are skipped.
+// Failing to prune a build doesn't stop the other builds from being pruned; the errors are returned together.
+func (s *BuildService) Prune(options PruneOptions) ([]*BuildInfo, error) {
+	builds, err := s.builderFactory.ListBuilds()
+	if err != nil {
+		return nil, err
+	}
+
+	usedImages, err := s.getUsedImages()
+	if err != nil {
+		return nil, err
+	}
+
+	pruned := []*BuildInfo{}
+	var pruneErrs []error
+	for _, build := range builds {
+		if options.OlderThan > 0 && time.Since(build.LastUsedAt) < options.OlderThan {
+			continue
+		}
+
+		if options.Unused && usedImages[build.ImageName] {
+			continue
+		}
+
+		if !s.startPrune(build.Hash) {
+			log.Debugf("skipping pruning build %s because a build of its configuration is in progress", build.Hash)
+			continue
+		}
+
+		buildInfo, err := s.pruneBuild(build, usedImages)
+		s.finishPrune(build.Hash)
+		if err != nil {
+			pruneErrs = append(pruneErrs, fmt.Errorf("failed to prune build %s: %w", build.Hash, err))
+			continue
+		}
+
+		pruned = append(pruned, buildInfo)
+	}
+
+	return pruned, errors.Join(pruneErrs...)
+}
+
+func (s *BuildService) pruneBuild(build *builder.Build, usedImages map[string]bool) (*BuildInfo, error) {
+	buildInfo := getBuildInfo(build, usedImages)
+
+	// Images that a workspace references are never deleted from the registry
+	if !buildInfo.InUse {
+		size, err := s.deleteImage(build.ImageName)
+		if err != nil {
+			return nil, err
+		}
+		buildInfo.Size = size
+	}
+
+	err := s.builderFactory.DeleteBuild(build.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildInfo, nil
+}
+
+// startPrune marks the config hash as being pruned so no build of it starts until finishPrune is called.
+// It returns false if a build of the hash is queued or running.
+func (s *BuildService) startPrune(hash string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, inFlight := s.inFlight[hash]; inFlight {
+		return false
+	}
+
+	s.pruning[hash] = true
+	return true
+}
+
+func (s *BuildService) finishPrune(hash string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.pruning, hash)
+	s.pruneCond.Broadcast()
+}
+
+// deleteImage deletes the image from the registry and returns the size it took up
+func (s *BuildService) deleteImage(imageName string) (int64, error) {
+	image, err := s.getRegistryImage(imageName)
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := image.getSize()
+	if err != nil {
+		if errors.Is(err, ErrImageNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get image %s: %w", imageName, err)
+	}
+
+	err = image.delete()
+	if err != nil && !errors.Is(err, ErrImageNotFound) {
+		return 0, fmt.Errorf("failed to delete image %s: %w", imageName, err)
+	}
+
+	return size, nil
+}
+
+func (s *BuildService) getRegistryImage(imageName string) (*registryImage, error) {
+	cr, err := s.containerRegistryService.FindByImageName(imageName)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return nil, err
+	}
+
+	return newRegistryImage(imageName, cr)
+}
+
+// getUsedImages returns the images of all workspace projects
+func (s *BuildService) getUsedImages() (map[string]bool, error) {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	usedImages := map[string]bool{}
+	for _, w := range workspaces {
+		for _, project := range w.Projects {
+			usedImages[project.Image] = true
+		}
+	}
+
+	return usedImages, nil
+}
+
+func getBuildInfo(build *builder.Build, usedImages map[string]bool) *BuildInfo {
+	return &BuildInfo{
+		Hash:        build.Hash,
+		ProjectName: build.ProjectName,
+		ImageName:   build.ImageName,
+		CreatedAt:   build.CreatedAt.UTC().Format(time.RFC3339),
+		LastUsedAt:  build.LastUsedAt.UTC().Format(time.RFC3339),
+		InUse:       usedImages[build.ImageName],
+	}
+}
+
+// ParseAge parses durations like 7d, 12h or 30m. Days are not supported by time.ParseDuration.
+func ParseAge(age string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(age, "d"); ok {
+		count, err := strconv.Atoi(days)
+		if err != nil || count < 0 {
+			return 0, fmt.Errorf("invalid duration %s", age)
+		}
+		return time.Duration(count) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(age)
+}
@@ -0,0 +1,266 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
+	"github.com/daytonaio/daytona/pkg/builder"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRegistry serves image manifests of a single layer with the Docker registry v2 API
+type fakeRegistry struct {
+	mutex     sync.Mutex
+	manifests map[string]int64
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	repository, reference, _ := strings.Cut(path, "/manifests/")
+
+	switch req.Method {
+	case http.MethodDelete:
+		for key := range r.manifests {
+			if "sha256:"+key == reference {
+				delete(r.manifests, key)
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		key := repository + ":" + reference
+		size, ok := r.manifests[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Docker-Content-Digest", "sha256:"+key)
+		if req.Method == http.MethodHead {
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"config": map[string]int64{"size": 10},
+			"layers": []map[string]int64{{"size": size - 10}},
+		})
+	}
+}
+
+func (r *fakeRegistry) has(key string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_, ok := r.manifests[key]
+	return ok
+}
+
+func TestBuildService(t *testing.T) {
+	registry := &fakeRegistry{manifests: map[string]int64{
+		"daytona/p-used:1":   100,
+		"daytona/p-unused:1": 200,
+		"daytona/p-recent:1": 300,
+	}}
+	server := httptest.NewServer(registry)
+	defer server.Close()
+
+	registryHost := strings.TrimPrefix(server.URL, "http://")
+
+	usedBuild := &builder.Build{
+		BuildResult: builder.BuildResult{ImageName: registryHost + "/daytona/p-used:1"},
+		Hash:        "used",
+		ProjectName: "project1",
+		CreatedAt:   time.Now().Add(-30 * 24 * time.Hour),
+		LastUsedAt:  time.Now().Add(-10 * 24 * time.Hour),
+	}
+	unusedBuild := &builder.Build{
+		BuildResult: builder.BuildResult{ImageName: registryHost + "/daytona/p-unused:1"},
+		Hash:        "unused",
+		ProjectName: "project2",
+		CreatedAt:   time.Now().Add(-20 * 24 * time.Hour),
+		LastUsedAt:  time.Now().Add(-8 * 24 * time.Hour),
+	}
+	recentBuild := &builder.Build{
+		BuildResult: builder.BuildResult{ImageName: registryHost + "/daytona/p-recent:1"},
+		Hash:        "recent",
+		ProjectName: "project3",
+		CreatedAt:   time.Now().Add(-time.Hour),
+		LastUsedAt:  time.Now().Add(-time.Hour),
+	}
+
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	err := workspaceStore.Save(&workspace.Workspace{
+		Id:   "123",
+		Name: "workspace",
+		Projects: []*workspace.Project{
+			{Name: "project1", Image: usedBuild.ImageName},
+		},
+	})
+	require.Nil(t, err)
+
+	builderFactory := &mocks.MockBuilderFactory{}
+	builderFactory.On("ListBuilds").Return([]*builder.Build{recentBuild, unusedBuild, usedBuild}, nil)
+
+	containerRegistryService := mocks.NewMockContainerRegistryService()
+	containerRegistryService.On("FindByImageName", mock.Anything).Return((*containerregistry.ContainerRegistry)(nil), containerregistry.ErrContainerRegistryNotFound)
+
+	service := builds.NewBuildService(builds.BuildServiceConfig{
+		BuilderFactory:           builderFactory,
+		WorkspaceStore:           workspaceStore,
+		ContainerRegistryService: containerRegistryService,
+	})
+
+	t.Run("List", func(t *testing.T) {
+		buildList, err := service.List()
+		require.Nil(t, err)
+		require.Len(t, buildList, 3)
+
+		require.Equal(t, "recent", buildList[0].Hash)
+		require.Equal(t, "project3", buildList[0].ProjectName)
+		require.Equal(t, int64(300), buildList[0].Size)
+		require.False(t, buildList[0].InUse)
+
+		require.Equal(t, "used", buildList[2].Hash)
+		require.True(t, buildList[2].InUse)
+	})
+
+	t.Run("PruneUnusedOlderThan", func(t *testing.T) {
+		builderFactory.On("DeleteBuild", "unused").Return(nil).Once()
+
+		pruned, err := service.Prune(builds.PruneOptions{
+			OlderThan: 7 * 24 * time.Hour,
+			Unused:    true,
+		})
+		require.Nil(t, err)
+		require.Len(t, pruned, 1)
+		require.Equal(t, "unused", pruned[0].Hash)
+		require.Equal(t, int64(200), pruned[0].Size)
+
+		require.False(t, registry.has("daytona/p-unused:1"))
+		require.True(t, registry.has("daytona/p-used:1"))
+		require.True(t, registry.has("daytona/p-recent:1"))
+		builderFactory.AssertExpectations(t)
+	})
+
+	t.Run("PruneKeepsReferencedImages", func(t *testing.T) {
+		builderFactory.On("DeleteBuild", "used").Return(nil).Once()
+
+		pruned, err := service.Prune(builds.PruneOptions{
+			OlderThan: 9 * 24 * time.Hour,
+		})
+		require.Nil(t, err)
+		require.Len(t, pruned, 1)
+		require.Equal(t, "used", pruned[0].Hash)
+		require.True(t, pruned[0].InUse)
+
+		require.True(t, registry.has("daytona/p-used:1"))
+		builderFactory.AssertExpectations(t)
+	})
+}
+
+// pruneBuilderFactory lists the given builds and fails to delete the builds in failing
+type pruneBuilderFactory struct {
+	*blockingBuilderFactory
+	builds  []*builder.Build
+	failing map[string]bool
+	mutex   sync.Mutex
+	deleted []string
+}
+
+func (f *pruneBuilderFactory) ListBuilds() ([]*builder.Build, error) {
+	return f.builds, nil
+}
+
+func (f *pruneBuilderFactory) DeleteBuild(hash string) error {
+	if f.failing[hash] {
+		return errors.New("permission denied")
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.deleted = append(f.deleted, hash)
+	return nil
+}
+
+func TestPruneWithBuildsInProgress(t *testing.T) {
+	project := newQueueTestProject("project1", "sha1")
+	inFlightHash, err := project.GetConfigHash()
+	require.Nil(t, err)
+
+	server := httptest.NewServer(&fakeRegistry{manifests: map[string]int64{}})
+	defer server.Close()
+
+	imageName := strings.TrimPrefix(server.URL, "http://") + "/daytona/p-project:1"
+	lastUsedAt := time.Now().Add(-10 * 24 * time.Hour)
+	builderFactory := &pruneBuilderFactory{
+		blockingBuilderFactory: &blockingBuilderFactory{release: make(chan struct{})},
+		builds: []*builder.Build{
+			{BuildResult: builder.BuildResult{ImageName: imageName}, Hash: inFlightHash, ProjectName: "project1", LastUsedAt: lastUsedAt},
+			{BuildResult: builder.BuildResult{ImageName: imageName}, Hash: "failing", ProjectName: "project2", LastUsedAt: lastUsedAt},
+			{BuildResult: builder.BuildResult{ImageName: imageName}, Hash: "unused", ProjectName: "project3", LastUsedAt: lastUsedAt},
+		},
+		failing: map[string]bool{"failing": true},
+	}
+
+	containerRegistryService := mocks.NewMockContainerRegistryService()
+	containerRegistryService.On("FindByImageName", mock.Anything).Return((*containerregistry.ContainerRegistry)(nil), containerregistry.ErrContainerRegistryNotFound)
+
+	service := builds.NewBuildService(builds.BuildServiceConfig{
+		BuilderFactory:           builderFactory,
+		WorkspaceStore:           t_workspaces.NewInMemoryWorkspaceStore(),
+		ContainerRegistryService: containerRegistryService,
+		LoggerFactory:            logs.NewLoggerFactory(t.TempDir()),
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := service.Build(project, nil, io.Discard)
+		require.Nil(t, err)
+	}()
+	waitForJobs(t, service, 1)
+
+	pruned, err := service.Prune(builds.PruneOptions{})
+	require.ErrorContains(t, err, "failed to prune build failing: permission denied")
+	require.Len(t, pruned, 1)
+	require.Equal(t, "unused", pruned[0].Hash)
+	require.Equal(t, []string{"unused"}, builderFactory.deleted)
+
+	close(builderFactory.release)
+	<-done
+}
+
+func TestParseAge(t *testing.T) {
+	age, err := builds.ParseAge("7d")
+	require.Nil(t, err)
+	require.Equal(t, 7*24*time.Hour, age)
+
+	age, err = builds.ParseAge("12h")
+	require.Nil(t, err)
+	require.Equal(t, 12*time.Hour, age)
+
+	_, err = builds.ParseAge("xd")
+	require.NotNil(t, err)
+}
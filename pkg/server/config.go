@@ -91,6 +91,26 @@ func GetConfigDir() (string, error) {
 	return filepath.Join(userConfigDir, "daytona", "server"), nil
 }
 
+// GetApiSocketPath returns the path of the Unix socket that local clients use to reach the API server
+func GetApiSocketPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "api.sock"), nil
+}
+
+// GetApiLocalAddrFilePath returns the path of the file the API server writes the address of its listener for local clients to
+func GetApiLocalAddrFilePath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "api-local-addr"), nil
+}
+
 func GetWorkspaceLogsDir() (string, error) {
 	configDir, err := GetConfigDir()
 	if err != nil {
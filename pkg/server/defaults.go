@@ -27,6 +27,7 @@ const defaultProjectUser = "daytona"
 const defaultLocalBuilderRegistryPort = 3988
 const defaultBuilderRegistryServer = "local"
 const defaultBuildImageNamespace = ""
+const defaultBuildWorkers = 2
 
 var defaultProjectPostStartCommands = []string{"sudo dockerd"}
 
@@ -118,6 +119,7 @@ func getDefaultConfig() (*Config, error) {
 		LocalBuilderRegistryPort:        defaultLocalBuilderRegistryPort,
 		BuilderRegistryServer:           defaultBuilderRegistryServer,
 		BuildImageNamespace:             defaultBuildImageNamespace,
+		BuildWorkers:                    defaultBuildWorkers,
 	}
 
 	if os.Getenv("DEFAULT_REGISTRY_URL") != "" {
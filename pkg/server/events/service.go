@@ -0,0 +1,131 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+type EventType string
+
+const (
+	EventTypeWorkspaceCreating   EventType = "workspace.creating"
+	EventTypeWorkspaceCreated    EventType = "workspace.created"
+	EventTypeWorkspaceStarted    EventType = "workspace.started"
+	EventTypeWorkspaceStopped    EventType = "workspace.stopped"
+	EventTypeWorkspaceRemoved    EventType = "workspace.removed"
+	EventTypeProjectStarted      EventType = "project.started"
+	EventTypeProjectStopped      EventType = "project.stopped"
+	EventTypeProjectStateUpdated EventType = "project.state-updated"
+	EventTypeBuildStarted        EventType = "build.started"
+	EventTypeBuildSucceeded      EventType = "build.succeeded"
+	EventTypeBuildFailed         EventType = "build.failed"
+	// EventTypeBuildSkipped is published when no build configuration was detected for a project
+	EventTypeBuildSkipped EventType = "build.skipped"
+)
+
+type Event struct {
+	Type        EventType               `json:"type"`
+	WorkspaceId string                  `json:"workspaceId"`
+	ProjectName string                  `json:"projectName,omitempty"`
+	Message     string                  `json:"message,omitempty"`
+	State       *workspace.ProjectState `json:"state,omitempty"`
+	Time        string                  `json:"time"`
+}
+
+type EventFilter struct {
+	WorkspaceId string
+	// Types limits the events to the given types. All events match if it is empty.
+	Types []EventType
+}
+
+func (f EventFilter) Matches(event Event) bool {
+	if f.WorkspaceId != "" && f.WorkspaceId != event.WorkspaceId {
+		return false
+	}
+
+	return len(f.Types) == 0 || slices.Contains(f.Types, event.Type)
+}
+
+type IEventService interface {
+	Publish(event Event)
+	Subscribe(filter EventFilter) (<-chan Event, func())
+}
+
+type EventServiceConfig struct {
+	// BufferSize is the number of events kept for a subscriber before new events are dropped
+	BufferSize int
+}
+
+func NewEventService(config EventServiceConfig) IEventService {
+	bufferSize := config.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = 64
+	}
+
+	return &EventService{
+		bufferSize:  bufferSize,
+		subscribers: map[*subscriber]bool{},
+	}
+}
+
+type EventService struct {
+	bufferSize  int
+	mutex       sync.RWMutex
+	subscribers map[*subscriber]bool
+}
+
+type subscriber struct {
+	filter EventFilter
+	ch     chan Event
+}
+
+func (s *EventService) Publish(event Event) {
+	if event.Time == "" {
+		event.Time = time.Now().Format(time.RFC3339)
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for sub := range s.subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+
+		// Slow subscribers should never block the publisher
+		select {
+		case sub.ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel of events matching the filter and a function that ends the subscription.
+func (s *EventService) Subscribe(filter EventFilter) (<-chan Event, func()) {
+	sub := &subscriber{
+		filter: filter,
+		ch:     make(chan Event, s.bufferSize),
+	}
+
+	s.mutex.Lock()
+	s.subscribers[sub] = true
+	s.mutex.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			s.mutex.Lock()
+			delete(s.subscribers, sub)
+			s.mutex.Unlock()
+			close(sub.ch)
+		})
+	}
+
+	return sub.ch, unsubscribe
+}
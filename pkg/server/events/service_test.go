@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventServiceTestSuite struct {
+	suite.Suite
+	eventService events.IEventService
+}
+
+func NewEventServiceTestSuite() *EventServiceTestSuite {
+	return &EventServiceTestSuite{}
+}
+
+func (s *EventServiceTestSuite) SetupTest() {
+	s.eventService = events.NewEventService(events.EventServiceConfig{
+		BufferSize: 2,
+	})
+}
+
+func TestEventService(t *testing.T) {
+	suite.Run(t, NewEventServiceTestSuite())
+}
+
+func (s *EventServiceTestSuite) TestPublish() {
+	require := s.Require()
+
+	eventCh, unsubscribe := s.eventService.Subscribe(events.EventFilter{})
+	defer unsubscribe()
+
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeWorkspaceStarted,
+		WorkspaceId: "workspace1",
+	})
+
+	event := <-eventCh
+	require.Equal(events.EventTypeWorkspaceStarted, event.Type)
+	require.Equal("workspace1", event.WorkspaceId)
+	require.NotEmpty(event.Time)
+}
+
+func (s *EventServiceTestSuite) TestSubscribeFilter() {
+	require := s.Require()
+
+	eventCh, unsubscribe := s.eventService.Subscribe(events.EventFilter{
+		WorkspaceId: "workspace2",
+	})
+	defer unsubscribe()
+
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeWorkspaceStarted,
+		WorkspaceId: "workspace1",
+	})
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeWorkspaceStopped,
+		WorkspaceId: "workspace2",
+	})
+
+	event := <-eventCh
+	require.Equal(events.EventTypeWorkspaceStopped, event.Type)
+	require.Equal("workspace2", event.WorkspaceId)
+	require.Len(eventCh, 0)
+}
+
+func (s *EventServiceTestSuite) TestSubscribeTypesFilter() {
+	require := s.Require()
+
+	eventCh, unsubscribe := s.eventService.Subscribe(events.EventFilter{
+		Types: []events.EventType{events.EventTypeProjectStarted, events.EventTypeProjectStopped},
+	})
+	defer unsubscribe()
+
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeProjectStateUpdated,
+		WorkspaceId: "workspace1",
+	})
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeProjectStopped,
+		WorkspaceId: "workspace1",
+	})
+
+	event := <-eventCh
+	require.Equal(events.EventTypeProjectStopped, event.Type)
+	require.Len(eventCh, 0)
+}
+
+func (s *EventServiceTestSuite) TestSlowSubscriberDoesNotBlock() {
+	require := s.Require()
+
+	eventCh, unsubscribe := s.eventService.Subscribe(events.EventFilter{})
+	defer unsubscribe()
+
+	for i := 0; i < 5; i++ {
+		s.eventService.Publish(events.Event{
+			Type:        events.EventTypeProjectStateUpdated,
+			WorkspaceId: "workspace1",
+		})
+	}
+
+	require.Len(eventCh, 2)
+}
+
+func (s *EventServiceTestSuite) TestUnsubscribe() {
+	require := s.Require()
+
+	eventCh, unsubscribe := s.eventService.Subscribe(events.EventFilter{})
+	unsubscribe()
+	unsubscribe()
+
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeWorkspaceRemoved,
+		WorkspaceId: "workspace1",
+	})
+
+	_, ok := <-eventCh
+	require.False(ok)
+}
@@ -7,6 +7,9 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/provider/manager"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -21,7 +24,7 @@ func (s *Server) downloadDefaultProviders() error {
 
 	log.Info("Downloading default providers")
 	for providerName, provider := range defaultProviders {
-		_, err = s.ProviderManager.DownloadProvider(provider.DownloadUrls, providerName, false)
+		_, err = s.ProviderManager.DownloadProvider(*provider, providerName, false, false)
 		if err != nil {
 			log.Error(err)
 		}
@@ -76,7 +79,7 @@ func (s *Server) registerProviders() error {
 				continue
 			}
 
-			if manifest.HasUpdateAvailable(info.Name, info.Version) {
+			if manifest.HasUpdateAvailable(info.Name, info.Version, s.config.PinnedProviderVersions[info.Name]) {
 				log.Infof("Update available for %s. Update with `daytona provider update`.", info.Name)
 			}
 		}
@@ -94,7 +97,7 @@ func (s *Server) getPluginPath(dir string) (string, error) {
 	}
 
 	for _, file := range files {
-		if !file.IsDir() {
+		if !file.IsDir() && !strings.HasSuffix(file.Name(), manager.STAGED_PROVIDER_SUFFIX) {
 			return filepath.Join(dir, file.Name()), nil
 		}
 	}
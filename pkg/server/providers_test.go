@@ -0,0 +1,103 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	daytona_os "github.com/daytonaio/daytona/pkg/os"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadDefaultProviders(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	operatingSystem, err := daytona_os.GetOperatingSystem()
+	require.NoError(t, err)
+
+	binary := []byte("provider binary")
+	digest := sha256.Sum256(binary)
+
+	mux := http.NewServeMux()
+	registry := httptest.NewServer(mux)
+	defer registry.Close()
+
+	manifest := manager.ProvidersManifest{
+		"test-provider": {
+			Default: true,
+			Versions: map[string]manager.Version{
+				"v0.1.0": {
+					DownloadUrls: map[daytona_os.OperatingSystem]string{*operatingSystem: registry.URL + "/providers/test-provider"},
+					Checksums:    map[daytona_os.OperatingSystem]string{*operatingSystem: hex.EncodeToString(digest[:])},
+					Signatures:   map[daytona_os.OperatingSystem]string{*operatingSystem: base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, digest[:]))},
+				},
+			},
+		},
+	}
+
+	mux.HandleFunc("/providers/manifest.json", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(manifest))
+	})
+	mux.HandleFunc("/providers/test-provider", func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write(binary)
+		require.NoError(t, err)
+	})
+
+	providerPath := func(baseDir string) string {
+		path := filepath.Join(baseDir, "test-provider", "test-provider")
+		if runtime.GOOS == "windows" {
+			path += ".exe"
+		}
+		return path
+	}
+
+	t.Run("Installs providers signed with the public key", func(t *testing.T) {
+		baseDir := t.TempDir()
+		s := &Server{
+			ProviderManager: manager.NewProviderManager(manager.ProviderManagerConfig{
+				RegistryUrl: registry.URL,
+				BaseDir:     baseDir,
+				PublicKey:   base64.StdEncoding.EncodeToString(publicKey),
+			}),
+		}
+
+		require.NoError(t, s.downloadDefaultProviders())
+
+		content, err := os.ReadFile(providerPath(baseDir))
+		require.NoError(t, err)
+		require.Equal(t, binary, content)
+	})
+
+	t.Run("Skips providers that don't match the public key", func(t *testing.T) {
+		otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		baseDir := t.TempDir()
+		s := &Server{
+			ProviderManager: manager.NewProviderManager(manager.ProviderManagerConfig{
+				RegistryUrl: registry.URL,
+				BaseDir:     baseDir,
+				PublicKey:   base64.StdEncoding.EncodeToString(otherKey),
+			}),
+		}
+
+		require.NoError(t, s.downloadDefaultProviders())
+
+		_, err = os.Stat(providerPath(baseDir))
+		require.True(t, os.IsNotExist(err))
+	})
+}
@@ -70,6 +70,8 @@ func (s *LocalContainerRegistry) Start() error {
 		Image: "registry:2.8.3",
 		Env: []string{
 			fmt.Sprintf("REGISTRY_HTTP_ADDR=0.0.0.0:%d", s.port),
+			// Allows pruned build images to be deleted
+			"REGISTRY_STORAGE_DELETE_ENABLED=true",
 		},
 		ExposedPorts: nat.PortSet{
 			nat.Port(fmt.Sprintf("%d/tcp", s.port)): {},
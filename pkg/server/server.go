@@ -11,9 +11,13 @@ import (
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/frpc"
+	"github.com/daytonaio/daytona/pkg/idempotency"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
@@ -35,6 +39,13 @@ type ServerInstanceConfig struct {
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
+	EventService             events.IEventService
+	Provisioner              provisioner.IProvisioner
+	BuildService             builds.IBuildService
+	IdempotencyKeyStore      idempotency.Store
+	// ApiLocalAddr is the address of the API listener that doesn't require client certificates.
+	// The API tunnel connects to it if mutual TLS is required.
+	ApiLocalAddr string
 }
 
 var server *Server
@@ -59,6 +70,11 @@ func GetInstance(serverConfig *ServerInstanceConfig) *Server {
 			GitProviderService:       serverConfig.GitProviderService,
 			ProviderManager:          serverConfig.ProviderManager,
 			ProfileDataService:       serverConfig.ProfileDataService,
+			EventService:             serverConfig.EventService,
+			Provisioner:              serverConfig.Provisioner,
+			BuildService:             serverConfig.BuildService,
+			IdempotencyKeyStore:      serverConfig.IdempotencyKeyStore,
+			apiLocalAddr:             serverConfig.ApiLocalAddr,
 		}
 	}
 
@@ -76,6 +92,11 @@ type Server struct {
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
+	EventService             events.IEventService
+	Provisioner              provisioner.IProvisioner
+	BuildService             builds.IBuildService
+	IdempotencyKeyStore      idempotency.Store
+	apiLocalAddr             string
 }
 
 func (s *Server) Start(errCh chan error) error {
@@ -117,13 +138,7 @@ func (s *Server) Start(errCh chan error) error {
 		}
 	}()
 
-	apiFrpcHealthCheck, apiFrpcService, err := frpc.GetService(frpc.FrpcConnectParams{
-		ServerDomain: s.config.Frps.Domain,
-		ServerPort:   int(s.config.Frps.Port),
-		Name:         fmt.Sprintf("daytona-server-api-%s", s.config.Id),
-		Port:         int(s.config.ApiPort),
-		SubDomain:    fmt.Sprintf("api-%s", s.config.Id),
-	})
+	apiFrpcHealthCheck, apiFrpcService, err := frpc.GetService(GetApiFrpcConnectParams(s.config, s.apiLocalAddr))
 	if err != nil {
 		return err
 	}
@@ -227,3 +242,18 @@ func (s *Server) Start(errCh chan error) error {
 
 	return nil
 }
+
+// GetApiFrpcConnectParams returns the parameters of the tunnel to the API server.
+// Connections through the tunnel can't carry client certificates, so the tunnel connects to the API listener
+// at apiLocalAddr, which doesn't require them, if it is set.
+func GetApiFrpcConnectParams(c Config, apiLocalAddr string) frpc.FrpcConnectParams {
+	return frpc.FrpcConnectParams{
+		ServerDomain: c.Frps.Domain,
+		ServerPort:   int(c.Frps.Port),
+		Name:         fmt.Sprintf("daytona-server-api-%s", c.Id),
+		Port:         int(c.ApiPort),
+		SubDomain:    fmt.Sprintf("api-%s", c.Id),
+		LocalTLS:     c.Tls != nil,
+		LocalAddr:    apiLocalAddr,
+	}
+}
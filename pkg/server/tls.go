@@ -0,0 +1,152 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const selfSignedCertificateValidity = 365 * 24 * time.Hour
+
+func GetTlsDir() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "tls"), nil
+}
+
+// EnsureTLSCertificate fills in the default certificate paths of a self-signed TLS config
+// and generates the certificate if it doesn't exist or has expired
+func EnsureTLSCertificate(c *TLSConfig) error {
+	if !c.SelfSigned {
+		if c.CertFile == "" || c.KeyFile == "" {
+			return errors.New("TLS certificate and key files are required unless selfSigned is set")
+		}
+		return nil
+	}
+
+	tlsDir, err := GetTlsDir()
+	if err != nil {
+		return err
+	}
+
+	if c.CertFile == "" {
+		c.CertFile = filepath.Join(tlsDir, "server.crt")
+	}
+	if c.KeyFile == "" {
+		c.KeyFile = filepath.Join(tlsDir, "server.key")
+	}
+
+	cert, err := readCertificate(c.CertFile)
+	if err == nil && time.Now().Before(cert.NotAfter) {
+		if _, err := os.Stat(c.KeyFile); err == nil {
+			return nil
+		}
+	}
+
+	log.Infof("Generating self-signed TLS certificate in %s", filepath.Dir(c.CertFile))
+	return generateSelfSignedCertificate(c.CertFile, c.KeyFile)
+}
+
+// GetCertificateFingerprint returns the fingerprint of the first certificate in a PEM file
+func GetCertificateFingerprint(certFile string) (string, error) {
+	cert, err := readCertificate(certFile)
+	if err != nil {
+		return "", err
+	}
+
+	return CertificateFingerprint(cert.Raw), nil
+}
+
+// CertificateFingerprint returns the hex encoded SHA-256 hash of a DER encoded certificate
+func CertificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+func readCertificate(certFile string) (*x509.Certificate, error) {
+	content, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no certificate found in " + certFile)
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func generateSelfSignedCertificate(certFile, keyFile string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{Organization: []string{"Daytona"}, CommonName: "Daytona Server"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(selfSignedCertificateValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+
+	if hostname, err := os.Hostname(); err == nil && hostname != "localhost" {
+		template.DNSNames = append(template.DNSNames, hostname)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(certFile), 0700)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(keyFile), 0700)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644)
+}
@@ -25,6 +25,16 @@ type FRPSConfig struct {
 	Protocol string `json:"protocol"`
 } // @name FRPSConfig
 
+// TLSConfig enables HTTPS on the API server.
+// If SelfSigned is set and the certificate files don't exist, a self-signed certificate is generated on startup.
+// If ClientCaFile is set, clients must present a certificate signed by that CA (mutual TLS).
+type TLSConfig struct {
+	CertFile     string `json:"certFile"`
+	KeyFile      string `json:"keyFile"`
+	ClientCaFile string `json:"clientCaFile,omitempty"`
+	SelfSigned   bool   `json:"selfSigned"`
+} // @name TLSConfig
+
 type NetworkKey struct {
 	Key string `json:"key"`
 } // @name NetworkKey
@@ -43,7 +53,18 @@ type Config struct {
 	DefaultProjectUser              string      `json:"defaultProjectUser"`
 	DefaultProjectPostStartCommands []string    `json:"defaultProjectPostStartCommands"`
 	BuilderImage                    string      `json:"builderImage"`
-	LocalBuilderRegistryPort        uint32      `json:"localBuilderRegistryPort"`
-	BuilderRegistryServer           string      `json:"builderRegistryServer"`
-	BuildImageNamespace             string      `json:"buildImageNamespace"`
+	// BuildpacksBuilderImage is the Cloud Native Buildpacks builder image used for repositories without a devcontainer or Dockerfile.
+	// Language base images are used if empty.
+	BuildpacksBuilderImage   string `json:"buildpacksBuilderImage,omitempty"`
+	LocalBuilderRegistryPort uint32 `json:"localBuilderRegistryPort"`
+	BuilderRegistryServer    string `json:"builderRegistryServer"`
+	BuildImageNamespace      string `json:"buildImageNamespace"`
+	// BuildWorkers is the number of builds that run at the same time
+	BuildWorkers uint32     `json:"buildWorkers,omitempty"`
+	Tls          *TLSConfig `json:"tls,omitempty"`
+	// ProvidersPublicKey is the base64 encoded Ed25519 key used to verify downloaded providers.
+	// Defaults to the key built into the server.
+	ProvidersPublicKey string `json:"providersPublicKey,omitempty"`
+	// PinnedProviderVersions maps provider names to the version they are pinned to
+	PinnedProviderVersions map[string]string `json:"pinnedProviderVersions,omitempty"`
 } // @name ServerConfig
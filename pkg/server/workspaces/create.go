@@ -9,11 +9,11 @@ import (
 	"regexp"
 
 	"github.com/daytonaio/daytona/pkg/apikey"
-	"github.com/daytonaio/daytona/pkg/builder"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
@@ -97,6 +97,11 @@ func (s *WorkspaceService) CreateWorkspace(req dto.CreateWorkspaceRequest) (*wor
 		return nil, err
 	}
 
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeWorkspaceCreating,
+		WorkspaceId: w.Id,
+	})
+
 	return s.createWorkspace(w)
 }
 
@@ -111,45 +116,50 @@ func (s *WorkspaceService) createBuild(project *workspace.Project, gc *gitprovid
 			project.User = lastBuildResult.User
 			project.PostStartCommands = lastBuildResult.PostStartCommands
 			project.PostCreateCommands = lastBuildResult.PostCreateCommands
+			project.Services = lastBuildResult.Services
+			project.Lifecycle = lastBuildResult.Lifecycle
+			project.ForwardPorts = lastBuildResult.ForwardPorts
+			project.Customizations = lastBuildResult.Customizations
 			return project, nil
 		}
 
-		builder, err := s.builderFactory.Create(*project, gc)
-		if err != nil {
-			return nil, err
-		}
-
-		if builder == nil {
-			return project, nil
-		}
-
-		buildResult, err := builder.Build()
-		if err != nil {
-			s.handleBuildError(project, builder, logWriter, err)
-			return project, nil
-		}
+		s.eventService.Publish(events.Event{
+			Type:        events.EventTypeBuildStarted,
+			WorkspaceId: project.WorkspaceId,
+			ProjectName: project.Name,
+		})
 
-		err = builder.Publish()
+		buildResult, err := s.buildService.Build(*project, gc, logWriter)
 		if err != nil {
-			s.handleBuildError(project, builder, logWriter, err)
+			s.handleBuildError(project, logWriter, err)
 			return project, nil
 		}
 
-		err = builder.SaveBuildResults(*buildResult)
-		if err != nil {
-			s.handleBuildError(project, builder, logWriter, err)
+		if buildResult == nil {
+			s.eventService.Publish(events.Event{
+				Type:        events.EventTypeBuildSkipped,
+				WorkspaceId: project.WorkspaceId,
+				ProjectName: project.Name,
+				Message:     "no build configuration detected",
+			})
 			return project, nil
 		}
 
-		err = builder.CleanUp()
-		if err != nil {
-			logWriter.Write([]byte(fmt.Sprintf("Error cleaning up build: %s\n", err.Error())))
-		}
-
 		project.Image = buildResult.ImageName
 		project.User = buildResult.User
 		project.PostStartCommands = buildResult.PostStartCommands
 		project.PostCreateCommands = buildResult.PostCreateCommands
+		project.Services = buildResult.Services
+		project.Lifecycle = buildResult.Lifecycle
+		project.ForwardPorts = buildResult.ForwardPorts
+		project.Customizations = buildResult.Customizations
+
+		s.eventService.Publish(events.Event{
+			Type:        events.EventTypeBuildSucceeded,
+			WorkspaceId: project.WorkspaceId,
+			ProjectName: project.Name,
+			Message:     buildResult.ImageName,
+		})
 
 		return project, nil
 	}
@@ -165,7 +175,10 @@ func (s *WorkspaceService) createProject(project *workspace.Project, target *pro
 		return err
 	}
 
-	err = s.provisioner.CreateProject(project, target, cr)
+	ctx, done := s.projectProviderContext(project.WorkspaceId, project.Name, providerCreateTimeout)
+	defer done()
+
+	err = s.provisioner.CreateProject(ctx, project, target, cr)
 	if err != nil {
 		return err
 	}
@@ -186,7 +199,9 @@ func (s *WorkspaceService) createWorkspace(ws *workspace.Workspace) (*workspace.
 
 	wsLogger.Write([]byte(fmt.Sprintf("Creating workspace %s (%s)\n", ws.Name, ws.Id)))
 
-	err = s.provisioner.CreateWorkspace(ws, target)
+	ctx, done := s.workspaceProviderContext(ws.Id, providerCreateTimeout)
+	err = s.provisioner.CreateWorkspace(ctx, ws, target)
+	done()
 	if err != nil {
 		return nil, err
 	}
@@ -225,6 +240,11 @@ func (s *WorkspaceService) createWorkspace(ws *workspace.Workspace) (*workspace.
 
 	wsLogger.Write([]byte("Workspace creation complete. Pending start...\n"))
 
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeWorkspaceCreated,
+		WorkspaceId: ws.Id,
+	})
+
 	err = s.startWorkspace(ws, target, wsLogger)
 	if err != nil {
 		return nil, err
@@ -233,18 +253,24 @@ func (s *WorkspaceService) createWorkspace(ws *workspace.Workspace) (*workspace.
 	return ws, nil
 }
 
-func (s *WorkspaceService) handleBuildError(project *workspace.Project, builder builder.IBuilder, logWriter io.Writer, err error) {
+func (s *WorkspaceService) handleBuildError(project *workspace.Project, logWriter io.Writer, err error) {
 	logWriter.Write([]byte("################################################\n"))
 	logWriter.Write([]byte(fmt.Sprintf("#### BUILD FAILED FOR PROJECT %s: %s\n", project.Name, err.Error())))
 	logWriter.Write([]byte("################################################\n"))
 
-	cleanupErr := builder.CleanUp()
-	if cleanupErr != nil {
-		logWriter.Write([]byte(fmt.Sprintf("Error cleaning up build: %s\n", cleanupErr.Error())))
-	}
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeBuildFailed,
+		WorkspaceId: project.WorkspaceId,
+		ProjectName: project.Name,
+		Message:     err.Error(),
+	})
 
 	logWriter.Write([]byte("Creating project with default image\n"))
 	project.Image = s.defaultProjectImage
 	project.User = s.defaultProjectUser
 	project.PostStartCommands = s.defaultProjectPostStartCommands
+	project.Services = nil
+	project.Lifecycle = nil
+	project.ForwardPorts = nil
+	project.Customizations = nil
 }
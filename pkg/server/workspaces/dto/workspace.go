@@ -38,3 +38,10 @@ type CreateWorkspaceRequest struct {
 	Target   string                          `json:"target"`
 	Projects []CreateWorkspaceRequestProject `json:"projects" validate:"required,gt=0,dive"`
 } //	@name	CreateWorkspaceRequest
+
+type ProjectImageStatus struct {
+	// Stale is true if the build files changed since the project image was built
+	Stale    bool   `json:"stale"`
+	BuildSha string `json:"buildSha"`
+	HeadSha  string `json:"headSha"`
+} // @name ProjectImageStatus
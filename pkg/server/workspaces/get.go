@@ -4,7 +4,10 @@
 package workspaces
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
 func (s *WorkspaceService) GetWorkspace(workspaceId string) (*dto.WorkspaceDTO, error) {
@@ -18,7 +21,10 @@ func (s *WorkspaceService) GetWorkspace(workspaceId string) (*dto.WorkspaceDTO,
 		return nil, err
 	}
 
-	workspaceInfo, err := s.provisioner.GetWorkspaceInfo(workspace, target)
+	ctx, cancel := context.WithTimeout(context.Background(), workspaceInfoTimeout)
+	defer cancel()
+
+	workspaceInfo, err := s.provisioner.GetWorkspaceInfo(ctx, workspace, target)
 	if err != nil {
 		return nil, err
 	}
@@ -30,3 +36,13 @@ func (s *WorkspaceService) GetWorkspace(workspaceId string) (*dto.WorkspaceDTO,
 
 	return &response, nil
 }
+
+// FindWorkspace returns the stored workspace without getting its info from the provider
+func (s *WorkspaceService) FindWorkspace(workspaceId string) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	return w, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// GetProjectImageStatus checks if the image of the project is stale relative to the current HEAD of its repository.
+// The image is stale if the build files at HEAD differ from the build files of the commit the image was built from.
+// The content hash of the image is saved with its build and the content hash of HEAD is only computed once per commit.
+func (s *WorkspaceService) GetProjectImageStatus(workspaceId, projectName string) (*dto.ProjectImageStatus, error) {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	var project *workspace.Project
+	for _, p := range ws.Projects {
+		if p.Name == projectName {
+			project = p
+			break
+		}
+	}
+	if project == nil {
+		return nil, ErrProjectNotFound
+	}
+
+	// Projects that use a prebuilt image cannot become stale
+	if project.Build == nil || project.Repository == nil {
+		return &dto.ProjectImageStatus{}, nil
+	}
+
+	headSha, err := s.gitProviderService.GetLastCommitSha(project.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &dto.ProjectImageStatus{
+		BuildSha: project.Repository.Sha,
+		HeadSha:  headSha,
+	}
+
+	if headSha == project.Repository.Sha {
+		return status, nil
+	}
+
+	headProject := *project
+	headRepository := *project.Repository
+	headRepository.Sha = headSha
+	headProject.Repository = &headRepository
+
+	buildContentHash, err := s.getBuildContentHash(*project)
+	if err != nil {
+		return nil, err
+	}
+	// Buildpacks builds, builds that ran lifecycle commands and builds saved by older versions have no content hash
+	// since they depend on the whole repository
+	if buildContentHash == "" {
+		status.Stale = true
+		return status, nil
+	}
+
+	headContentHash, err := s.getBuildContentHash(headProject)
+	if err != nil {
+		return nil, err
+	}
+
+	if headContentHash == "" {
+		gc, _ := s.gitProviderService.GetConfigForUrl(project.Repository.Url)
+
+		headContentHash, err = s.builderFactory.GetContentHash(headProject, gc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status.Stale = headContentHash != buildContentHash
+
+	return status, nil
+}
+
+// getBuildContentHash returns the content hash of the saved build of the project or an empty string if there is none
+func (s *WorkspaceService) getBuildContentHash(project workspace.Project) (string, error) {
+	hash, err := project.GetConfigHash()
+	if err != nil {
+		return "", err
+	}
+
+	builds, err := s.builderFactory.ListBuilds()
+	if err != nil {
+		return "", err
+	}
+
+	for _, build := range builds {
+		if build.Hash == hash {
+			return build.ContentHash, nil
+		}
+	}
+
+	return "", nil
+}
@@ -4,22 +4,30 @@
 package workspaces
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/daytonaio/daytona/pkg/pagination"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	log "github.com/sirupsen/logrus"
 )
 
-func (s *WorkspaceService) ListWorkspaces(verbose bool) ([]dto.WorkspaceDTO, error) {
+// ListWorkspaces returns the requested page of workspaces. Provider info is only fetched for workspaces on the page.
+func (s *WorkspaceService) ListWorkspaces(verbose bool, params pagination.ListParams) (*pagination.Result[dto.WorkspaceDTO], error) {
 	workspaces, err := s.workspaceStore.List()
 	if err != nil {
 		return nil, err
 	}
 
+	page, err := pagination.Paginate(workspaces, params, "id")
+	if err != nil {
+		return nil, err
+	}
+
 	response := []dto.WorkspaceDTO{}
 
-	for _, w := range workspaces {
+	for _, w := range page.Items {
 		var workspaceInfo *workspace.WorkspaceInfo
 		if verbose {
 			target, err := s.targetStore.Find(w.Target)
@@ -28,7 +36,9 @@ func (s *WorkspaceService) ListWorkspaces(verbose bool) ([]dto.WorkspaceDTO, err
 				continue
 			}
 
-			workspaceInfo, err = s.provisioner.GetWorkspaceInfo(w, target)
+			ctx, cancel := context.WithTimeout(context.Background(), workspaceInfoTimeout)
+			workspaceInfo, err = s.provisioner.GetWorkspaceInfo(ctx, w, target)
+			cancel()
 			if err != nil {
 				log.Error(fmt.Errorf("failed to get workspace info for %s", w.Name))
 			}
@@ -40,5 +50,9 @@ func (s *WorkspaceService) ListWorkspaces(verbose bool) ([]dto.WorkspaceDTO, err
 		})
 	}
 
-	return response, nil
+	return &pagination.Result[dto.WorkspaceDTO]{
+		Items:      response,
+		NextCursor: page.NextCursor,
+		Total:      page.Total,
+	}, nil
 }
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+)
+
+// workspaceInfoTimeout bounds how long fetching workspace info from a provider can take
+const workspaceInfoTimeout = 30 * time.Second
+
+// Timeouts of the provider operations. Creating and starting can include pulling images
+// and setting up projects, so they get the most time.
+const (
+	providerCreateTimeout  = 30 * time.Minute
+	providerStartTimeout   = 30 * time.Minute
+	providerStopTimeout    = 5 * time.Minute
+	providerDestroyTimeout = 10 * time.Minute
+)
+
+// workspaceProviderContext returns a context with a timeout whose provider calls stream their logs to the workspace logs.
+// The returned function releases the context and closes the logger and must be called when the provider calls are done.
+func (s *WorkspaceService) workspaceProviderContext(workspaceId string, timeout time.Duration) (context.Context, func()) {
+	logger := s.loggerFactory.CreateWorkspaceLogger(workspaceId, logs.LogSourceProvider)
+	return providerContext(logger, timeout)
+}
+
+// projectProviderContext returns a context with a timeout whose provider calls stream their logs to the project logs.
+// The returned function releases the context and closes the logger and must be called when the provider calls are done.
+func (s *WorkspaceService) projectProviderContext(workspaceId, projectName string, timeout time.Duration) (context.Context, func()) {
+	logger := s.loggerFactory.CreateProjectLogger(workspaceId, projectName, logs.LogSourceProvider)
+	return providerContext(logger, timeout)
+}
+
+func providerContext(logger logs.Logger, timeout time.Duration) (context.Context, func()) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	return provider.WithLogWriter(ctx, logger), func() {
+		cancel()
+		logger.Close()
+	}
+}
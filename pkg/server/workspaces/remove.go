@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -25,13 +26,17 @@ func (s *WorkspaceService) RemoveWorkspace(workspaceId string) error {
 
 	for _, project := range workspace.Projects {
 		//	todo: go routines
-		err := s.provisioner.DestroyProject(project, target)
+		ctx, done := s.projectProviderContext(workspace.Id, project.Name, providerDestroyTimeout)
+		err := s.provisioner.DestroyProject(ctx, project, target)
+		done()
 		if err != nil {
 			return err
 		}
 	}
 
-	err = s.provisioner.DestroyWorkspace(workspace, target)
+	ctx, done := s.workspaceProviderContext(workspace.Id, providerDestroyTimeout)
+	err = s.provisioner.DestroyWorkspace(ctx, workspace, target)
+	done()
 	if err != nil {
 		return err
 	}
@@ -68,6 +73,11 @@ func (s *WorkspaceService) RemoveWorkspace(workspaceId string) error {
 		return err
 	}
 
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeWorkspaceRemoved,
+		WorkspaceId: workspace.Id,
+	})
+
 	log.Infof("Workspace %s destroyed", workspace.Id)
 	return nil
 }
@@ -85,13 +95,17 @@ func (s *WorkspaceService) ForceRemoveWorkspace(workspaceId string) error {
 
 	for _, project := range workspace.Projects {
 		//	todo: go routines
-		err := s.provisioner.DestroyProject(project, target)
+		ctx, done := s.projectProviderContext(workspace.Id, project.Name, providerDestroyTimeout)
+		err := s.provisioner.DestroyProject(ctx, project, target)
+		done()
 		if err != nil {
 			log.Error(err)
 		}
 	}
 
-	err = s.provisioner.DestroyWorkspace(workspace, target)
+	ctx, done := s.workspaceProviderContext(workspace.Id, providerDestroyTimeout)
+	err = s.provisioner.DestroyWorkspace(ctx, workspace, target)
+	done()
 	if err != nil {
 		log.Error(err)
 	}
@@ -119,6 +133,11 @@ func (s *WorkspaceService) ForceRemoveWorkspace(workspaceId string) error {
 		return err
 	}
 
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeWorkspaceRemoved,
+		WorkspaceId: workspace.Id,
+	})
+
 	log.Infof("Workspace %s destroyed", workspace.Id)
 	return nil
 }
@@ -9,10 +9,13 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/builder"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/pagination"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/workspace"
@@ -21,9 +24,11 @@ import (
 type IWorkspaceService interface {
 	CreateWorkspace(req dto.CreateWorkspaceRequest) (*workspace.Workspace, error)
 	GetWorkspace(workspaceId string) (*dto.WorkspaceDTO, error)
+	FindWorkspace(workspaceId string) (*workspace.Workspace, error)
 	GetWorkspaceLogReader(workspaceId string) (io.Reader, error)
 	GetProjectLogReader(workspaceId, projectName string) (io.Reader, error)
-	ListWorkspaces(verbose bool) ([]dto.WorkspaceDTO, error)
+	GetProjectImageStatus(workspaceId, projectName string) (*dto.ProjectImageStatus, error)
+	ListWorkspaces(verbose bool, params pagination.ListParams) (*pagination.Result[dto.WorkspaceDTO], error)
 	RemoveWorkspace(workspaceId string) error
 	ForceRemoveWorkspace(workspaceId string) error
 	SetProjectState(workspaceId string, projectName string, state *workspace.ProjectState) (*workspace.Workspace, error)
@@ -51,6 +56,8 @@ type WorkspaceServiceConfig struct {
 	LoggerFactory                   logs.LoggerFactory
 	GitProviderService              gitproviders.IGitProviderService
 	BuilderFactory                  builder.IBuilderFactory
+	BuildService                    builds.IBuildService
+	EventService                    events.IEventService
 }
 
 func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
@@ -68,6 +75,8 @@ func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
 		apiKeyService:                   config.ApiKeyService,
 		gitProviderService:              config.GitProviderService,
 		builderFactory:                  config.BuilderFactory,
+		buildService:                    config.BuildService,
+		eventService:                    config.EventService,
 	}
 }
 
@@ -85,6 +94,8 @@ type WorkspaceService struct {
 	loggerFactory                   logs.LoggerFactory
 	gitProviderService              gitproviders.IGitProviderService
 	builderFactory                  builder.IBuilderFactory
+	buildService                    builds.IBuildService
+	eventService                    events.IEventService
 }
 
 func (s *WorkspaceService) SetProjectState(workspaceId, projectName string, state *workspace.ProjectState) (*workspace.Workspace, error) {
@@ -96,7 +107,19 @@ func (s *WorkspaceService) SetProjectState(workspaceId, projectName string, stat
 	for _, project := range ws.Projects {
 		if project.Name == projectName {
 			project.State = state
-			return ws, s.workspaceStore.Save(ws)
+			err = s.workspaceStore.Save(ws)
+			if err != nil {
+				return nil, err
+			}
+
+			s.eventService.Publish(events.Event{
+				Type:        events.EventTypeProjectStateUpdated,
+				WorkspaceId: ws.Id,
+				ProjectName: project.Name,
+				State:       state,
+			})
+
+			return ws, nil
 		}
 	}
 
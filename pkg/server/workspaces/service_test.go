@@ -12,10 +12,13 @@ import (
 	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
 	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
 	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/builder"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/pagination"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/workspace"
@@ -88,6 +91,8 @@ func TestWorkspaceService(t *testing.T) {
 
 	mockBuilderFactory := &mocks.MockBuilderFactory{}
 
+	eventService := events.NewEventService(events.EventServiceConfig{})
+
 	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
 		WorkspaceStore:                  workspaceStore,
 		TargetStore:                     targetStore,
@@ -102,6 +107,7 @@ func TestWorkspaceService(t *testing.T) {
 		LoggerFactory:                   logs.NewLoggerFactory(logsDir),
 		GitProviderService:              gitProviderService,
 		BuilderFactory:                  mockBuilderFactory,
+		EventService:                    eventService,
 	})
 
 	t.Run("CreateWorkspace", func(t *testing.T) {
@@ -175,12 +181,13 @@ func TestWorkspaceService(t *testing.T) {
 		verbose := false
 		provisioner.On("GetWorkspaceInfo", mock.Anything, &target).Return(&workspaceInfo, nil)
 
-		workspaces, err := service.ListWorkspaces(verbose)
+		workspaces, err := service.ListWorkspaces(verbose, pagination.ListParams{})
 
 		require.Nil(t, err)
-		require.Len(t, workspaces, 1)
+		require.Len(t, workspaces.Items, 1)
+		require.Equal(t, 1, workspaces.Total)
 
-		workspace := workspaces[0]
+		workspace := workspaces.Items[0]
 
 		workspaceDtoEquals(t, createWorkspaceRequest, workspace, workspaceInfo, defaultProjectImage, verbose)
 	})
@@ -189,16 +196,26 @@ func TestWorkspaceService(t *testing.T) {
 		verbose := true
 		provisioner.On("GetWorkspaceInfo", mock.Anything, &target).Return(&workspaceInfo, nil)
 
-		workspaces, err := service.ListWorkspaces(verbose)
+		workspaces, err := service.ListWorkspaces(verbose, pagination.ListParams{})
 
 		require.Nil(t, err)
-		require.Len(t, workspaces, 1)
+		require.Len(t, workspaces.Items, 1)
+		require.Equal(t, 1, workspaces.Total)
 
-		workspace := workspaces[0]
+		workspace := workspaces.Items[0]
 
 		workspaceDtoEquals(t, createWorkspaceRequest, workspace, workspaceInfo, defaultProjectImage, verbose)
 	})
 
+	t.Run("ListWorkspaces - paginated", func(t *testing.T) {
+		workspaces, err := service.ListWorkspaces(false, pagination.ListParams{Limit: 1, Cursor: pagination.EncodeCursor(nil, createWorkspaceRequest.Id)})
+
+		require.Nil(t, err)
+		require.Empty(t, workspaces.Items)
+		require.Empty(t, workspaces.NextCursor)
+		require.Equal(t, 1, workspaces.Total)
+	})
+
 	t.Run("StartWorkspace", func(t *testing.T) {
 		provisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
 		provisioner.On("StartProject", mock.Anything, &target).Return(nil)
@@ -281,6 +298,9 @@ func TestWorkspaceService(t *testing.T) {
 		ws, err := service.CreateWorkspace(createWorkspaceRequest)
 		require.Nil(t, err)
 
+		eventCh, unsubscribe := eventService.Subscribe(events.EventFilter{WorkspaceId: ws.Id})
+		defer unsubscribe()
+
 		projectName := ws.Projects[0].Name
 		updatedAt := time.Now().Format(time.RFC1123)
 		res, err := service.SetProjectState(ws.Id, projectName, &workspace.ProjectState{
@@ -295,6 +315,11 @@ func TestWorkspaceService(t *testing.T) {
 		project, err := res.GetProject(projectName)
 		require.Nil(t, err)
 		require.Equal(t, "main", project.State.GitStatus.CurrentBranch)
+
+		event := <-eventCh
+		require.Equal(t, events.EventTypeProjectStateUpdated, event.Type)
+		require.Equal(t, projectName, event.ProjectName)
+		require.Equal(t, uint64(10), event.State.Uptime)
 	})
 
 	t.Cleanup(func() {
@@ -303,6 +328,98 @@ func TestWorkspaceService(t *testing.T) {
 	})
 }
 
+func TestGetProjectImageStatus(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	gitProviderService := mocks.NewMockGitProviderService()
+	mockBuilderFactory := &mocks.MockBuilderFactory{}
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:     workspaceStore,
+		GitProviderService: gitProviderService,
+		BuilderFactory:     mockBuilderFactory,
+	})
+
+	repository := &gitprovider.GitRepository{
+		Url: "https://github.com/daytonaio/daytona",
+		Sha: "123",
+	}
+	project := &workspace.Project{
+		Name:       "project1",
+		Repository: repository,
+		Build: &workspace.ProjectBuild{
+			Devcontainer: &workspace.ProjectBuildDevcontainer{
+				DevContainerFilePath: ".devcontainer/devcontainer.json",
+			},
+		},
+	}
+	err := workspaceStore.Save(&workspace.Workspace{
+		Id:       "test",
+		Projects: []*workspace.Project{project},
+	})
+	require.Nil(t, err)
+
+	headProject := *project
+	headProject.Repository = &gitprovider.GitRepository{
+		Url: repository.Url,
+		Sha: "456",
+	}
+
+	buildHash, err := project.GetConfigHash()
+	require.Nil(t, err)
+	headBuildHash, err := headProject.GetConfigHash()
+	require.Nil(t, err)
+
+	gitProviderConfig := &gitprovider.GitProviderConfig{Id: "github"}
+	gitProviderService.On("GetConfigForUrl", repository.Url).Return(gitProviderConfig, nil)
+
+	t.Run("Image is up to date at HEAD", func(t *testing.T) {
+		gitProviderService.On("GetLastCommitSha", repository).Return("123", nil).Once()
+
+		status, err := service.GetProjectImageStatus("test", "project1")
+
+		require.Nil(t, err)
+		require.False(t, status.Stale)
+		require.Equal(t, "123", status.HeadSha)
+	})
+
+	t.Run("Image is not stale if the build files did not change", func(t *testing.T) {
+		gitProviderService.On("GetLastCommitSha", repository).Return("456", nil).Once()
+		mockBuilderFactory.On("ListBuilds").Return([]*builder.Build{
+			{Hash: buildHash, ContentHash: "content"},
+			{Hash: headBuildHash, ContentHash: "content"},
+		}, nil).Twice()
+
+		status, err := service.GetProjectImageStatus("test", "project1")
+
+		require.Nil(t, err)
+		require.False(t, status.Stale)
+		require.Equal(t, "123", status.BuildSha)
+		require.Equal(t, "456", status.HeadSha)
+	})
+
+	t.Run("Image is stale if the build files changed", func(t *testing.T) {
+		gitProviderService.On("GetLastCommitSha", repository).Return("456", nil).Once()
+		mockBuilderFactory.On("ListBuilds").Return([]*builder.Build{
+			{Hash: buildHash, ContentHash: "content"},
+		}, nil).Twice()
+		mockBuilderFactory.On("GetContentHash", headProject, gitProviderConfig).Return("changed-content", nil).Once()
+
+		status, err := service.GetProjectImageStatus("test", "project1")
+
+		require.Nil(t, err)
+		require.True(t, status.Stale)
+	})
+
+	t.Run("GetProjectImageStatus fails when project not found", func(t *testing.T) {
+		_, err := service.GetProjectImageStatus("test", "unknown")
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+	})
+
+	t.Cleanup(func() {
+		mockBuilderFactory.AssertExpectations(t)
+	})
+}
+
 func workspaceEquals(t *testing.T, req dto.CreateWorkspaceRequest, workspace *workspace.Workspace, projectImage string) {
 	t.Helper()
 
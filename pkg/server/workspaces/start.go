@@ -9,6 +9,7 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/workspace"
 
 	"github.com/daytonaio/daytona/internal/util"
@@ -58,7 +59,9 @@ func (s *WorkspaceService) StartProject(workspaceId, projectName string) error {
 func (s *WorkspaceService) startWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget, wsLogWriter io.Writer) error {
 	wsLogWriter.Write([]byte("Starting workspace\n"))
 
-	err := s.provisioner.StartWorkspace(workspace, target)
+	ctx, done := s.workspaceProviderContext(workspace.Id, providerStartTimeout)
+	err := s.provisioner.StartWorkspace(ctx, workspace, target)
+	done()
 	if err != nil {
 		return err
 	}
@@ -75,6 +78,11 @@ func (s *WorkspaceService) startWorkspace(workspace *workspace.Workspace, target
 
 	wsLogWriter.Write([]byte(fmt.Sprintf("Workspace %s started\n", workspace.Name)))
 
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeWorkspaceStarted,
+		WorkspaceId: workspace.Id,
+	})
+
 	return nil
 }
 
@@ -84,12 +92,21 @@ func (s *WorkspaceService) startProject(project *workspace.Project, target *prov
 	projectToStart := *project
 	projectToStart.EnvVars = workspace.GetProjectEnvVars(project, s.serverApiUrl, s.serverUrl)
 
-	err := s.provisioner.StartProject(project, target)
+	ctx, done := s.projectProviderContext(project.WorkspaceId, project.Name, providerStartTimeout)
+	defer done()
+
+	err := s.provisioner.StartProject(ctx, project, target)
 	if err != nil {
 		return err
 	}
 
 	logWriter.Write([]byte(fmt.Sprintf("Project %s started\n", project.Name)))
 
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeProjectStarted,
+		WorkspaceId: project.WorkspaceId,
+		ProjectName: project.Name,
+	})
+
 	return nil
 }
@@ -5,6 +5,8 @@ package workspaces
 
 import (
 	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
 )
 
 func (s *WorkspaceService) StopWorkspace(workspaceId string) error {
@@ -20,7 +22,9 @@ func (s *WorkspaceService) StopWorkspace(workspaceId string) error {
 
 	for _, project := range workspace.Projects {
 		//	todo: go routines
-		err := s.provisioner.StopProject(project, target)
+		ctx, done := s.projectProviderContext(workspace.Id, project.Name, providerStopTimeout)
+		err := s.provisioner.StopProject(ctx, project, target)
+		done()
 		if err != nil {
 			return err
 		}
@@ -30,12 +34,24 @@ func (s *WorkspaceService) StopWorkspace(workspaceId string) error {
 		}
 	}
 
-	err = s.provisioner.StopWorkspace(workspace, target)
+	ctx, done := s.workspaceProviderContext(workspace.Id, providerStopTimeout)
+	err = s.provisioner.StopWorkspace(ctx, workspace, target)
+	done()
+	if err != nil {
+		return err
+	}
+
+	err = s.workspaceStore.Save(workspace)
 	if err != nil {
 		return err
 	}
 
-	return s.workspaceStore.Save(workspace)
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeWorkspaceStopped,
+		WorkspaceId: workspace.Id,
+	})
+
+	return nil
 }
 
 func (s *WorkspaceService) StopProject(workspaceId, projectName string) error {
@@ -54,7 +70,9 @@ func (s *WorkspaceService) StopProject(workspaceId, projectName string) error {
 		return err
 	}
 
-	err = s.provisioner.StopProject(project, target)
+	ctx, done := s.projectProviderContext(w.Id, project.Name, providerStopTimeout)
+	err = s.provisioner.StopProject(ctx, project, target)
+	done()
 	if err != nil {
 		return err
 	}
@@ -64,5 +82,16 @@ func (s *WorkspaceService) StopProject(workspaceId, projectName string) error {
 		project.State.UpdatedAt = time.Now().Format(time.RFC1123)
 	}
 
-	return s.workspaceStore.Save(w)
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return err
+	}
+
+	s.eventService.Publish(events.Event{
+		Type:        events.EventTypeProjectStopped,
+		WorkspaceId: w.Id,
+		ProjectName: project.Name,
+	})
+
+	return nil
 }
@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/docker/go-units"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+type rowData struct {
+	Hash     string
+	Project  string
+	Image    string
+	Size     string
+	Created  string
+	LastUsed string
+}
+
+func getRowData(build *apiclient.BuildInfo) *rowData {
+	rowData := rowData{}
+
+	rowData.Hash = build.GetHash()
+	if len(rowData.Hash) > 12 {
+		rowData.Hash = rowData.Hash[:12]
+	}
+	rowData.Project = build.GetProjectName()
+	rowData.Image = build.GetImageName()
+	rowData.Size = "/"
+	if build.GetSize() > 0 {
+		rowData.Size = units.HumanSize(float64(build.GetSize()))
+	}
+	rowData.Created = util.FormatCreatedTime(build.GetCreatedAt())
+	rowData.LastUsed = util.FormatCreatedTime(build.GetLastUsedAt())
+	if build.GetInUse() {
+		rowData.LastUsed = "in use"
+	}
+
+	return &rowData
+}
+
+func getRowFromRowData(rowData rowData) []string {
+	row := []string{
+		views.NameStyle.Render(rowData.Hash),
+		views.DefaultRowDataStyle.Render(rowData.Project),
+		views.DefaultRowDataStyle.Render(rowData.Image),
+		views.DefaultRowDataStyle.Render(rowData.Size),
+		views.DefaultRowDataStyle.Render(rowData.Created),
+		views.DefaultRowDataStyle.Render(rowData.LastUsed),
+	}
+
+	return row
+}
+
+func ListBuilds(buildList []apiclient.BuildInfo) {
+	re := lipgloss.NewRenderer(os.Stdout)
+	headers := []string{"Hash", "Project", "Image", "Size", "Created", "Last Used"}
+	data := [][]string{}
+
+	for _, build := range buildList {
+		data = append(data, getRowFromRowData(*getRowData(&build)))
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+	minWidth := views_util.GetTableMinimumWidth(data)
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth || minWidth > breakpointWidth {
+		renderUnstyledList(buildList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledList(buildList []apiclient.BuildInfo) {
+	output := "\n"
+
+	for i, build := range buildList {
+		rowData := getRowData(&build)
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Hash: "), build.GetHash()) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), rowData.Project) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Image: "), rowData.Image) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Size: "), rowData.Size) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Created: "), rowData.Created) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Last Used: "), rowData.LastUsed) + "\n\n"
+
+		if i < len(buildList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
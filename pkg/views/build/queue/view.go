@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package queue
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+type rowData struct {
+	Id       string
+	Project  string
+	State    string
+	Position string
+	Created  string
+}
+
+func getRowData(job *apiclient.BuildJob) *rowData {
+	rowData := rowData{}
+
+	rowData.Id = job.GetId()
+	rowData.Project = job.GetProjectName()
+	rowData.State = string(job.GetState())
+	if job.GetError() != "" {
+		rowData.State = fmt.Sprintf("%s: %s", rowData.State, job.GetError())
+	}
+	rowData.Position = "/"
+	if job.GetQueuePosition() > 0 {
+		rowData.Position = fmt.Sprint(job.GetQueuePosition())
+	}
+	rowData.Created = util.FormatCreatedTime(job.GetCreatedAt())
+
+	return &rowData
+}
+
+func getRowFromRowData(rowData rowData) []string {
+	row := []string{
+		views.NameStyle.Render(rowData.Id),
+		views.DefaultRowDataStyle.Render(rowData.Project),
+		views.DefaultRowDataStyle.Render(rowData.State),
+		views.DefaultRowDataStyle.Render(rowData.Position),
+		views.DefaultRowDataStyle.Render(rowData.Created),
+	}
+
+	return row
+}
+
+func ListBuildJobs(jobList []apiclient.BuildJob) {
+	re := lipgloss.NewRenderer(os.Stdout)
+	headers := []string{"ID", "Project", "State", "Position", "Created"}
+	data := [][]string{}
+
+	for _, job := range jobList {
+		data = append(data, getRowFromRowData(*getRowData(&job)))
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+	minWidth := views_util.GetTableMinimumWidth(data)
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth || minWidth > breakpointWidth {
+		renderUnstyledList(jobList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledList(jobList []apiclient.BuildJob) {
+	output := "\n"
+
+	for i, job := range jobList {
+		rowData := getRowData(&job)
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), rowData.Id) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), rowData.Project) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("State: "), rowData.State) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Position: "), rowData.Position) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Created: "), rowData.Created) + "\n\n"
+
+		if i < len(jobList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+// List renders the build secret names with their repository patterns
+func List(repositoryPatterns map[string][]string) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Name", "Repositories"}
+
+	names := []string{}
+	for name := range repositoryPatterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data := [][]string{}
+	for _, name := range names {
+		data = append(data, []string{
+			views.NameStyle.Render(name),
+			views.DefaultRowDataStyle.Render(getRepositoriesLabel(repositoryPatterns[name])),
+		})
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(names, repositoryPatterns)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledList(names []string, repositoryPatterns map[string][]string) {
+	output := "\n"
+
+	for _, name := range names {
+		output += fmt.Sprintf("%s\t%s", views.GetPropertyKey("Name:"), name) + "\n"
+		output += fmt.Sprintf("%s\t%s", views.GetPropertyKey("Repositories:"), getRepositoriesLabel(repositoryPatterns[name])) + "\n"
+
+		output += "\n\n"
+	}
+
+	output = strings.TrimSuffix(output, "\n\n")
+
+	fmt.Println(output)
+}
+
+func getRepositoriesLabel(patterns []string) string {
+	if len(patterns) == 0 {
+		return "Referenced by projects only"
+	}
+	return strings.Join(patterns, ", ")
+}
@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+// ValueInput prompts for the secret value without echoing it
+func ValueInput(name string, value *string) error {
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title(fmt.Sprintf("Value of %s", name)).
+				Password(true).
+				Value(value).
+				Validate(func(str string) error {
+					if str == "" {
+						return errors.New("value can not be blank")
+					}
+					return nil
+				}),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	return form.Run()
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func RenderInfo(provider *apiclient.Provider) {
+	output := "\n"
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider Name: "), provider.GetName()) + "\n\n"
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider Version: "), provider.GetVersion()) + "\n\n"
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider State: "), provider.GetState()) + "\n\n"
+
+	if provider.GetLastError() != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Last Error: "), provider.GetLastError()) + "\n\n"
+	}
+
+	capabilities := provider.GetCapabilities()
+
+	output += views.GetPropertyKey("Capabilities:") + "\n"
+	output += getCapabilityLine("Stop without destroying", capabilities.GetStopWithoutDestroy())
+	output += getCapabilityLine("Resource usage", capabilities.GetResourceUsage())
+	output += getCapabilityLine("Volume snapshots", capabilities.GetVolumeSnapshots())
+	output += getCapabilityLine("Direct port exposure", capabilities.GetDirectPortExposure())
+
+	fmt.Println(output)
+}
+
+func getCapabilityLine(name string, supported bool) string {
+	value := "not supported"
+	if supported {
+		value = "supported"
+	}
+
+	return fmt.Sprintf("  %s %s", views.GetPropertyKey(fmt.Sprintf("%-24s", name)), value) + "\n"
+}
@@ -16,24 +16,30 @@ import (
 )
 
 type RowData struct {
-	Name    string
-	Version string
+	Name      string
+	Version   string
+	State     string
+	LastError string
 }
 
 func getRowFromRowData(rowData RowData) []string {
 	row := []string{
 		views.NameStyle.Render(rowData.Name),
 		views.DefaultRowDataStyle.Render(rowData.Version),
+		views.DefaultRowDataStyle.Render(rowData.State),
+		views.DefaultRowDataStyle.Render(rowData.LastError),
 	}
 
 	return row
 }
 
 func getRowData(provider *apiclient.Provider) *RowData {
-	rowData := RowData{"", ""}
+	rowData := RowData{"", "", "", ""}
 
 	rowData.Name = *provider.Name
 	rowData.Version = *provider.Version
+	rowData.State = provider.GetState()
+	rowData.LastError = provider.GetLastError()
 
 	return &rowData
 }
@@ -42,7 +48,7 @@ func List(providerList []apiclient.Provider) {
 
 	re := lipgloss.NewRenderer(os.Stdout)
 
-	headers := []string{"Name", "Version"}
+	headers := []string{"Name", "Version", "State", "Last Error"}
 
 	data := [][]string{}
 
@@ -92,7 +98,13 @@ func renderUnstyledList(providerList []apiclient.Provider) {
 	for _, provider := range providerList {
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider Name: "), *provider.Name) + "\n\n"
 
-		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider Version: "), *provider.Version) + "\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider Version: "), *provider.Version) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider State: "), provider.GetState()) + "\n"
+
+		if provider.GetLastError() != "" {
+			output += "\n" + fmt.Sprintf("%s %s", views.GetPropertyKey("Last Error: "), provider.GetLastError()) + "\n"
+		}
 
 		if provider.Name != providerList[len(providerList)-1].Name {
 			output += views.SeparatorString + "\n\n"
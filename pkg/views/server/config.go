@@ -39,6 +39,10 @@ func RenderConfig(config *server.Config) {
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Builder Image: "), config.BuilderImage) + "\n\n"
 
+	if config.BuildpacksBuilderImage != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Buildpacks Builder Image: "), config.BuildpacksBuilderImage) + "\n\n"
+	}
+
 	if config.BuilderRegistryServer == "local" {
 		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Local Builder Registry Port: "), config.LocalBuilderRegistryPort) + "\n\n"
 	} else {
@@ -47,6 +51,8 @@ func RenderConfig(config *server.Config) {
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Build Image Namespace: "), config.BuildImageNamespace) + "\n\n"
 
+	output += fmt.Sprintf("%s %d", views.GetPropertyKey("Build Workers: "), config.BuildWorkers) + "\n\n"
+
 	output += views.SeparatorString + "\n\n"
 
 	output += fmt.Sprintf("To edit these values run: %s", lipgloss.NewStyle().Foreground(views.Green).Render("daytona server configure")) + "\n\n"
@@ -12,6 +12,7 @@ import (
 
 	"github.com/charmbracelet/huh"
 	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/views"
 )
 
@@ -25,6 +26,11 @@ func ConfigurationForm(config *apiclient.ServerConfig, containerRegistries []api
 	headscalePortView := strconv.Itoa(int(config.GetHeadscalePort()))
 	frpsPortView := strconv.Itoa(int(config.Frps.GetPort()))
 	localBuilderRegistryPort := strconv.Itoa(int(config.GetLocalBuilderRegistryPort()))
+	buildWorkersView := strconv.Itoa(int(config.GetBuildWorkers()))
+	buildpacksBuilderImage := config.GetBuildpacksBuilderImage()
+	config.BuildpacksBuilderImage = &buildpacksBuilderImage
+	providersPublicKey := config.GetProvidersPublicKey()
+	config.ProvidersPublicKey = &providersPublicKey
 
 	builderContainerRegistryOptions := []huh.Option[string]{{
 		Key:   "Local registry managed by Daytona",
@@ -43,7 +49,18 @@ func ConfigurationForm(config *apiclient.ServerConfig, containerRegistries []api
 				Validate(directoryValidator(config.ProvidersDir)),
 			huh.NewInput().
 				Title("Registry URL").
+				Description("Use file:///path/to/mirror for a registry mirrored with `daytona provider mirror`").
 				Value(config.RegistryUrl),
+			huh.NewInput().
+				Title("Providers Public Key").
+				Description("Base64 encoded Ed25519 key used to verify providers. Leave empty to use the key built into the server.").
+				Value(config.ProvidersPublicKey).
+				Validate(func(s string) error {
+					if s == "" {
+						return nil
+					}
+					return manager.ValidatePublicKey(s)
+				}),
 			huh.NewInput().
 				Title("Server Download URL").
 				Value(config.ServerDownloadUrl),
@@ -62,6 +79,10 @@ func ConfigurationForm(config *apiclient.ServerConfig, containerRegistries []api
 				Title("Builder Image").
 				Description("Image dependencies: docker, @devcontainers/cli (node package)").
 				Value(config.BuilderImage),
+			huh.NewInput().
+				Title("Buildpacks Builder Image").
+				Description("Builds repositories without a devcontainer or Dockerfile. Leave empty to use language base images.").
+				Value(config.BuildpacksBuilderImage),
 			huh.NewSelect[string]().
 				Title("Builder Registry").
 				Description("To add options, add a container registry with 'daytona cr set'").
@@ -73,6 +94,22 @@ func ConfigurationForm(config *apiclient.ServerConfig, containerRegistries []api
 				Title("Build Image Namespace").
 				Description("Namespace to be used when tagging and pushing build images").
 				Value(config.BuildImageNamespace),
+			huh.NewInput().
+				Title("Build Workers").
+				Description("Number of builds that run at the same time. Set to 0 to use the default.").
+				Value(&buildWorkersView).
+				Validate(func(s string) error {
+					buildWorkers, err := strconv.Atoi(s)
+					if err != nil {
+						return errors.New("failed to parse build workers")
+					}
+					if buildWorkers < 0 {
+						return errors.New("build workers must not be negative")
+					}
+					config.SetBuildWorkers(int32(buildWorkers))
+
+					return nil
+				}),
 		),
 		huh.NewGroup(
 			huh.NewInput().
@@ -167,6 +167,10 @@ func getInput(name string, property apiclient.ProviderProviderTargetProperty, in
 		Value(value).
 		Password(property.InputMasked != nil && *property.InputMasked).
 		Validate(func(s string) error {
+			if property.Required != nil && *property.Required && s == "" {
+				return errors.New("value is required")
+			}
+
 			switch *property.Type {
 			case apiclient.ProviderTargetPropertyTypeInt:
 				_, err := strconv.Atoi(s)
@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func RenderTestResult(targetName string, result *apiclient.TargetTestResult) {
+	output := "\n"
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Target: "), targetName) + "\n\n"
+
+	output += views.GetPropertyKey("Checks:") + "\n"
+	for _, check := range result.GetChecks() {
+		output += getCheckLine(check)
+	}
+
+	fmt.Println(output)
+}
+
+func getCheckLine(check apiclient.TargetCheck) string {
+	status := "passed"
+	if !check.GetPassed() {
+		status = "failed"
+	}
+
+	line := fmt.Sprintf("  %s %s", views.GetPropertyKey(fmt.Sprintf("%-24s", check.GetName())), status)
+	if check.GetMessage() != "" {
+		line += fmt.Sprintf(" (%s)", check.GetMessage())
+	}
+
+	return line + "\n"
+}
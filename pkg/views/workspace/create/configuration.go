@@ -21,9 +21,11 @@ type BuildChoice string
 const (
 	AUTOMATIC             BuildChoice = "auto"
 	DEVCONTAINER          BuildChoice = "devcontainer"
+	DOCKERFILE            BuildChoice = "dockerfile"
 	CUSTOMIMAGE           BuildChoice = "custom-image"
 	NONE                  BuildChoice = "none"
 	DEVCONTAINER_FILEPATH             = ".devcontainer/devcontainer.json"
+	DOCKERFILE_FILEPATH               = "Dockerfile"
 )
 
 var configurationHelpLine = lipgloss.NewStyle().Foreground(views.Gray).Render("enter: next  f10: advanced configuration")
@@ -31,13 +33,14 @@ var configurationHelpLine = lipgloss.NewStyle().Foreground(views.Gray).Render("e
 type ProjectConfigurationData struct {
 	BuilderChoice        string
 	DevcontainerFilePath string
+	DockerfilePath       string
 	Image                string
 	User                 string
 	PostStartCommands    []string
 	EnvVars              map[string]string
 }
 
-func NewProjectConfigurationData(builderChoice BuildChoice, devContainerFilePath string, currentProject *apiclient.CreateWorkspaceRequestProject, apiServerConfig *apiclient.ServerConfig) *ProjectConfigurationData {
+func NewProjectConfigurationData(builderChoice BuildChoice, devContainerFilePath string, dockerfilePath string, currentProject *apiclient.CreateWorkspaceRequestProject, apiServerConfig *apiclient.ServerConfig) *ProjectConfigurationData {
 	image := *apiServerConfig.DefaultProjectImage
 	user := *apiServerConfig.DefaultProjectUser
 	commands := []string{}
@@ -62,6 +65,7 @@ func NewProjectConfigurationData(builderChoice BuildChoice, devContainerFilePath
 	return &ProjectConfigurationData{
 		BuilderChoice:        string(builderChoice),
 		DevcontainerFilePath: devContainerFilePath,
+		DockerfilePath:       dockerfilePath,
 		Image:                image,
 		User:                 user,
 		PostStartCommands:    commands,
@@ -86,6 +90,7 @@ func ConfigureProjects(projectList *[]apiclient.CreateWorkspaceRequestProject, a
 	}
 
 	devContainerFilePath := DEVCONTAINER_FILEPATH
+	dockerfilePath := DOCKERFILE_FILEPATH
 	builderChoice := AUTOMATIC
 
 	if currentProject.Build != nil {
@@ -93,6 +98,10 @@ func ConfigureProjects(projectList *[]apiclient.CreateWorkspaceRequestProject, a
 			builderChoice = DEVCONTAINER
 			devContainerFilePath = *currentProject.Build.Devcontainer.DevContainerFilePath
 		}
+		if currentProject.Build.Dockerfile != nil {
+			builderChoice = DOCKERFILE
+			dockerfilePath = currentProject.Build.Dockerfile.GetDockerfile()
+		}
 	} else {
 		if *currentProject.Image == *apiServerConfig.DefaultProjectImage && *currentProject.User == *apiServerConfig.DefaultProjectUser {
 			builderChoice = NONE
@@ -101,7 +110,7 @@ func ConfigureProjects(projectList *[]apiclient.CreateWorkspaceRequestProject, a
 		}
 	}
 
-	projectConfigurationData := NewProjectConfigurationData(builderChoice, devContainerFilePath, currentProject, &apiServerConfig)
+	projectConfigurationData := NewProjectConfigurationData(builderChoice, devContainerFilePath, dockerfilePath, currentProject, &apiServerConfig)
 
 	form := GetProjectConfigurationForm(projectConfigurationData)
 	err := form.Run()
@@ -143,6 +152,17 @@ func ConfigureProjects(projectList *[]apiclient.CreateWorkspaceRequestProject, a
 				(*projectList)[i].PostStartCommands = nil
 			}
 
+			if projectConfigurationData.BuilderChoice == string(DOCKERFILE) {
+				(*projectList)[i].Build = &apiclient.ProjectBuild{
+					Dockerfile: &apiclient.ProjectBuildDockerfile{
+						Dockerfile: &projectConfigurationData.DockerfilePath,
+					},
+				}
+				(*projectList)[i].Image = nil
+				(*projectList)[i].User = nil
+				(*projectList)[i].PostStartCommands = nil
+			}
+
 			(*projectList)[i].EnvVars = &projectConfigurationData.EnvVars
 		}
 	}
@@ -158,6 +178,7 @@ func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData)
 	buildOptions := []huh.Option[string]{
 		{Key: "Automatic", Value: string(AUTOMATIC)},
 		{Key: "Devcontainer", Value: string(DEVCONTAINER)},
+		{Key: "Dockerfile", Value: string(DOCKERFILE)},
 		{Key: "Custom image", Value: string(CUSTOMIMAGE)},
 		{Key: "None", Value: string(NONE)},
 	}
@@ -193,10 +214,22 @@ func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData)
 		).WithHideFunc(func() bool {
 			return projectConfiguration.BuilderChoice != string(DEVCONTAINER)
 		}),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Dockerfile path").
+				Value(&projectConfiguration.DockerfilePath).Validate(func(s string) error {
+				if s == "" {
+					return errors.New("dockerfile path is required")
+				}
+				return nil
+			}),
+		).WithHideFunc(func() bool {
+			return projectConfiguration.BuilderChoice != string(DOCKERFILE)
+		}),
 		huh.NewGroup(
 			configure.GetPostStartCommandsInput(&projectConfiguration.PostStartCommands, "Post start commands"),
 		).WithHideFunc(func() bool {
-			return projectConfiguration.BuilderChoice == string(DEVCONTAINER)
+			return projectConfiguration.BuilderChoice == string(DEVCONTAINER) || projectConfiguration.BuilderChoice == string(DOCKERFILE)
 		}),
 		huh.NewGroup(
 			views.GetEnvVarsInput(&projectConfiguration.EnvVars),
@@ -22,6 +22,7 @@ type ProjectDetail string
 const (
 	Build              ProjectDetail = "Build"
 	DevcontainerConfig ProjectDetail = "Devcontainer Config"
+	Dockerfile         ProjectDetail = "Dockerfile"
 	Image              ProjectDetail = "Image"
 	User               ProjectDetail = "User"
 	PostStartCommands  ProjectDetail = "Post Start Commands"
@@ -120,6 +121,11 @@ func renderProjectDetails(project apiclient.CreateWorkspaceRequestProject, build
 				}
 			}
 		}
+	} else if buildChoice == DOCKERFILE {
+		if project.Build != nil && project.Build.Dockerfile != nil {
+			output += "\n"
+			output += projectDetailOutput(Dockerfile, project.Build.Dockerfile.GetDockerfile())
+		}
 	} else {
 		if project.Image != nil {
 			if output != "" {
@@ -178,6 +184,8 @@ func getProjectBuildChoice(project apiclient.CreateWorkspaceRequestProject, apiS
 	} else {
 		if project.Build.Devcontainer != nil {
 			return DEVCONTAINER, "Devcontainer"
+		} else if project.Build.Dockerfile != nil {
+			return DOCKERFILE, "Dockerfile"
 		} else {
 			return AUTOMATIC, "Automatic"
 		}
@@ -23,7 +23,8 @@ var propertyValueStyle = lipgloss.NewStyle().
 	Foreground(views.Light).
 	Bold(true)
 
-func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
+// Render prints the workspace info. Image statuses are keyed by project name and are only shown for projects that have one.
+func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool, imageStatuses map[string]*apiclient.ProjectImageStatus) {
 	var isCreationView bool
 	var output string
 	nameLabel := "Name"
@@ -46,9 +47,9 @@ func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
 	}
 
 	if len(workspace.Projects) == 1 {
-		output += getSingleProjectOutput(&workspace.Projects[0], isCreationView)
+		output += getSingleProjectOutput(&workspace.Projects[0], isCreationView, imageStatuses)
 	} else {
-		output += getProjectsOutputs(workspace.Projects, isCreationView)
+		output += getProjectsOutputs(workspace.Projects, isCreationView, imageStatuses)
 	}
 
 	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
@@ -86,7 +87,7 @@ func renderTUIView(output string, width int, isCreationView bool) {
 	fmt.Println(content)
 }
 
-func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) string {
+func getSingleProjectOutput(project *apiclient.Project, isCreationView bool, imageStatuses map[string]*apiclient.ProjectImageStatus) string {
 	var output string
 	var repositoryUrl string
 
@@ -101,6 +102,9 @@ func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) str
 		if project.State.GitStatus != nil {
 			output += getInfoLineGitStatus("Branch", project.State.GitStatus) + "\n"
 		}
+		if lifecycleOutput := getInfoLineLifecycle("Lifecycle", project.State.LifecycleHooks); lifecycleOutput != "" {
+			output += lifecycleOutput + "\n"
+		}
 	}
 
 	if project.Target != nil && !isCreationView {
@@ -108,6 +112,18 @@ func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) str
 	}
 	output += getInfoLine("Repository", repositoryUrl)
 
+	if imageStatus, ok := imageStatuses[project.GetName()]; ok {
+		output += getInfoLineImageStatus("Image", imageStatus)
+	}
+
+	if len(project.Services) > 0 {
+		output += getInfoLine("Services", getServiceNames(project.Services))
+	}
+
+	if len(project.ForwardPorts) > 0 {
+		output += getInfoLine("Ports", getPortNames(project.ForwardPorts))
+	}
+
 	if project.Name != nil && !isCreationView {
 		output += "\n"
 		output += getInfoLine("Project", *project.Name)
@@ -116,7 +132,7 @@ func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) str
 	return output
 }
 
-func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) string {
+func getProjectsOutputs(projects []apiclient.Project, isCreationView bool, imageStatuses map[string]*apiclient.ProjectImageStatus) string {
 	var output string
 	for i, project := range projects {
 		output += getInfoLine(fmt.Sprintf("Project #%d", i+1), *project.Name)
@@ -124,12 +140,24 @@ func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) strin
 		if project.State != nil && project.State.GitStatus != nil {
 			output += getInfoLineGitStatus("Branch", project.State.GitStatus)
 		}
+		if project.State != nil {
+			output += getInfoLineLifecycle("Lifecycle", project.State.LifecycleHooks)
+		}
 		if project.Target != nil && !isCreationView {
 			output += getInfoLine("Target", *project.Target)
 		}
 		if project.Repository != nil {
 			output += getInfoLine("Repository", *project.Repository.Url)
 		}
+		if imageStatus, ok := imageStatuses[project.GetName()]; ok {
+			output += getInfoLineImageStatus("Image", imageStatus)
+		}
+		if len(project.Services) > 0 {
+			output += getInfoLine("Services", getServiceNames(project.Services))
+		}
+		if len(project.ForwardPorts) > 0 {
+			output += getInfoLine("Ports", getPortNames(project.ForwardPorts))
+		}
 		if project.Name != projects[len(projects)-1].Name {
 			output += "\n"
 		}
@@ -137,6 +165,26 @@ func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) strin
 	return output
 }
 
+func getServiceNames(services []apiclient.ProjectService) string {
+	names := []string{}
+	for _, service := range services {
+		names = append(names, service.GetName())
+	}
+	return strings.Join(names, ", ")
+}
+
+func getPortNames(ports []apiclient.ProjectPort) string {
+	names := []string{}
+	for _, port := range ports {
+		if port.GetLabel() != "" {
+			names = append(names, fmt.Sprintf("%d (%s)", port.GetPort(), port.GetLabel()))
+		} else {
+			names = append(names, fmt.Sprint(port.GetPort()))
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
 func getInfoLine(key, value string) string {
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
 }
@@ -160,6 +208,41 @@ func getInfoLineState(key string, state *apiclient.ProjectState) string {
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + stateProperty + propertyValueStyle.Foreground(views.Light).Render("\n")
 }
 
+// getInfoLineLifecycle returns a line with the lifecycle hooks that are running or failed
+func getInfoLineLifecycle(key string, hooks []apiclient.LifecycleHookStatus) string {
+	statuses := []string{}
+	for _, hook := range hooks {
+		switch hook.GetState() {
+		case apiclient.LifecycleHookStateRunning:
+			statuses = append(statuses, propertyValueStyle.Foreground(views.Yellow).Render(fmt.Sprintf("%s running", hook.GetHook())))
+		case apiclient.LifecycleHookStateError:
+			statuses = append(statuses, propertyValueStyle.Foreground(views.Orange).Render(fmt.Sprintf("%s failed: %s", hook.GetHook(), hook.GetError())))
+		}
+	}
+
+	if len(statuses) == 0 {
+		return ""
+	}
+
+	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + strings.Join(statuses, ", ") + "\n"
+}
+
+func getInfoLineImageStatus(key string, status *apiclient.ProjectImageStatus) string {
+	statusProperty := propertyValueStyle.Foreground(views.Green).Render("Up to date")
+	if status.GetStale() {
+		statusProperty = propertyValueStyle.Foreground(views.Orange).Render(fmt.Sprintf("Stale, build files changed at HEAD %s", shortSha(status.GetHeadSha())))
+	}
+
+	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + statusProperty + "\n"
+}
+
+func shortSha(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
 func getInfoLineGitStatus(key string, status *apiclient.GitStatus) string {
 	output := propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key))
 	if status.CurrentBranch == nil {
@@ -103,7 +103,7 @@ func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders
 
 func renderUnstyledList(workspaceList []apiclient.WorkspaceDTO) {
 	for _, workspace := range workspaceList {
-		info_view.Render(&workspace, "", true)
+		info_view.Render(&workspace, "", true, nil)
 
 		if workspace.Id != workspaceList[len(workspaceList)-1].Id {
 			fmt.Printf("\n%s\n\n", views.SeparatorString)
@@ -55,6 +55,9 @@ func selectProjectRequestPrompt(projects *[]apiclient.CreateWorkspaceRequestProj
 		if project.Build != nil && project.Build.Devcontainer != nil && project.Build.Devcontainer.DevContainerFilePath != nil {
 			devcontainerConfig = fmt.Sprintf("%s %s", "Devcontainer Config:", *project.Build.Devcontainer.DevContainerFilePath)
 		}
+		if project.Build != nil && project.Build.Dockerfile != nil && project.Build.Dockerfile.Dockerfile != nil {
+			devcontainerConfig = fmt.Sprintf("%s %s", "Dockerfile:", *project.Build.Dockerfile.Dockerfile)
+		}
 
 		newItem := projectRequestItem{name: name, image: image, user: user, project: project, devcontainerConfig: devcontainerConfig}
 
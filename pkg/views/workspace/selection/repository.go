@@ -14,7 +14,9 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-func selectRepositoryPrompt(repositories []apiclient.GitRepository, index int, choiceChan chan<- string) {
+var LoadMoreIdentifier = "<LOAD_MORE>"
+
+func selectRepositoryPrompt(repositories []apiclient.GitRepository, index int, hasNextPage bool, choiceChan chan<- string) {
 	items := []list.Item{}
 
 	// Populate items with titles and descriptions from workspaces.
@@ -23,6 +25,11 @@ func selectRepositoryPrompt(repositories []apiclient.GitRepository, index int, c
 		items = append(items, newItem)
 	}
 
+	if hasNextPage {
+		newItem := item[string]{id: LoadMoreIdentifier, title: "Load more repositories", choiceProperty: LoadMoreIdentifier}
+		items = append(items, newItem)
+	}
+
 	l := views.GetStyledSelectList(items)
 
 	title := "Choose a Repository"
@@ -46,18 +53,24 @@ func selectRepositoryPrompt(repositories []apiclient.GitRepository, index int, c
 	}
 }
 
-func GetRepositoryFromPrompt(repositories []apiclient.GitRepository, index int) *apiclient.GitRepository {
+// GetRepositoryFromPrompt returns the chosen repository. If hasNextPage is set, the user can instead
+// choose to load more repositories, in which case loadMore is true and the repository is nil.
+func GetRepositoryFromPrompt(repositories []apiclient.GitRepository, index int, hasNextPage bool) (repository *apiclient.GitRepository, loadMore bool) {
 	choiceChan := make(chan string)
 
-	go selectRepositoryPrompt(repositories, index, choiceChan)
+	go selectRepositoryPrompt(repositories, index, hasNextPage, choiceChan)
 
 	choice := <-choiceChan
 
+	if choice == LoadMoreIdentifier {
+		return nil, true
+	}
+
 	for _, repository := range repositories {
 		if *repository.Url == choice {
-			return &repository
+			return &repository, false
 		}
 	}
 
-	return nil
+	return nil, false
 }
@@ -0,0 +1,15 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+// ProjectCustomizations holds the tool specific customizations of the devcontainer config
+type ProjectCustomizations struct {
+	VSCode *VSCodeCustomizations `json:"vscode,omitempty"`
+} // @name ProjectCustomizations
+
+// VSCodeCustomizations are applied to the remote VS Code server on the first connect
+type VSCodeCustomizations struct {
+	Extensions []string               `json:"extensions,omitempty"`
+	Settings   map[string]interface{} `json:"settings,omitempty"`
+} // @name VSCodeCustomizations
@@ -0,0 +1,98 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import "slices"
+
+// LifecycleHook is a devcontainer lifecycle hook
+type LifecycleHook string // @name LifecycleHook
+
+const (
+	LifecycleHookOnCreate      LifecycleHook = "onCreate"
+	LifecycleHookUpdateContent LifecycleHook = "updateContent"
+	LifecycleHookPostCreate    LifecycleHook = "postCreate"
+	LifecycleHookPostStart     LifecycleHook = "postStart"
+	LifecycleHookPostAttach    LifecycleHook = "postAttach"
+)
+
+// CreateLifecycleHooks run once after the project is created, in order
+var CreateLifecycleHooks = []LifecycleHook{LifecycleHookOnCreate, LifecycleHookUpdateContent, LifecycleHookPostCreate}
+
+// LifecycleCommand is a command of a lifecycle hook.
+// Command is run in a shell while Args is run without one.
+type LifecycleCommand struct {
+	// Name is set for commands of the object syntax that run in parallel
+	Name    string   `json:"name,omitempty"`
+	Command string   `json:"command,omitempty"`
+	Args    []string `json:"args,omitempty"`
+} // @name LifecycleCommand
+
+// LifecycleCommandGroup holds commands that run in parallel.
+// The groups of a hook run one after another.
+type LifecycleCommandGroup struct {
+	Commands []LifecycleCommand `json:"commands"`
+} // @name LifecycleCommandGroup
+
+type ProjectLifecycle struct {
+	OnCreate      []LifecycleCommandGroup `json:"onCreate,omitempty"`
+	UpdateContent []LifecycleCommandGroup `json:"updateContent,omitempty"`
+	PostCreate    []LifecycleCommandGroup `json:"postCreate,omitempty"`
+	PostStart     []LifecycleCommandGroup `json:"postStart,omitempty"`
+	PostAttach    []LifecycleCommandGroup `json:"postAttach,omitempty"`
+	// WaitFor is the hook that has to finish before the project accepts connections. Defaults to updateContent.
+	WaitFor LifecycleHook `json:"waitFor,omitempty"`
+	// Prebuilt are the hooks that already ran while the image was built. The agent does not run them again.
+	Prebuilt []LifecycleHook `json:"prebuilt,omitempty"`
+} // @name ProjectLifecycle
+
+// GetCommandGroups returns the command groups of the hook
+func (l *ProjectLifecycle) GetCommandGroups(hook LifecycleHook) []LifecycleCommandGroup {
+	if l == nil {
+		return nil
+	}
+
+	switch hook {
+	case LifecycleHookOnCreate:
+		return l.OnCreate
+	case LifecycleHookUpdateContent:
+		return l.UpdateContent
+	case LifecycleHookPostCreate:
+		return l.PostCreate
+	case LifecycleHookPostStart:
+		return l.PostStart
+	case LifecycleHookPostAttach:
+		return l.PostAttach
+	}
+
+	return nil
+}
+
+// IsPrebuilt returns true if the hook already ran while the image was built
+func (l *ProjectLifecycle) IsPrebuilt(hook LifecycleHook) bool {
+	return l != nil && slices.Contains(l.Prebuilt, hook)
+}
+
+// GetWaitFor returns the hook that has to finish before the project accepts connections
+func (l *ProjectLifecycle) GetWaitFor() LifecycleHook {
+	if l == nil || l.WaitFor == "" {
+		return LifecycleHookUpdateContent
+	}
+
+	return l.WaitFor
+}
+
+type LifecycleHookState string // @name LifecycleHookState
+
+const (
+	LifecycleHookStateRunning LifecycleHookState = "running"
+	LifecycleHookStateSuccess LifecycleHookState = "success"
+	LifecycleHookStateError   LifecycleHookState = "error"
+)
+
+// LifecycleHookStatus is the result of the last run of a lifecycle hook
+type LifecycleHookStatus struct {
+	Hook  LifecycleHook      `json:"hook"`
+	State LifecycleHookState `json:"state"`
+	Error string             `json:"error,omitempty"`
+} // @name LifecycleHookStatus
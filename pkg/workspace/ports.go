@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+// PortAutoForwardAction is the action taken when a port of the project is forwarded automatically
+type PortAutoForwardAction string // @name PortAutoForwardAction
+
+const (
+	PortAutoForwardActionNotify          PortAutoForwardAction = "notify"
+	PortAutoForwardActionOpenBrowser     PortAutoForwardAction = "openBrowser"
+	PortAutoForwardActionOpenBrowserOnce PortAutoForwardAction = "openBrowserOnce"
+	PortAutoForwardActionOpenPreview     PortAutoForwardAction = "openPreview"
+	PortAutoForwardActionSilent          PortAutoForwardAction = "silent"
+	PortAutoForwardActionIgnore          PortAutoForwardAction = "ignore"
+)
+
+// ProjectPort is a port of the project that is forwarded to the local machine when opening the project
+type ProjectPort struct {
+	Port     uint16 `json:"port"`
+	Label    string `json:"label,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
+	// OnAutoForward defaults to notify
+	OnAutoForward PortAutoForwardAction `json:"onAutoForward,omitempty"`
+	// RequireLocalPort prevents forwarding to a different local port when the port is taken
+	RequireLocalPort bool `json:"requireLocalPort,omitempty"`
+} // @name ProjectPort
@@ -17,21 +17,33 @@ type ProjectBuildDevcontainer struct {
 	DevContainerFilePath string `json:"devContainerFilePath"`
 } // @name ProjectBuildDevcontainer
 
-/*
 type ProjectBuildDockerfile struct {
-	Context    string            `json:"context"`
+	// Context is the path of the build context relative to the repository root. Defaults to the repository root.
+	Context string `json:"context"`
+	// Dockerfile is the path of the Dockerfile relative to the repository root
 	Dockerfile string            `json:"dockerfile"`
 	Args       map[string]string `json:"args"`
+	// Target is the build stage to build. The last stage is built if empty.
+	Target string `json:"target"`
 } // @name ProjectBuildDockerfile
-*/
 
 type ProjectBuild struct {
 	Devcontainer *ProjectBuildDevcontainer `json:"devcontainer"`
-	/*
-		Dockerfile   *ProjectBuildDockerfile   `json:"dockerfile"`
-	*/
+	Dockerfile   *ProjectBuildDockerfile   `json:"dockerfile,omitempty"`
+	// Secrets are names of profile build secrets that are mounted into the build in addition to the secrets matching the repository
+	Secrets []string `json:"secrets,omitempty"`
 } // @name ProjectBuild
 
+// ProjectService is a sidecar container that runs next to the project container in the workspace network.
+// Containers of the workspace reach it by its name.
+type ProjectService struct {
+	Name    string            `json:"name"`
+	Image   string            `json:"image"`
+	EnvVars map[string]string `json:"envVars"`
+	// Command overrides the default command of the image
+	Command []string `json:"command,omitempty"`
+} // @name ProjectService
+
 type Project struct {
 	Name               string                     `json:"name"`
 	Image              string                     `json:"image"`
@@ -45,6 +57,14 @@ type Project struct {
 	State              *ProjectState              `json:"state,omitempty"`
 	PostCreateCommands []string                   `json:"postCreateCommands,omitempty"`
 	PostStartCommands  []string                   `json:"postStartCommands,omitempty"`
+	// Services run next to the project container and start, stop and get removed with it
+	Services []ProjectService `json:"services,omitempty"`
+	// Lifecycle holds the devcontainer lifecycle hooks that the agent runs
+	Lifecycle *ProjectLifecycle `json:"lifecycle,omitempty"`
+	// ForwardPorts are forwarded to the local machine when opening the project
+	ForwardPorts []ProjectPort `json:"forwardPorts,omitempty"`
+	// Customizations are applied by the IDE when opening the project
+	Customizations *ProjectCustomizations `json:"customizations,omitempty"`
 } // @name Project
 
 type ProjectInfo struct {
@@ -59,6 +79,8 @@ type ProjectState struct {
 	UpdatedAt string     `json:"updatedAt"`
 	Uptime    uint64     `json:"uptime"`
 	GitStatus *GitStatus `json:"gitStatus"`
+	// LifecycleHooks holds the results of the lifecycle hooks the agent ran
+	LifecycleHooks []LifecycleHookStatus `json:"lifecycleHooks,omitempty"`
 } // @name ProjectState
 
 type GitStatus struct {
@@ -113,7 +135,8 @@ func GetProjectHostname(workspaceId string, projectName string) string {
 	return hostname
 }
 
-// GetConfigHash returns a SHA-256 hash of the project's build configuration, repository URL, and environment variables.
+// GetConfigHash returns a SHA-256 hash of the project's build configuration and repository commit.
+// It identifies the sources of a build. Builds of different commits with the same build files share the content hash of the builder.
 func (p *Project) GetConfigHash() (string, error) {
 	buildJson, err := json.Marshal(p.Build)
 	if err != nil {